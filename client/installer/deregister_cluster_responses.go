@@ -29,6 +29,12 @@ func (o *DeregisterClusterReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewDeregisterClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewDeregisterClusterNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -74,6 +80,39 @@ func (o *DeregisterClusterNoContent) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewDeregisterClusterForbidden creates a DeregisterClusterForbidden with default headers values
+func NewDeregisterClusterForbidden() *DeregisterClusterForbidden {
+	return &DeregisterClusterForbidden{}
+}
+
+/*DeregisterClusterForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type DeregisterClusterForbidden struct {
+	Payload *models.Error
+}
+
+func (o *DeregisterClusterForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}][%d] deregisterClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeregisterClusterForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeregisterClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDeregisterClusterNotFound creates a DeregisterClusterNotFound with default headers values
 func NewDeregisterClusterNotFound() *DeregisterClusterNotFound {
 	return &DeregisterClusterNotFound{}
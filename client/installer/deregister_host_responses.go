@@ -35,6 +35,12 @@ func (o *DeregisterHostReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewDeregisterHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewDeregisterHostNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -107,6 +113,39 @@ func (o *DeregisterHostBadRequest) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewDeregisterHostForbidden creates a DeregisterHostForbidden with default headers values
+func NewDeregisterHostForbidden() *DeregisterHostForbidden {
+	return &DeregisterHostForbidden{}
+}
+
+/*DeregisterHostForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type DeregisterHostForbidden struct {
+	Payload *models.Error
+}
+
+func (o *DeregisterHostForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/hosts/{host_id}][%d] deregisterHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DeregisterHostForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeregisterHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDeregisterHostNotFound creates a DeregisterHostNotFound with default headers values
 func NewDeregisterHostNotFound() *DeregisterHostNotFound {
 	return &DeregisterHostNotFound{}
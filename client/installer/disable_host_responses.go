@@ -29,6 +29,12 @@ func (o *DisableHostReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewDisableHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewDisableHostNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -74,6 +80,39 @@ func (o *DisableHostNoContent) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewDisableHostForbidden creates a DisableHostForbidden with default headers values
+func NewDisableHostForbidden() *DisableHostForbidden {
+	return &DisableHostForbidden{}
+}
+
+/*DisableHostForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type DisableHostForbidden struct {
+	Payload *models.Error
+}
+
+func (o *DisableHostForbidden) Error() string {
+	return fmt.Sprintf("[DELETE /clusters/{cluster_id}/hosts/{host_id}/actions/enable][%d] disableHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DisableHostForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DisableHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDisableHostNotFound creates a DisableHostNotFound with default headers values
 func NewDisableHostNotFound() *DisableHostNotFound {
 	return &DisableHostNotFound{}
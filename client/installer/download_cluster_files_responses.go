@@ -30,6 +30,12 @@ func (o *DownloadClusterFilesReader) ReadResponse(response runtime.ClientRespons
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewDownloadClusterFilesForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewDownloadClusterFilesNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -87,6 +93,39 @@ func (o *DownloadClusterFilesOK) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewDownloadClusterFilesForbidden creates a DownloadClusterFilesForbidden with default headers values
+func NewDownloadClusterFilesForbidden() *DownloadClusterFilesForbidden {
+	return &DownloadClusterFilesForbidden{}
+}
+
+/*DownloadClusterFilesForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation, or the artifact is sensitive and the user lacks the sensitive permission.
+*/
+type DownloadClusterFilesForbidden struct {
+	Payload *models.Error
+}
+
+func (o *DownloadClusterFilesForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/downloads/files][%d] downloadClusterFilesForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DownloadClusterFilesForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadClusterFilesForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDownloadClusterFilesNotFound creates a DownloadClusterFilesNotFound with default headers values
 func NewDownloadClusterFilesNotFound() *DownloadClusterFilesNotFound {
 	return &DownloadClusterFilesNotFound{}
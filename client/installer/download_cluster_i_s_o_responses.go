@@ -36,12 +36,24 @@ func (o *DownloadClusterISOReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewDownloadClusterISOForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewDownloadClusterISONotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return nil, result
+	case 409:
+		result := NewDownloadClusterISOConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewDownloadClusterISOInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -120,6 +132,39 @@ func (o *DownloadClusterISOBadRequest) readResponse(response runtime.ClientRespo
 	return nil
 }
 
+// NewDownloadClusterISOForbidden creates a DownloadClusterISOForbidden with default headers values
+func NewDownloadClusterISOForbidden() *DownloadClusterISOForbidden {
+	return &DownloadClusterISOForbidden{}
+}
+
+/*DownloadClusterISOForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type DownloadClusterISOForbidden struct {
+	Payload *models.Error
+}
+
+func (o *DownloadClusterISOForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/downloads/image][%d] downloadClusterISOForbidden  %+v", 403, o.Payload)
+}
+
+func (o *DownloadClusterISOForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadClusterISOForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDownloadClusterISONotFound creates a DownloadClusterISONotFound with default headers values
 func NewDownloadClusterISONotFound() *DownloadClusterISONotFound {
 	return &DownloadClusterISONotFound{}
@@ -153,6 +198,39 @@ func (o *DownloadClusterISONotFound) readResponse(response runtime.ClientRespons
 	return nil
 }
 
+// NewDownloadClusterISOConflict creates a DownloadClusterISOConflict with default headers values
+func NewDownloadClusterISOConflict() *DownloadClusterISOConflict {
+	return &DownloadClusterISOConflict{}
+}
+
+/*DownloadClusterISOConflict handles this case with default header values.
+
+The image is not ready.
+*/
+type DownloadClusterISOConflict struct {
+	Payload *models.Error
+}
+
+func (o *DownloadClusterISOConflict) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/downloads/image][%d] downloadClusterISOConflict  %+v", 409, o.Payload)
+}
+
+func (o *DownloadClusterISOConflict) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DownloadClusterISOConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewDownloadClusterISOInternalServerError creates a DownloadClusterISOInternalServerError with default headers values
 func NewDownloadClusterISOInternalServerError() *DownloadClusterISOInternalServerError {
 	return &DownloadClusterISOInternalServerError{}
@@ -29,6 +29,12 @@ func (o *EnableHostReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewEnableHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewEnableHostNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -74,6 +80,39 @@ func (o *EnableHostNoContent) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewEnableHostForbidden creates a EnableHostForbidden with default headers values
+func NewEnableHostForbidden() *EnableHostForbidden {
+	return &EnableHostForbidden{}
+}
+
+/*EnableHostForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type EnableHostForbidden struct {
+	Payload *models.Error
+}
+
+func (o *EnableHostForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/enable][%d] enableHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *EnableHostForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *EnableHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewEnableHostNotFound creates a EnableHostNotFound with default headers values
 func NewEnableHostNotFound() *EnableHostNotFound {
 	return &EnableHostNotFound{}
@@ -9,11 +9,8 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-	"github.com/go-openapi/validate"
 
 	"github.com/filanov/bm-inventory/models"
 )
@@ -38,6 +35,12 @@ func (o *GenerateClusterISOReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewGenerateClusterISOForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGenerateClusterISONotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -63,23 +66,23 @@ func NewGenerateClusterISOCreated() *GenerateClusterISOCreated {
 
 /*GenerateClusterISOCreated handles this case with default header values.
 
-Success.
+Success. The image is generated in the background, a previous image generated with identical parameters is returned instead of generating a new one.
 */
 type GenerateClusterISOCreated struct {
-	Payload *GenerateClusterISOCreatedBody
+	Payload *models.Image
 }
 
 func (o *GenerateClusterISOCreated) Error() string {
 	return fmt.Sprintf("[POST /clusters/{cluster_id}/downloads/image][%d] generateClusterISOCreated  %+v", 201, o.Payload)
 }
 
-func (o *GenerateClusterISOCreated) GetPayload() *GenerateClusterISOCreatedBody {
+func (o *GenerateClusterISOCreated) GetPayload() *models.Image {
 	return o.Payload
 }
 
 func (o *GenerateClusterISOCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
-	o.Payload = new(GenerateClusterISOCreatedBody)
+	o.Payload = new(models.Image)
 
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
@@ -122,6 +125,39 @@ func (o *GenerateClusterISOBadRequest) readResponse(response runtime.ClientRespo
 	return nil
 }
 
+// NewGenerateClusterISOForbidden creates a GenerateClusterISOForbidden with default headers values
+func NewGenerateClusterISOForbidden() *GenerateClusterISOForbidden {
+	return &GenerateClusterISOForbidden{}
+}
+
+/*GenerateClusterISOForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type GenerateClusterISOForbidden struct {
+	Payload *models.Error
+}
+
+func (o *GenerateClusterISOForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/downloads/image][%d] generateClusterISOForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GenerateClusterISOForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GenerateClusterISOForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGenerateClusterISONotFound creates a GenerateClusterISONotFound with default headers values
 func NewGenerateClusterISONotFound() *GenerateClusterISONotFound {
 	return &GenerateClusterISONotFound{}
@@ -187,58 +223,3 @@ func (o *GenerateClusterISOInternalServerError) readResponse(response runtime.Cl
 
 	return nil
 }
-
-/*GenerateClusterISOCreatedBody generate cluster i s o created body
-swagger:model GenerateClusterISOCreatedBody
-*/
-type GenerateClusterISOCreatedBody struct {
-
-	// image Id
-	// Format: uuid
-	ImageID strfmt.UUID `json:"imageId,omitempty"`
-}
-
-// Validate validates this generate cluster i s o created body
-func (o *GenerateClusterISOCreatedBody) Validate(formats strfmt.Registry) error {
-	var res []error
-
-	if err := o.validateImageID(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
-
-func (o *GenerateClusterISOCreatedBody) validateImageID(formats strfmt.Registry) error {
-
-	if swag.IsZero(o.ImageID) { // not required
-		return nil
-	}
-
-	if err := validate.FormatOf("generateClusterISOCreated"+"."+"imageId", "body", "uuid", o.ImageID.String(), formats); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (o *GenerateClusterISOCreatedBody) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(o)
-}
-
-// UnmarshalBinary interface implementation
-func (o *GenerateClusterISOCreatedBody) UnmarshalBinary(b []byte) error {
-	var res GenerateClusterISOCreatedBody
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*o = res
-	return nil
-}
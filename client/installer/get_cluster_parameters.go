@@ -14,13 +14,17 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetClusterParams creates a new GetClusterParams object
 // with the default values initialized.
 func NewGetClusterParams() *GetClusterParams {
-	var ()
+	var (
+		withSecretsDefault = bool(false)
+	)
 	return &GetClusterParams{
+		WithSecrets: &withSecretsDefault,
 
 		timeout: cr.DefaultTimeout,
 	}
@@ -29,8 +33,11 @@ func NewGetClusterParams() *GetClusterParams {
 // NewGetClusterParamsWithTimeout creates a new GetClusterParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewGetClusterParamsWithTimeout(timeout time.Duration) *GetClusterParams {
-	var ()
+	var (
+		withSecretsDefault = bool(false)
+	)
 	return &GetClusterParams{
+		WithSecrets: &withSecretsDefault,
 
 		timeout: timeout,
 	}
@@ -39,8 +46,11 @@ func NewGetClusterParamsWithTimeout(timeout time.Duration) *GetClusterParams {
 // NewGetClusterParamsWithContext creates a new GetClusterParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewGetClusterParamsWithContext(ctx context.Context) *GetClusterParams {
-	var ()
+	var (
+		withSecretsDefault = bool(false)
+	)
 	return &GetClusterParams{
+		WithSecrets: &withSecretsDefault,
 
 		Context: ctx,
 	}
@@ -49,9 +59,12 @@ func NewGetClusterParamsWithContext(ctx context.Context) *GetClusterParams {
 // NewGetClusterParamsWithHTTPClient creates a new GetClusterParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewGetClusterParamsWithHTTPClient(client *http.Client) *GetClusterParams {
-	var ()
+	var (
+		withSecretsDefault = bool(false)
+	)
 	return &GetClusterParams{
-		HTTPClient: client,
+		WithSecrets: &withSecretsDefault,
+		HTTPClient:  client,
 	}
 }
 
@@ -62,6 +75,11 @@ type GetClusterParams struct {
 
 	/*ClusterID*/
 	ClusterID strfmt.UUID
+	/*WithSecrets
+	  Return the pull secret and the SSH public key of the cluster, which are redacted otherwise. Requires the write permission.
+
+	*/
+	WithSecrets *bool
 
 	timeout    time.Duration
 	Context    context.Context
@@ -112,6 +130,17 @@ func (o *GetClusterParams) SetClusterID(clusterID strfmt.UUID) {
 	o.ClusterID = clusterID
 }
 
+// WithWithSecrets adds the withSecrets to the get cluster params
+func (o *GetClusterParams) WithWithSecrets(withSecrets *bool) *GetClusterParams {
+	o.SetWithSecrets(withSecrets)
+	return o
+}
+
+// SetWithSecrets adds the withSecrets to the get cluster params
+func (o *GetClusterParams) SetWithSecrets(withSecrets *bool) {
+	o.WithSecrets = withSecrets
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -125,6 +154,22 @@ func (o *GetClusterParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Re
 		return err
 	}
 
+	if o.WithSecrets != nil {
+
+		// query param with_secrets
+		var qrWithSecrets bool
+		if o.WithSecrets != nil {
+			qrWithSecrets = *o.WithSecrets
+		}
+		qWithSecrets := swag.FormatBool(qrWithSecrets)
+		if qWithSecrets != "" {
+			if err := r.SetQueryParam("with_secrets", qWithSecrets); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
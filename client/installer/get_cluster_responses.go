@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/filanov/bm-inventory/models"
 )
@@ -29,6 +31,12 @@ func (o *GetClusterReader) ReadResponse(response runtime.ClientResponse, consume
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewGetClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGetClusterNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -57,6 +65,10 @@ func NewGetClusterOK() *GetClusterOK {
 Success.
 */
 type GetClusterOK struct {
+	/*The resource version the returned state is up to date with, watches resumed from it stream the changes made after it.
+	 */
+	XResourceVersion int64
+
 	Payload *models.Cluster
 }
 
@@ -70,6 +82,13 @@ func (o *GetClusterOK) GetPayload() *models.Cluster {
 
 func (o *GetClusterOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header X-Resource-Version
+	xResourceVersion, err := swag.ConvertInt64(response.GetHeader("X-Resource-Version"))
+	if err != nil {
+		return errors.InvalidType("X-Resource-Version", "header", "int64", response.GetHeader("X-Resource-Version"))
+	}
+	o.XResourceVersion = xResourceVersion
+
 	o.Payload = new(models.Cluster)
 
 	// response payload
@@ -80,6 +99,39 @@ func (o *GetClusterOK) readResponse(response runtime.ClientResponse, consumer ru
 	return nil
 }
 
+// NewGetClusterForbidden creates a GetClusterForbidden with default headers values
+func NewGetClusterForbidden() *GetClusterForbidden {
+	return &GetClusterForbidden{}
+}
+
+/*GetClusterForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type GetClusterForbidden struct {
+	Payload *models.Error
+}
+
+func (o *GetClusterForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}][%d] getClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetClusterForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetClusterNotFound creates a GetClusterNotFound with default headers values
 func NewGetClusterNotFound() *GetClusterNotFound {
 	return &GetClusterNotFound{}
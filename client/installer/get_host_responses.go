@@ -29,6 +29,12 @@ func (o *GetHostReader) ReadResponse(response runtime.ClientResponse, consumer r
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewGetHostForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGetHostNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -80,6 +86,39 @@ func (o *GetHostOK) readResponse(response runtime.ClientResponse, consumer runti
 	return nil
 }
 
+// NewGetHostForbidden creates a GetHostForbidden with default headers values
+func NewGetHostForbidden() *GetHostForbidden {
+	return &GetHostForbidden{}
+}
+
+/*GetHostForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type GetHostForbidden struct {
+	Payload *models.Error
+}
+
+func (o *GetHostForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}][%d] getHostForbidden  %+v", 403, o.Payload)
+}
+
+func (o *GetHostForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetHostForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetHostNotFound creates a GetHostNotFound with default headers values
 func NewGetHostNotFound() *GetHostNotFound {
 	return &GetHostNotFound{}
@@ -60,6 +60,11 @@ for the get next steps operation typically these are written to a http.Request
 */
 type GetNextStepsParams struct {
 
+	/*XAgentToken
+	  The agent token of the cluster, it is embedded in the discovery images of the cluster.
+
+	*/
+	XAgentToken *string
 	/*ClusterID*/
 	ClusterID strfmt.UUID
 	/*HostID*/
@@ -103,6 +108,17 @@ func (o *GetNextStepsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithXAgentToken adds the xAgentToken to the get next steps params
+func (o *GetNextStepsParams) WithXAgentToken(xAgentToken *string) *GetNextStepsParams {
+	o.SetXAgentToken(xAgentToken)
+	return o
+}
+
+// SetXAgentToken adds the xAgentToken to the get next steps params
+func (o *GetNextStepsParams) SetXAgentToken(xAgentToken *string) {
+	o.XAgentToken = xAgentToken
+}
+
 // WithClusterID adds the clusterID to the get next steps params
 func (o *GetNextStepsParams) WithClusterID(clusterID strfmt.UUID) *GetNextStepsParams {
 	o.SetClusterID(clusterID)
@@ -133,6 +149,15 @@ func (o *GetNextStepsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.XAgentToken != nil {
+
+		// header param X-Agent-Token
+		if err := r.SetHeaderParam("X-Agent-Token", *o.XAgentToken); err != nil {
+			return err
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
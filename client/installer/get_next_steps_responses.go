@@ -29,6 +29,12 @@ func (o *GetNextStepsReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return result, nil
+	case 401:
+		result := NewGetNextStepsUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGetNextStepsNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -78,6 +84,39 @@ func (o *GetNextStepsOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewGetNextStepsUnauthorized creates a GetNextStepsUnauthorized with default headers values
+func NewGetNextStepsUnauthorized() *GetNextStepsUnauthorized {
+	return &GetNextStepsUnauthorized{}
+}
+
+/*GetNextStepsUnauthorized handles this case with default header values.
+
+The agent token is missing, revoked or doesn't belong to the cluster.
+*/
+type GetNextStepsUnauthorized struct {
+	Payload *models.Error
+}
+
+func (o *GetNextStepsUnauthorized) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts/{host_id}/instructions][%d] getNextStepsUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *GetNextStepsUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetNextStepsUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetNextStepsNotFound creates a GetNextStepsNotFound with default headers values
 func NewGetNextStepsNotFound() *GetNextStepsNotFound {
 	return &GetNextStepsNotFound{}
@@ -35,6 +35,12 @@ func (o *InstallClusterReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewInstallClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewInstallClusterNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -125,6 +131,39 @@ func (o *InstallClusterBadRequest) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewInstallClusterForbidden creates a InstallClusterForbidden with default headers values
+func NewInstallClusterForbidden() *InstallClusterForbidden {
+	return &InstallClusterForbidden{}
+}
+
+/*InstallClusterForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type InstallClusterForbidden struct {
+	Payload *models.Error
+}
+
+func (o *InstallClusterForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/actions/install][%d] installClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *InstallClusterForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *InstallClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewInstallClusterNotFound creates a InstallClusterNotFound with default headers values
 func NewInstallClusterNotFound() *InstallClusterNotFound {
 	return &InstallClusterNotFound{}
@@ -18,20 +18,30 @@ import (
 
 // API is the interface of the installer client
 type API interface {
+	/*
+	   DeleteClusterImage deletes an open shift per cluster discovery i s o*/
+	DeleteClusterImage(ctx context.Context, params *DeleteClusterImageParams) (*DeleteClusterImageNoContent, error)
 	/*
 	   DeregisterCluster deletes an open shift bare metal cluster definition*/
 	DeregisterCluster(ctx context.Context, params *DeregisterClusterParams) (*DeregisterClusterNoContent, error)
 	/*
 	   DeregisterHost deregisters an open shift bare metal host*/
 	DeregisterHost(ctx context.Context, params *DeregisterHostParams) (*DeregisterHostNoContent, error)
+	/*
+	   DeregisterWebhook deletes a webhook subscription and its delivery history*/
+	DeregisterWebhook(ctx context.Context, params *DeregisterWebhookParams) (*DeregisterWebhookNoContent, error)
 	/*
 	   DisableHost disables a host for inclusion in the cluster*/
 	DisableHost(ctx context.Context, params *DisableHostParams) (*DisableHostNoContent, error)
 	/*
-	   DownloadClusterFiles downloads files relating to the installed installing cluster*/
+	   DownloadClusterFiles downloads files relating to the installed installing cluster
+
+	   Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.*/
 	DownloadClusterFiles(ctx context.Context, params *DownloadClusterFilesParams, writer io.Writer) (*DownloadClusterFilesOK, error)
 	/*
-	   DownloadClusterISO downloads the open shift per cluster discovery i s o*/
+	   DownloadClusterISO downloads the open shift per cluster discovery i s o
+
+	   Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.*/
 	DownloadClusterISO(ctx context.Context, params *DownloadClusterISOParams, writer io.Writer) (*DownloadClusterISOOK, error)
 	/*
 	   EnableHost enables a host for inclusion in the cluster*/
@@ -42,6 +52,17 @@ type API interface {
 	/*
 	   GetCluster retrieves the details of the open shift bare metal cluster*/
 	GetCluster(ctx context.Context, params *GetClusterParams) (*GetClusterOK, error)
+	/*
+	   GetClusterImage retrieves the generation status of an open shift per cluster discovery i s o*/
+	GetClusterImage(ctx context.Context, params *GetClusterImageParams) (*GetClusterImageOK, error)
+	/*
+	   GetFreeAddresses retrieves the free addresses of the machine networks of the cluster which are candidates for the v i ps
+
+	   The addresses are found free by a scan of the machine networks made by one of the cluster hosts, the addresses of the hosts and the VIPs already set are excluded.*/
+	GetFreeAddresses(ctx context.Context, params *GetFreeAddressesParams) (*GetFreeAddressesOK, error)
+	/*
+	   GetHardwareProfile retrieves the details of a hardware requirement profile*/
+	GetHardwareProfile(ctx context.Context, params *GetHardwareProfileParams) (*GetHardwareProfileOK, error)
 	/*
 	   GetHost retrieves the details of the open shift bare metal host*/
 	GetHost(ctx context.Context, params *GetHostParams) (*GetHostOK, error)
@@ -51,12 +72,30 @@ type API interface {
 	/*
 	   InstallCluster installs the open shift bare metal cluster*/
 	InstallCluster(ctx context.Context, params *InstallClusterParams) (*InstallClusterOK, error)
+	/*
+	   ListClusterArtifacts retrieves the list of files generated for the installed installing cluster that can be downloaded*/
+	ListClusterArtifacts(ctx context.Context, params *ListClusterArtifactsParams) (*ListClusterArtifactsOK, error)
+	/*
+	   ListClusterEvents retrieves the state transitions of the cluster and its hosts ordered by the time they occurred at*/
+	ListClusterEvents(ctx context.Context, params *ListClusterEventsParams) (*ListClusterEventsOK, error)
+	/*
+	   ListClusterImages retrieves the list of open shift per cluster discovery i s os*/
+	ListClusterImages(ctx context.Context, params *ListClusterImagesParams) (*ListClusterImagesOK, error)
 	/*
 	   ListClusters retrieves the list of open shift bare metal clusters*/
 	ListClusters(ctx context.Context, params *ListClustersParams) (*ListClustersOK, error)
+	/*
+	   ListHardwareProfiles retrieves the list of hardware requirement profiles that clusters can be validated against*/
+	ListHardwareProfiles(ctx context.Context, params *ListHardwareProfilesParams) (*ListHardwareProfilesOK, error)
 	/*
 	   ListHosts retrieves the list of open shift bare metal hosts*/
 	ListHosts(ctx context.Context, params *ListHostsParams) (*ListHostsOK, error)
+	/*
+	   ListWebhookDeliveries retrieves the delivery history of a webhook subscription ordered by the time the deliveries were created at*/
+	ListWebhookDeliveries(ctx context.Context, params *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesOK, error)
+	/*
+	   ListWebhooks retrieves the list of webhook subscriptions*/
+	ListWebhooks(ctx context.Context, params *ListWebhooksParams) (*ListWebhooksOK, error)
 	/*
 	   PostStepReply posts the result of the operations from the host agent*/
 	PostStepReply(ctx context.Context, params *PostStepReplyParams) (*PostStepReplyNoContent, error)
@@ -66,15 +105,27 @@ type API interface {
 	/*
 	   RegisterHost registers a new open shift bare metal host*/
 	RegisterHost(ctx context.Context, params *RegisterHostParams) (*RegisterHostCreated, error)
+	/*
+	   RegisterWebhook subscribes a webhook to notifications of cluster and host lifecycle events*/
+	RegisterWebhook(ctx context.Context, params *RegisterWebhookParams) (*RegisterWebhookCreated, error)
+	/*
+	   RevokeAgentToken revokes the agent tokens of all the discovery images of the cluster a new token is embedded in the next discovery image that is generated*/
+	RevokeAgentToken(ctx context.Context, params *RevokeAgentTokenParams) (*RevokeAgentTokenNoContent, error)
 	/*
 	   SetDebugStep sets a single shot debug step that will be sent next time the host agent will ask for a command*/
 	SetDebugStep(ctx context.Context, params *SetDebugStepParams) (*SetDebugStepNoContent, error)
+	/*
+	   SetHostInstallationDisk sets the disk the host will be installed on instead of the one chosen by the default selection policy*/
+	SetHostInstallationDisk(ctx context.Context, params *SetHostInstallationDiskParams) (*SetHostInstallationDiskOK, error)
 	/*
 	   UpdateCluster updates an open shift bare metal cluster definition*/
 	UpdateCluster(ctx context.Context, params *UpdateClusterParams) (*UpdateClusterCreated, error)
 	/*
 	   UpdateHostInstallProgress updates installation progress*/
 	UpdateHostInstallProgress(ctx context.Context, params *UpdateHostInstallProgressParams) (*UpdateHostInstallProgressOK, error)
+	/*
+	   WatchCluster streams the state transitions of the cluster and its hosts as they are committed*/
+	WatchCluster(ctx context.Context, params *WatchClusterParams) (*WatchClusterOK, error)
 }
 
 // New creates a new installer API client.
@@ -95,6 +146,31 @@ type Client struct {
 	authInfo  runtime.ClientAuthInfoWriter
 }
 
+/*
+DeleteClusterImage deletes an open shift per cluster discovery i s o
+*/
+func (a *Client) DeleteClusterImage(ctx context.Context, params *DeleteClusterImageParams) (*DeleteClusterImageNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteClusterImage",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/images/{image_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteClusterImageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteClusterImageNoContent), nil
+
+}
+
 /*
 DeregisterCluster deletes an open shift bare metal cluster definition
 */
@@ -109,6 +185,7 @@ func (a *Client) DeregisterCluster(ctx context.Context, params *DeregisterCluste
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &DeregisterClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -133,6 +210,7 @@ func (a *Client) DeregisterHost(ctx context.Context, params *DeregisterHostParam
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &DeregisterHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -143,6 +221,31 @@ func (a *Client) DeregisterHost(ctx context.Context, params *DeregisterHostParam
 
 }
 
+/*
+DeregisterWebhook deletes a webhook subscription and its delivery history
+*/
+func (a *Client) DeregisterWebhook(ctx context.Context, params *DeregisterWebhookParams) (*DeregisterWebhookNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeregisterWebhook",
+		Method:             "DELETE",
+		PathPattern:        "/webhooks/{webhook_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeregisterWebhookReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeregisterWebhookNoContent), nil
+
+}
+
 /*
 DisableHost disables a host for inclusion in the cluster
 */
@@ -157,6 +260,7 @@ func (a *Client) DisableHost(ctx context.Context, params *DisableHostParams) (*D
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &DisableHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -169,6 +273,8 @@ func (a *Client) DisableHost(ctx context.Context, params *DisableHostParams) (*D
 
 /*
 DownloadClusterFiles downloads files relating to the installed installing cluster
+
+Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.
 */
 func (a *Client) DownloadClusterFiles(ctx context.Context, params *DownloadClusterFilesParams, writer io.Writer) (*DownloadClusterFilesOK, error) {
 
@@ -181,6 +287,7 @@ func (a *Client) DownloadClusterFiles(ctx context.Context, params *DownloadClust
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &DownloadClusterFilesReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -193,6 +300,8 @@ func (a *Client) DownloadClusterFiles(ctx context.Context, params *DownloadClust
 
 /*
 DownloadClusterISO downloads the open shift per cluster discovery i s o
+
+Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.
 */
 func (a *Client) DownloadClusterISO(ctx context.Context, params *DownloadClusterISOParams, writer io.Writer) (*DownloadClusterISOOK, error) {
 
@@ -205,6 +314,7 @@ func (a *Client) DownloadClusterISO(ctx context.Context, params *DownloadCluster
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &DownloadClusterISOReader{formats: a.formats, writer: writer},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -229,6 +339,7 @@ func (a *Client) EnableHost(ctx context.Context, params *EnableHostParams) (*Ena
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &EnableHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -253,6 +364,7 @@ func (a *Client) GenerateClusterISO(ctx context.Context, params *GenerateCluster
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &GenerateClusterISOReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -277,6 +389,7 @@ func (a *Client) GetCluster(ctx context.Context, params *GetClusterParams) (*Get
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &GetClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -287,6 +400,82 @@ func (a *Client) GetCluster(ctx context.Context, params *GetClusterParams) (*Get
 
 }
 
+/*
+GetClusterImage retrieves the generation status of an open shift per cluster discovery i s o
+*/
+func (a *Client) GetClusterImage(ctx context.Context, params *GetClusterImageParams) (*GetClusterImageOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetClusterImage",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/images/{image_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetClusterImageReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetClusterImageOK), nil
+
+}
+
+/*GetFreeAddresses retrieves the free addresses of the machine networks of the cluster which are candidates for the v i ps
+
+The addresses are found free by a scan of the machine networks made by one of the cluster hosts, the addresses of the hosts and the VIPs already set are excluded.
+*/
+func (a *Client) GetFreeAddresses(ctx context.Context, params *GetFreeAddressesParams) (*GetFreeAddressesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetFreeAddresses",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/free_addresses",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetFreeAddressesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetFreeAddressesOK), nil
+
+}
+
+/*
+GetHardwareProfile retrieves the details of a hardware requirement profile
+*/
+func (a *Client) GetHardwareProfile(ctx context.Context, params *GetHardwareProfileParams) (*GetHardwareProfileOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "GetHardwareProfile",
+		Method:             "GET",
+		PathPattern:        "/hardware_profiles/{profile_name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetHardwareProfileReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetHardwareProfileOK), nil
+
+}
+
 /*
 GetHost retrieves the details of the open shift bare metal host
 */
@@ -301,6 +490,7 @@ func (a *Client) GetHost(ctx context.Context, params *GetHostParams) (*GetHostOK
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &GetHostReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -349,6 +539,7 @@ func (a *Client) InstallCluster(ctx context.Context, params *InstallClusterParam
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &InstallClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -359,6 +550,81 @@ func (a *Client) InstallCluster(ctx context.Context, params *InstallClusterParam
 
 }
 
+/*
+ListClusterArtifacts retrieves the list of files generated for the installed installing cluster that can be downloaded
+*/
+func (a *Client) ListClusterArtifacts(ctx context.Context, params *ListClusterArtifactsParams) (*ListClusterArtifactsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterArtifacts",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/artifacts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListClusterArtifactsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterArtifactsOK), nil
+
+}
+
+/*
+ListClusterEvents retrieves the state transitions of the cluster and its hosts ordered by the time they occurred at
+*/
+func (a *Client) ListClusterEvents(ctx context.Context, params *ListClusterEventsParams) (*ListClusterEventsOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterEvents",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/events",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListClusterEventsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterEventsOK), nil
+
+}
+
+/*
+ListClusterImages retrieves the list of open shift per cluster discovery i s os
+*/
+func (a *Client) ListClusterImages(ctx context.Context, params *ListClusterImagesParams) (*ListClusterImagesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListClusterImages",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/images",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListClusterImagesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListClusterImagesOK), nil
+
+}
+
 /*
 ListClusters retrieves the list of open shift bare metal clusters
 */
@@ -373,6 +639,7 @@ func (a *Client) ListClusters(ctx context.Context, params *ListClustersParams) (
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &ListClustersReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -383,6 +650,31 @@ func (a *Client) ListClusters(ctx context.Context, params *ListClustersParams) (
 
 }
 
+/*
+ListHardwareProfiles retrieves the list of hardware requirement profiles that clusters can be validated against
+*/
+func (a *Client) ListHardwareProfiles(ctx context.Context, params *ListHardwareProfilesParams) (*ListHardwareProfilesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListHardwareProfiles",
+		Method:             "GET",
+		PathPattern:        "/hardware_profiles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListHardwareProfilesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListHardwareProfilesOK), nil
+
+}
+
 /*
 ListHosts retrieves the list of open shift bare metal hosts
 */
@@ -397,6 +689,7 @@ func (a *Client) ListHosts(ctx context.Context, params *ListHostsParams) (*ListH
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &ListHostsReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -407,6 +700,56 @@ func (a *Client) ListHosts(ctx context.Context, params *ListHostsParams) (*ListH
 
 }
 
+/*
+ListWebhookDeliveries retrieves the delivery history of a webhook subscription ordered by the time the deliveries were created at
+*/
+func (a *Client) ListWebhookDeliveries(ctx context.Context, params *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListWebhookDeliveries",
+		Method:             "GET",
+		PathPattern:        "/webhooks/{webhook_id}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListWebhookDeliveriesReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListWebhookDeliveriesOK), nil
+
+}
+
+/*
+ListWebhooks retrieves the list of webhook subscriptions
+*/
+func (a *Client) ListWebhooks(ctx context.Context, params *ListWebhooksParams) (*ListWebhooksOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListWebhooks",
+		Method:             "GET",
+		PathPattern:        "/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListWebhooksReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListWebhooksOK), nil
+
+}
+
 /*
 PostStepReply posts the result of the operations from the host agent
 */
@@ -445,6 +788,7 @@ func (a *Client) RegisterCluster(ctx context.Context, params *RegisterClusterPar
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &RegisterClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -479,6 +823,56 @@ func (a *Client) RegisterHost(ctx context.Context, params *RegisterHostParams) (
 
 }
 
+/*
+RegisterWebhook subscribes a webhook to notifications of cluster and host lifecycle events
+*/
+func (a *Client) RegisterWebhook(ctx context.Context, params *RegisterWebhookParams) (*RegisterWebhookCreated, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RegisterWebhook",
+		Method:             "POST",
+		PathPattern:        "/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RegisterWebhookReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegisterWebhookCreated), nil
+
+}
+
+/*
+RevokeAgentToken revokes the agent tokens of all the discovery images of the cluster a new token is embedded in the next discovery image that is generated
+*/
+func (a *Client) RevokeAgentToken(ctx context.Context, params *RevokeAgentTokenParams) (*RevokeAgentTokenNoContent, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "RevokeAgentToken",
+		Method:             "DELETE",
+		PathPattern:        "/clusters/{cluster_id}/agent-token",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RevokeAgentTokenReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RevokeAgentTokenNoContent), nil
+
+}
+
 /*
 SetDebugStep sets a single shot debug step that will be sent next time the host agent will ask for a command
 */
@@ -493,6 +887,7 @@ func (a *Client) SetDebugStep(ctx context.Context, params *SetDebugStepParams) (
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &SetDebugStepReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -503,6 +898,31 @@ func (a *Client) SetDebugStep(ctx context.Context, params *SetDebugStepParams) (
 
 }
 
+/*
+SetHostInstallationDisk sets the disk the host will be installed on instead of the one chosen by the default selection policy
+*/
+func (a *Client) SetHostInstallationDisk(ctx context.Context, params *SetHostInstallationDiskParams) (*SetHostInstallationDiskOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SetHostInstallationDisk",
+		Method:             "PUT",
+		PathPattern:        "/clusters/{cluster_id}/hosts/{host_id}/installation_disk",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetHostInstallationDiskReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SetHostInstallationDiskOK), nil
+
+}
+
 /*
 UpdateCluster updates an open shift bare metal cluster definition
 */
@@ -517,6 +937,7 @@ func (a *Client) UpdateCluster(ctx context.Context, params *UpdateClusterParams)
 		Schemes:            []string{"http"},
 		Params:             params,
 		Reader:             &UpdateClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
 		Context:            ctx,
 		Client:             params.HTTPClient,
 	})
@@ -550,3 +971,28 @@ func (a *Client) UpdateHostInstallProgress(ctx context.Context, params *UpdateHo
 	return result.(*UpdateHostInstallProgressOK), nil
 
 }
+
+/*
+WatchCluster streams the state transitions of the cluster and its hosts as they are committed
+*/
+func (a *Client) WatchCluster(ctx context.Context, params *WatchClusterParams) (*WatchClusterOK, error) {
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "WatchCluster",
+		Method:             "GET",
+		PathPattern:        "/clusters/{cluster_id}/watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WatchClusterReader{formats: a.formats},
+		AuthInfo:           a.authInfo,
+		Context:            ctx,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WatchClusterOK), nil
+
+}
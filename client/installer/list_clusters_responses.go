@@ -29,6 +29,12 @@ func (o *ListClustersReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewListClustersForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewListClustersInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -72,6 +78,39 @@ func (o *ListClustersOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewListClustersForbidden creates a ListClustersForbidden with default headers values
+func NewListClustersForbidden() *ListClustersForbidden {
+	return &ListClustersForbidden{}
+}
+
+/*ListClustersForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type ListClustersForbidden struct {
+	Payload *models.Error
+}
+
+func (o *ListClustersForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters][%d] listClustersForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListClustersForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListClustersForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListClustersInternalServerError creates a ListClustersInternalServerError with default headers values
 func NewListClustersInternalServerError() *ListClustersInternalServerError {
 	return &ListClustersInternalServerError{}
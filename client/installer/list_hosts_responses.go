@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/filanov/bm-inventory/models"
 )
@@ -29,6 +31,18 @@ func (o *ListHostsReader) ReadResponse(response runtime.ClientResponse, consumer
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewListHostsForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewListHostsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewListHostsInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -51,6 +65,10 @@ func NewListHostsOK() *ListHostsOK {
 Success.
 */
 type ListHostsOK struct {
+	/*The resource version the returned state is up to date with, watches resumed from it stream the changes made after it.
+	 */
+	XResourceVersion int64
+
 	Payload models.HostList
 }
 
@@ -64,6 +82,13 @@ func (o *ListHostsOK) GetPayload() models.HostList {
 
 func (o *ListHostsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// response header X-Resource-Version
+	xResourceVersion, err := swag.ConvertInt64(response.GetHeader("X-Resource-Version"))
+	if err != nil {
+		return errors.InvalidType("X-Resource-Version", "header", "int64", response.GetHeader("X-Resource-Version"))
+	}
+	o.XResourceVersion = xResourceVersion
+
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
 		return err
@@ -72,6 +97,72 @@ func (o *ListHostsOK) readResponse(response runtime.ClientResponse, consumer run
 	return nil
 }
 
+// NewListHostsForbidden creates a ListHostsForbidden with default headers values
+func NewListHostsForbidden() *ListHostsForbidden {
+	return &ListHostsForbidden{}
+}
+
+/*ListHostsForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type ListHostsForbidden struct {
+	Payload *models.Error
+}
+
+func (o *ListHostsForbidden) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts][%d] listHostsForbidden  %+v", 403, o.Payload)
+}
+
+func (o *ListHostsForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListHostsForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListHostsNotFound creates a ListHostsNotFound with default headers values
+func NewListHostsNotFound() *ListHostsNotFound {
+	return &ListHostsNotFound{}
+}
+
+/*ListHostsNotFound handles this case with default header values.
+
+Error.
+*/
+type ListHostsNotFound struct {
+	Payload *models.Error
+}
+
+func (o *ListHostsNotFound) Error() string {
+	return fmt.Sprintf("[GET /clusters/{cluster_id}/hosts][%d] listHostsNotFound  %+v", 404, o.Payload)
+}
+
+func (o *ListHostsNotFound) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListHostsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListHostsInternalServerError creates a ListHostsInternalServerError with default headers values
 func NewListHostsInternalServerError() *ListHostsInternalServerError {
 	return &ListHostsInternalServerError{}
@@ -62,6 +62,11 @@ for the post step reply operation typically these are written to a http.Request
 */
 type PostStepReplyParams struct {
 
+	/*XAgentToken
+	  The agent token of the cluster, it is embedded in the discovery images of the cluster.
+
+	*/
+	XAgentToken *string
 	/*ClusterID*/
 	ClusterID strfmt.UUID
 	/*HostID*/
@@ -107,6 +112,17 @@ func (o *PostStepReplyParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithXAgentToken adds the xAgentToken to the post step reply params
+func (o *PostStepReplyParams) WithXAgentToken(xAgentToken *string) *PostStepReplyParams {
+	o.SetXAgentToken(xAgentToken)
+	return o
+}
+
+// SetXAgentToken adds the xAgentToken to the post step reply params
+func (o *PostStepReplyParams) SetXAgentToken(xAgentToken *string) {
+	o.XAgentToken = xAgentToken
+}
+
 // WithClusterID adds the clusterID to the post step reply params
 func (o *PostStepReplyParams) WithClusterID(clusterID strfmt.UUID) *PostStepReplyParams {
 	o.SetClusterID(clusterID)
@@ -148,6 +164,15 @@ func (o *PostStepReplyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 	}
 	var res []error
 
+	if o.XAgentToken != nil {
+
+		// header param X-Agent-Token
+		if err := r.SetHeaderParam("X-Agent-Token", *o.XAgentToken); err != nil {
+			return err
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
@@ -35,6 +35,12 @@ func (o *PostStepReplyReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 401:
+		result := NewPostStepReplyUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewPostStepReplyNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -107,6 +113,39 @@ func (o *PostStepReplyBadRequest) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewPostStepReplyUnauthorized creates a PostStepReplyUnauthorized with default headers values
+func NewPostStepReplyUnauthorized() *PostStepReplyUnauthorized {
+	return &PostStepReplyUnauthorized{}
+}
+
+/*PostStepReplyUnauthorized handles this case with default header values.
+
+The agent token is missing, revoked or doesn't belong to the cluster.
+*/
+type PostStepReplyUnauthorized struct {
+	Payload *models.Error
+}
+
+func (o *PostStepReplyUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/instructions][%d] postStepReplyUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *PostStepReplyUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *PostStepReplyUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewPostStepReplyNotFound creates a PostStepReplyNotFound with default headers values
 func NewPostStepReplyNotFound() *PostStepReplyNotFound {
 	return &PostStepReplyNotFound{}
@@ -35,6 +35,12 @@ func (o *RegisterClusterReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewRegisterClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewRegisterClusterInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -113,6 +119,39 @@ func (o *RegisterClusterBadRequest) readResponse(response runtime.ClientResponse
 	return nil
 }
 
+// NewRegisterClusterForbidden creates a RegisterClusterForbidden with default headers values
+func NewRegisterClusterForbidden() *RegisterClusterForbidden {
+	return &RegisterClusterForbidden{}
+}
+
+/*RegisterClusterForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type RegisterClusterForbidden struct {
+	Payload *models.Error
+}
+
+func (o *RegisterClusterForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters][%d] registerClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *RegisterClusterForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RegisterClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewRegisterClusterInternalServerError creates a RegisterClusterInternalServerError with default headers values
 func NewRegisterClusterInternalServerError() *RegisterClusterInternalServerError {
 	return &RegisterClusterInternalServerError{}
@@ -62,6 +62,11 @@ for the register host operation typically these are written to a http.Request
 */
 type RegisterHostParams struct {
 
+	/*XAgentToken
+	  The agent token of the cluster, it is embedded in the discovery images of the cluster.
+
+	*/
+	XAgentToken *string
 	/*ClusterID*/
 	ClusterID strfmt.UUID
 	/*NewHostParams*/
@@ -105,6 +110,17 @@ func (o *RegisterHostParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithXAgentToken adds the xAgentToken to the register host params
+func (o *RegisterHostParams) WithXAgentToken(xAgentToken *string) *RegisterHostParams {
+	o.SetXAgentToken(xAgentToken)
+	return o
+}
+
+// SetXAgentToken adds the xAgentToken to the register host params
+func (o *RegisterHostParams) SetXAgentToken(xAgentToken *string) {
+	o.XAgentToken = xAgentToken
+}
+
 // WithClusterID adds the clusterID to the register host params
 func (o *RegisterHostParams) WithClusterID(clusterID strfmt.UUID) *RegisterHostParams {
 	o.SetClusterID(clusterID)
@@ -135,6 +151,15 @@ func (o *RegisterHostParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.XAgentToken != nil {
+
+		// header param X-Agent-Token
+		if err := r.SetHeaderParam("X-Agent-Token", *o.XAgentToken); err != nil {
+			return err
+		}
+
+	}
+
 	// path param cluster_id
 	if err := r.SetPathParam("cluster_id", o.ClusterID.String()); err != nil {
 		return err
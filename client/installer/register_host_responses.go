@@ -35,6 +35,12 @@ func (o *RegisterHostReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 401:
+		result := NewRegisterHostUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewRegisterHostInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -113,6 +119,39 @@ func (o *RegisterHostBadRequest) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewRegisterHostUnauthorized creates a RegisterHostUnauthorized with default headers values
+func NewRegisterHostUnauthorized() *RegisterHostUnauthorized {
+	return &RegisterHostUnauthorized{}
+}
+
+/*RegisterHostUnauthorized handles this case with default header values.
+
+The agent token is missing, revoked or doesn't belong to the cluster.
+*/
+type RegisterHostUnauthorized struct {
+	Payload *models.Error
+}
+
+func (o *RegisterHostUnauthorized) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts][%d] registerHostUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *RegisterHostUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RegisterHostUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewRegisterHostInternalServerError creates a RegisterHostInternalServerError with default headers values
 func NewRegisterHostInternalServerError() *RegisterHostInternalServerError {
 	return &RegisterHostInternalServerError{}
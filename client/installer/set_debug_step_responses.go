@@ -29,6 +29,12 @@ func (o *SetDebugStepReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return result, nil
+	case 403:
+		result := NewSetDebugStepForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewSetDebugStepNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -68,6 +74,39 @@ func (o *SetDebugStepNoContent) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewSetDebugStepForbidden creates a SetDebugStepForbidden with default headers values
+func NewSetDebugStepForbidden() *SetDebugStepForbidden {
+	return &SetDebugStepForbidden{}
+}
+
+/*SetDebugStepForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type SetDebugStepForbidden struct {
+	Payload *models.Error
+}
+
+func (o *SetDebugStepForbidden) Error() string {
+	return fmt.Sprintf("[POST /clusters/{cluster_id}/hosts/{host_id}/actions/debug][%d] setDebugStepForbidden  %+v", 403, o.Payload)
+}
+
+func (o *SetDebugStepForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetDebugStepForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewSetDebugStepNotFound creates a SetDebugStepNotFound with default headers values
 func NewSetDebugStepNotFound() *SetDebugStepNotFound {
 	return &SetDebugStepNotFound{}
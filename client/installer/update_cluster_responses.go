@@ -35,6 +35,12 @@ func (o *UpdateClusterReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 403:
+		result := NewUpdateClusterForbidden()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewUpdateClusterNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -125,6 +131,39 @@ func (o *UpdateClusterBadRequest) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewUpdateClusterForbidden creates a UpdateClusterForbidden with default headers values
+func NewUpdateClusterForbidden() *UpdateClusterForbidden {
+	return &UpdateClusterForbidden{}
+}
+
+/*UpdateClusterForbidden handles this case with default header values.
+
+The user is not permitted to perform the operation.
+*/
+type UpdateClusterForbidden struct {
+	Payload *models.Error
+}
+
+func (o *UpdateClusterForbidden) Error() string {
+	return fmt.Sprintf("[PATCH /clusters/{cluster_id}][%d] updateClusterForbidden  %+v", 403, o.Payload)
+}
+
+func (o *UpdateClusterForbidden) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateClusterForbidden) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateClusterNotFound creates a UpdateClusterNotFound with default headers values
 func NewUpdateClusterNotFound() *UpdateClusterNotFound {
 	return &UpdateClusterNotFound{}
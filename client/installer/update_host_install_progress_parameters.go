@@ -62,6 +62,11 @@ for the update host install progress operation typically these are written to a
 */
 type UpdateHostInstallProgressParams struct {
 
+	/*XAgentToken
+	  The agent token of the cluster, it is embedded in the discovery images of the cluster.
+
+	*/
+	XAgentToken *string
 	/*ClusterID
 	  The ID of the cluster to retrieve
 
@@ -116,6 +121,17 @@ func (o *UpdateHostInstallProgressParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithXAgentToken adds the xAgentToken to the update host install progress params
+func (o *UpdateHostInstallProgressParams) WithXAgentToken(xAgentToken *string) *UpdateHostInstallProgressParams {
+	o.SetXAgentToken(xAgentToken)
+	return o
+}
+
+// SetXAgentToken adds the xAgentToken to the update host install progress params
+func (o *UpdateHostInstallProgressParams) SetXAgentToken(xAgentToken *string) {
+	o.XAgentToken = xAgentToken
+}
+
 // WithClusterID adds the clusterID to the update host install progress params
 func (o *UpdateHostInstallProgressParams) WithClusterID(clusterID strfmt.UUID) *UpdateHostInstallProgressParams {
 	o.SetClusterID(clusterID)
@@ -157,6 +173,15 @@ func (o *UpdateHostInstallProgressParams) WriteToRequest(r runtime.ClientRequest
 	}
 	var res []error
 
+	if o.XAgentToken != nil {
+
+		// header param X-Agent-Token
+		if err := r.SetHeaderParam("X-Agent-Token", *o.XAgentToken); err != nil {
+			return err
+		}
+
+	}
+
 	// path param clusterId
 	if err := r.SetPathParam("clusterId", o.ClusterID.String()); err != nil {
 		return err
@@ -7,9 +7,12 @@ package installer
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+
+	"github.com/filanov/bm-inventory/models"
 )
 
 // UpdateHostInstallProgressReader is a Reader for the UpdateHostInstallProgress structure.
@@ -26,6 +29,18 @@ func (o *UpdateHostInstallProgressReader) ReadResponse(response runtime.ClientRe
 			return nil, err
 		}
 		return result, nil
+	case 401:
+		result := NewUpdateHostInstallProgressUnauthorized()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 500:
+		result := NewUpdateHostInstallProgressInternalServerError()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 
 	default:
 		return nil, runtime.NewAPIError("unknown error", response, response.Code())
@@ -52,3 +67,69 @@ func (o *UpdateHostInstallProgressOK) readResponse(response runtime.ClientRespon
 
 	return nil
 }
+
+// NewUpdateHostInstallProgressUnauthorized creates a UpdateHostInstallProgressUnauthorized with default headers values
+func NewUpdateHostInstallProgressUnauthorized() *UpdateHostInstallProgressUnauthorized {
+	return &UpdateHostInstallProgressUnauthorized{}
+}
+
+/*UpdateHostInstallProgressUnauthorized handles this case with default header values.
+
+The agent token is missing, revoked or doesn't belong to the cluster.
+*/
+type UpdateHostInstallProgressUnauthorized struct {
+	Payload *models.Error
+}
+
+func (o *UpdateHostInstallProgressUnauthorized) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{clusterId}/hosts/{hostId}/progress][%d] updateHostInstallProgressUnauthorized  %+v", 401, o.Payload)
+}
+
+func (o *UpdateHostInstallProgressUnauthorized) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateHostInstallProgressUnauthorized) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateHostInstallProgressInternalServerError creates a UpdateHostInstallProgressInternalServerError with default headers values
+func NewUpdateHostInstallProgressInternalServerError() *UpdateHostInstallProgressInternalServerError {
+	return &UpdateHostInstallProgressInternalServerError{}
+}
+
+/*UpdateHostInstallProgressInternalServerError handles this case with default header values.
+
+Error.
+*/
+type UpdateHostInstallProgressInternalServerError struct {
+	Payload *models.Error
+}
+
+func (o *UpdateHostInstallProgressInternalServerError) Error() string {
+	return fmt.Sprintf("[PUT /clusters/{clusterId}/hosts/{hostId}/progress][%d] updateHostInstallProgressInternalServerError  %+v", 500, o.Payload)
+}
+
+func (o *UpdateHostInstallProgressInternalServerError) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateHostInstallProgressInternalServerError) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
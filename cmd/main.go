@@ -1,23 +1,39 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/filanov/bm-inventory/internal/cluster"
 
 	"github.com/filanov/bm-inventory/internal/bminventory"
 	"github.com/filanov/bm-inventory/internal/hardware"
 	"github.com/filanov/bm-inventory/internal/host"
-	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/internal/image"
+	"github.com/filanov/bm-inventory/internal/keyrotation"
+	"github.com/filanov/bm-inventory/internal/migrations"
+	"github.com/filanov/bm-inventory/internal/retention"
+	"github.com/filanov/bm-inventory/internal/webhooks"
+	"github.com/filanov/bm-inventory/pkg/auth"
+	"github.com/filanov/bm-inventory/pkg/database"
+	"github.com/filanov/bm-inventory/pkg/filemiddleware"
 	"github.com/filanov/bm-inventory/pkg/job"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/filanov/bm-inventory/pkg/objectstore"
 	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/filanov/bm-inventory/pkg/secret"
+	"github.com/filanov/bm-inventory/pkg/thread"
 	"github.com/filanov/bm-inventory/restapi"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
-	"github.com/jinzhu/gorm"
-	_ "github.com/jinzhu/gorm/dialects/mysql"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -31,30 +47,46 @@ func init() {
 }
 
 var Options struct {
-	BMConfig          bminventory.Config
-	DBHost            string `envconfig:"DB_HOST" default:"mariadb"`
-	DBPort            string `envconfig:"DB_PORT" default:"3306"`
-	HWValidatorConfig hardware.ValidatorCfg
-	JobConfig         job.Config
-	InstructionConfig host.InstructionConfig
+	BMConfig                    bminventory.Config
+	StorageConfig               objectstore.Config
+	RetentionConfig             retention.Config
+	DBConfig                    database.Config
+	MigrationConfig             migrations.Config
+	HWValidatorConfig           hardware.ValidatorCfg
+	JobConfig                   job.Config
+	InstructionConfig           host.InstructionConfig
+	LeaderConfig                leader.Config
+	WebhookConfig               webhooks.Config
+	AuthConfig                  auth.Config
+	EncryptionConfig            secret.Config
+	KeyRotationConfig           keyrotation.Config
+	ClusterStateMonitorInterval time.Duration `envconfig:"CLUSTER_MONITOR_INTERVAL" default:"10s"`
+	HostStateMonitorInterval    time.Duration `envconfig:"HOST_MONITOR_INTERVAL" default:"8s"`
+	ImageMonitorInterval        time.Duration `envconfig:"IMAGE_MONITOR_INTERVAL" default:"5s"`
+	RetentionInterval           time.Duration `envconfig:"RETENTION_INTERVAL" default:"10m"`
+	WebhookDeliveryInterval     time.Duration `envconfig:"WEBHOOK_DELIVERY_INTERVAL" default:"5s"`
+	KeyRotationInterval         time.Duration `envconfig:"KEY_ROTATION_INTERVAL" default:"10m"`
 }
 
 func main() {
 	log := logrus.New()
+	log.SetFormatter(&logutil.ScrubbingFormatter{Formatter: log.Formatter})
+	logrus.SetFormatter(&logutil.ScrubbingFormatter{Formatter: logrus.StandardLogger().Formatter})
 	err := envconfig.Process("myapp", &Options)
 	if err != nil {
 		log.Fatal(err.Error())
 	}
 
+	if err = secret.Init(Options.EncryptionConfig); err != nil {
+		log.Fatal("failed to configure the encryption of the secrets, ", err)
+	}
+
 	port := flag.String("port", "8090", "define port that the service will listen to")
 	flag.Parse()
 
 	log.Println("Starting bm service")
 
-	db, err := gorm.Open("mysql",
-		fmt.Sprintf("admin:admin@tcp(%s:%s)/installer?charset=utf8&parseTime=True&loc=Local",
-			Options.DBHost, Options.DBPort))
-
+	db, err := database.Open(log.WithField("pkg", "database"), Options.DBConfig)
 	if err != nil {
 		log.Fatal("Fail to connect to DB, ", err)
 	}
@@ -70,24 +102,109 @@ func main() {
 		log.Fatal("failed to create client:", err)
 	}
 
-	if err = db.AutoMigrate(&models.Host{}, &models.Cluster{}).Error; err != nil {
-		log.Fatal("failed to auto migrate, ", err)
+	err = migrations.Migrate(log.WithField("pkg", "migrations"), db, Options.MigrationConfig, Options.LeaderConfig)
+	if err != nil {
+		log.Fatal("failed to migrate DB, ", err)
 	}
 
-	clusterApi := cluster.NewManager(log.WithField("pkg", "cluster-state"), db)
+	leaderElector := leader.NewElector(log.WithField("pkg", "leader"), db, Options.LeaderConfig, "bm-inventory")
+	leaderElector.Start()
+	defer leaderElector.Stop()
+
+	clusterApi := cluster.NewManager(log.WithField("pkg", "cluster-state"), db, leaderElector)
 	hwValidator := hardware.NewValidator(Options.HWValidatorConfig)
 	instructionApi := host.NewInstructionManager(log, db, hwValidator, Options.InstructionConfig)
-	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, hwValidator, instructionApi)
+	hostApi := host.NewManager(log.WithField("pkg", "host-state"), db, hwValidator, instructionApi, leaderElector)
+
+	clusterStateMonitor := thread.New(
+		log.WithField("pkg", "cluster-monitor"), "Cluster State Monitor", Options.ClusterStateMonitorInterval, clusterApi.ClusterMonitoring)
+	clusterStateMonitor.Start()
+	defer clusterStateMonitor.Stop()
+
+	hostStateMonitor := thread.New(
+		log.WithField("pkg", "host-monitor"), "Host State Monitor", Options.HostStateMonitorInterval, hostApi.HostMonitoring)
+	hostStateMonitor.Start()
+	defer hostStateMonitor.Stop()
+
 	jobApi := job.New(log.WithField("pkg", "k8s-job-wrapper"), kclient, Options.JobConfig)
-	bm := bminventory.NewBareMetalInventory(db, log.WithField("pkg", "Inventory"), hostApi, clusterApi, Options.BMConfig, jobApi)
-	h, err := restapi.Handler(restapi.Config{
+	objectStore, err := objectstore.New(log.WithField("pkg", "object-store"), Options.StorageConfig)
+	if err != nil {
+		log.Fatal("failed to create object store client, ", err)
+	}
+	imageApi := image.NewManager(log.WithField("pkg", "image"), db, jobApi, objectStore, leaderElector)
+
+	imageMonitor := thread.New(
+		log.WithField("pkg", "image-monitor"), "Image Monitor", Options.ImageMonitorInterval, imageApi.ImageMonitoring)
+	imageMonitor.Start()
+	defer imageMonitor.Stop()
+
+	retentionApi := retention.NewManager(log.WithField("pkg", "retention"), Options.RetentionConfig, db, objectStore,
+		leaderElector)
+	retentionCleaner := thread.New(
+		log.WithField("pkg", "retention"), "Retention Cleaner", Options.RetentionInterval, retentionApi.RetentionCleanup)
+	retentionCleaner.Start()
+	defer retentionCleaner.Stop()
+
+	keyRotationApi := keyrotation.NewManager(log.WithField("pkg", "key-rotation"), Options.KeyRotationConfig, db,
+		leaderElector)
+	keyRotation := thread.New(
+		log.WithField("pkg", "key-rotation"), "Key Rotation", Options.KeyRotationInterval, keyRotationApi.RotateKeys)
+	keyRotation.Start()
+	defer keyRotation.Stop()
+
+	webhookApi, err := webhooks.NewManager(log.WithField("pkg", "webhooks"), Options.WebhookConfig, db, leaderElector)
+	if err != nil {
+		log.Fatal("failed to create webhooks manager, ", err)
+	}
+	webhookDispatcher := thread.New(
+		log.WithField("pkg", "webhooks"), "Webhook Dispatcher", Options.WebhookDeliveryInterval, webhookApi.DeliverPending)
+	webhookDispatcher.Start()
+	defer webhookDispatcher.Stop()
+
+	authenticator, err := auth.NewAuthenticator(log.WithField("pkg", "auth"), Options.AuthConfig)
+	if err != nil {
+		log.Fatal("failed to create user authenticator, ", err)
+	}
+	authorizer, err := auth.NewAuthorizer(log.WithField("pkg", "auth"), Options.AuthConfig)
+	if err != nil {
+		log.Fatal("failed to create user authorizer, ", err)
+	}
+
+	bm := bminventory.NewBareMetalInventory(db, log.WithField("pkg", "Inventory"), hostApi, clusterApi, hwValidator,
+		Options.BMConfig, jobApi, objectStore, retentionApi)
+	h, api, err := restapi.HandlerAPI(restapi.Config{
 		InstallerAPI: bm,
 		Logger:       log.Printf,
+		Authorizer:   authorizer.Authorize,
+		AuthUserAuth: auth.UserAuth(log.WithField("pkg", "auth"), authenticator, authorizer),
 	})
-	h = requestid.Middleware(h)
 	if err != nil {
 		log.Fatal("Failed to init rest handler,", err)
 	}
+	api.ServeError = auth.ServeError
+	h = requestid.Middleware(h)
+	h = filemiddleware.HeadMiddleware(h)
+
+	// the requests are canceled when the server shuts down, so that it doesn't wait for the open watches to end
+	baseCtx, cancelRequests := context.WithCancel(context.Background())
+	server := &http.Server{
+		Addr:        fmt.Sprintf(":%s", swag.StringValue(port)),
+		Handler:     h,
+		BaseContext: func(net.Listener) context.Context { return baseCtx },
+	}
+	server.RegisterOnShutdown(cancelRequests)
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+		<-stop
+		log.Println("Shutting down bm service")
+		if err := server.Shutdown(context.Background()); err != nil {
+			log.WithError(err).Error("Failed to shutdown http server")
+		}
+	}()
 
-	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%s", swag.StringValue(port)), h))
+	// returning from main, instead of exiting, lets the background threads stop cleanly
+	if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		log.WithError(err).Error("Http server failed")
+	}
 }
@@ -0,0 +1,119 @@
+package agenttoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"time"
+
+	"github.com/filanov/bm-inventory/pkg/database"
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// the number of random bytes of a token
+const tokenSize = 32
+
+// ErrInvalidToken is returned when the token is missing, expired, revoked or belongs to another cluster
+var ErrInvalidToken = errors.New("invalid agent token")
+
+// Token is the agent token of a discovery image, only its digest is stored since the token itself is embedded in the
+// image. The tokens of the previous images of a cluster stay valid for a grace period after a new image is generated,
+// so that the hosts that were booted from them keep working.
+type Token struct {
+	ImageID   strfmt.UUID `gorm:"primary_key"`
+	ClusterID strfmt.UUID `gorm:"index"`
+	Digest    string
+	CreatedAt time.Time `gorm:"type:datetime"`
+	// ExpiresAt is set when a newer image of the cluster is generated
+	ExpiresAt *time.Time `gorm:"type:datetime"`
+}
+
+func (Token) TableName() string {
+	return "agent_tokens"
+}
+
+func (t *Token) isValid(now time.Time) bool {
+	return t.ExpiresAt == nil || now.Before(*t.ExpiresAt)
+}
+
+func digest(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Generate returns a new agent token of the cluster for the image. The tokens of the previous images of the cluster
+// expire after the grace period, and the expired ones are deleted.
+func Generate(db *gorm.DB, clusterID, imageID strfmt.UUID, gracePeriod time.Duration) (string, error) {
+	b := make([]byte, tokenSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", errors.Wrapf(err, "failed to generate agent token of cluster %s", clusterID)
+	}
+	token := hex.EncodeToString(b)
+	now := database.Now()
+	var previous []*Token
+	if err := db.Find(&previous, "cluster_id = ?", clusterID.String()).Error; err != nil {
+		return "", errors.Wrapf(err, "failed to get agent tokens of cluster %s", clusterID)
+	}
+	for _, t := range previous {
+		var err error
+		switch {
+		case !t.isValid(now):
+			err = db.Where("image_id = ?", t.ImageID.String()).Delete(&Token{}).Error
+		case t.ExpiresAt == nil:
+			err = db.Model(&Token{}).Where("image_id = ?", t.ImageID.String()).
+				Update("expires_at", now.Add(gracePeriod)).Error
+		}
+		if err != nil {
+			return "", errors.Wrapf(err, "failed to expire agent token of image %s", t.ImageID)
+		}
+	}
+	t := &Token{ImageID: imageID, ClusterID: clusterID, Digest: digest(token), CreatedAt: now}
+	if err := db.Create(t).Error; err != nil {
+		return "", errors.Wrapf(err, "failed to save agent token of cluster %s", clusterID)
+	}
+	return token, nil
+}
+
+// IsCurrent returns whether the agent token of the image is the current token of the cluster, the one of its
+// latest image
+func IsCurrent(db *gorm.DB, clusterID, imageID strfmt.UUID) (bool, error) {
+	var t Token
+	if err := db.First(&t, "image_id = ? and cluster_id = ?", imageID.String(), clusterID.String()).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to get agent token of image %s", imageID)
+	}
+	return t.ExpiresAt == nil, nil
+}
+
+// Verify returns ErrInvalidToken if the token is not a valid agent token of one of the images of the cluster
+func Verify(db *gorm.DB, clusterID strfmt.UUID, token string) error {
+	if token == "" {
+		return ErrInvalidToken
+	}
+	var tokens []*Token
+	if err := db.Find(&tokens, "cluster_id = ?", clusterID.String()).Error; err != nil {
+		return errors.Wrapf(err, "failed to get agent tokens of cluster %s", clusterID)
+	}
+	now := database.Now()
+	d := []byte(digest(token))
+	for _, t := range tokens {
+		if subtle.ConstantTimeCompare(d, []byte(t.Digest)) == 1 && t.isValid(now) {
+			return nil
+		}
+	}
+	return ErrInvalidToken
+}
+
+// Revoke deletes the agent tokens of all the images of the cluster, the agents of the cluster are rejected until
+// a new image is generated
+func Revoke(db *gorm.DB, clusterID strfmt.UUID) error {
+	if err := db.Where("cluster_id = ?", clusterID.String()).Delete(&Token{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to revoke agent tokens of cluster %s", clusterID)
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package agenttoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func TestAgentToken(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "agent token tests")
+}
+
+func prepareDB() *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	Expect(err).ShouldNot(HaveOccurred())
+	db.AutoMigrate(&Token{})
+	return db
+}
+
+var _ = Describe("agent token", func() {
+	var (
+		db        *gorm.DB
+		clusterID strfmt.UUID
+		imageID   strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+		imageID = strfmt.UUID(uuid.New().String())
+	})
+
+	generate := func(cluster, img strfmt.UUID) string {
+		token, err := Generate(db, cluster, img, time.Hour)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(token).ShouldNot(BeEmpty())
+		return token
+	}
+
+	expectInvalid := func(cluster strfmt.UUID, token string) {
+		Expect(errors.Cause(Verify(db, cluster, token))).Should(Equal(ErrInvalidToken))
+	}
+
+	It("verify", func() {
+		token := generate(clusterID, imageID)
+		Expect(Verify(db, clusterID, token)).ShouldNot(HaveOccurred())
+		expectInvalid(clusterID, "")
+		expectInvalid(clusterID, token+"0")
+		expectInvalid(strfmt.UUID(uuid.New().String()), token)
+	})
+
+	It("stores_only_digest", func() {
+		token := generate(clusterID, imageID)
+		var t Token
+		Expect(db.First(&t, "image_id = ?", imageID.String()).Error).ShouldNot(HaveOccurred())
+		Expect(t.Digest).ShouldNot(ContainSubstring(token))
+	})
+
+	It("previous_image_grace_period", func() {
+		first := generate(clusterID, imageID)
+		otherImageID := strfmt.UUID(uuid.New().String())
+		second := generate(clusterID, otherImageID)
+		Expect(second).ShouldNot(Equal(first))
+		// the hosts booted from the previous image keep working during the grace period
+		Expect(Verify(db, clusterID, first)).ShouldNot(HaveOccurred())
+		Expect(Verify(db, clusterID, second)).ShouldNot(HaveOccurred())
+
+		current, err := IsCurrent(db, clusterID, imageID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(current).Should(BeFalse())
+		current, err = IsCurrent(db, clusterID, otherImageID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(current).Should(BeTrue())
+
+		var t Token
+		Expect(db.First(&t, "image_id = ?", imageID.String()).Error).ShouldNot(HaveOccurred())
+		Expect(*t.ExpiresAt).Should(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		Expect(db.Model(&t).Update("expires_at", time.Now().Add(-time.Second)).Error).ShouldNot(HaveOccurred())
+		expectInvalid(clusterID, first)
+		Expect(Verify(db, clusterID, second)).ShouldNot(HaveOccurred())
+
+		// the expired tokens are deleted when the next image is generated
+		generate(clusterID, strfmt.UUID(uuid.New().String()))
+		Expect(db.First(&Token{}, "image_id = ?", imageID.String()).Error).Should(HaveOccurred())
+		Expect(Verify(db, clusterID, second)).ShouldNot(HaveOccurred())
+	})
+
+	It("no_grace_period", func() {
+		first, err := Generate(db, clusterID, imageID, 0)
+		Expect(err).ShouldNot(HaveOccurred())
+		second, err := Generate(db, clusterID, strfmt.UUID(uuid.New().String()), 0)
+		Expect(err).ShouldNot(HaveOccurred())
+		expectInvalid(clusterID, first)
+		Expect(Verify(db, clusterID, second)).ShouldNot(HaveOccurred())
+	})
+
+	It("tokens_are_per_cluster", func() {
+		otherClusterID := strfmt.UUID(uuid.New().String())
+		token := generate(clusterID, imageID)
+		otherToken := generate(otherClusterID, strfmt.UUID(uuid.New().String()))
+		Expect(Verify(db, clusterID, token)).ShouldNot(HaveOccurred())
+		Expect(Verify(db, otherClusterID, otherToken)).ShouldNot(HaveOccurred())
+		expectInvalid(clusterID, otherToken)
+	})
+
+	It("revoke", func() {
+		token := generate(clusterID, imageID)
+		latest := generate(clusterID, strfmt.UUID(uuid.New().String()))
+		Expect(Revoke(db, clusterID)).ShouldNot(HaveOccurred())
+		expectInvalid(clusterID, token)
+		expectInvalid(clusterID, latest)
+		current, err := IsCurrent(db, clusterID, imageID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(current).Should(BeFalse())
+		// revoking a cluster without a token is a no-op
+		Expect(Revoke(db, clusterID)).ShouldNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
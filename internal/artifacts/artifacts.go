@@ -0,0 +1,84 @@
+package artifacts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/objectstore"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+)
+
+// artifact is an entry of the catalog of the files that are generated for a cluster and can be downloaded
+type artifact struct {
+	name string
+	// sensitive artifacts contain cluster credentials
+	sensitive bool
+}
+
+// catalog must match the file names the download API accepts
+var catalog = []artifact{
+	{name: "bootstrap.ign"},
+	{name: "master.ign"},
+	{name: "worker.ign"},
+	{name: "metadata.json"},
+	{name: "kubeconfig", sensitive: true},
+	{name: "kubeadmin-password", sensitive: true},
+}
+
+// ErrUnknownArtifact is returned for file names that are not in the catalog
+var ErrUnknownArtifact = errors.New("unknown artifact")
+
+func find(name string) *artifact {
+	for i := range catalog {
+		if catalog[i].name == name {
+			return &catalog[i]
+		}
+	}
+	return nil
+}
+
+// ObjectName returns the name of the object the cluster artifact is stored as
+func ObjectName(clusterID strfmt.UUID, name string) string {
+	return fmt.Sprintf("%s/%s", clusterID, name)
+}
+
+// IsSensitive returns whether downloading the artifact requires an explicit permission
+func IsSensitive(name string) (bool, error) {
+	a := find(name)
+	if a == nil {
+		return false, errors.Wrapf(ErrUnknownArtifact, "file %s is not a cluster artifact", name)
+	}
+	return a.sensitive, nil
+}
+
+// List returns the catalog artifacts that were generated for the cluster, other objects stored under
+// the cluster, such as its logs, are not listed
+func List(ctx context.Context, objectStore objectstore.API, clusterID strfmt.UUID) (models.ArtifactList, error) {
+	prefix := ObjectName(clusterID, "")
+	objects, err := objectStore.ListObjects(ctx, prefix)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list artifacts of cluster %s", clusterID)
+	}
+	generated := make(map[string]objectstore.ObjectInfo)
+	for _, obj := range objects {
+		generated[strings.TrimPrefix(obj.Name, prefix)] = obj
+	}
+	list := models.ArtifactList{}
+	for _, a := range catalog {
+		obj, ok := generated[a.name]
+		if !ok {
+			continue
+		}
+		list = append(list, &models.Artifact{
+			Name:        swag.String(a.name),
+			SizeBytes:   obj.Size,
+			GeneratedAt: strfmt.DateTime(obj.LastModified),
+			Sensitive:   swag.Bool(a.sensitive),
+		})
+	}
+	return list, nil
+}
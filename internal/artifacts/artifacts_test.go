@@ -0,0 +1,91 @@
+package artifacts
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filanov/bm-inventory/pkg/objectstore"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func TestArtifacts(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "artifacts tests")
+}
+
+var _ = Describe("IsSensitive", func() {
+	It("catalog", func() {
+		for _, name := range []string{"bootstrap.ign", "master.ign", "worker.ign", "metadata.json"} {
+			sensitive, err := IsSensitive(name)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sensitive).Should(BeFalse())
+		}
+		for _, name := range []string{"kubeconfig", "kubeadmin-password"} {
+			sensitive, err := IsSensitive(name)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(sensitive).Should(BeTrue())
+		}
+	})
+
+	It("unknown", func() {
+		for _, name := range []string{"", "logs/host.log", "../kubeconfig", "install-config.yaml"} {
+			_, err := IsSensitive(name)
+			Expect(errors.Cause(err)).Should(Equal(ErrUnknownArtifact))
+		}
+	})
+})
+
+var _ = Describe("List", func() {
+	var (
+		ctx       = context.Background()
+		ctrl      *gomock.Controller
+		mockStore *objectstore.MockAPI
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockStore = objectstore.NewMockAPI(ctrl)
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	It("generated_artifacts", func() {
+		generatedAt := time.Now().Add(-time.Hour)
+		object := func(name string, size int64) objectstore.ObjectInfo {
+			return objectstore.ObjectInfo{Name: ObjectName(clusterID, name), Size: size, LastModified: generatedAt}
+		}
+		mockStore.EXPECT().ListObjects(gomock.Any(), clusterID.String()+"/").Return([]objectstore.ObjectInfo{
+			object("kubeconfig", 10),
+			object("bootstrap.ign", 20),
+			object("logs/host.log", 30),
+			object("auth/kubeconfig", 40),
+		}, nil).Times(1)
+
+		list, err := List(ctx, mockStore, clusterID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(2))
+		Expect(swag.StringValue(list[0].Name)).Should(Equal("bootstrap.ign"))
+		Expect(list[0].SizeBytes).Should(Equal(int64(20)))
+		Expect(swag.BoolValue(list[0].Sensitive)).Should(BeFalse())
+		Expect(time.Time(list[0].GeneratedAt).Equal(generatedAt)).Should(BeTrue())
+		Expect(swag.StringValue(list[1].Name)).Should(Equal("kubeconfig"))
+		Expect(swag.BoolValue(list[1].Sensitive)).Should(BeTrue())
+	})
+
+	It("list_failure", func() {
+		mockStore.EXPECT().ListObjects(gomock.Any(), gomock.Any()).Return(nil, errors.Errorf("s3 error")).Times(1)
+		_, err := List(ctx, mockStore, clusterID)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+})
@@ -0,0 +1,48 @@
+package bminventory
+
+import (
+	"context"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/auth"
+	"github.com/go-openapi/strfmt"
+	"github.com/jinzhu/gorm"
+)
+
+// accessibleClusters scopes cluster queries to the clusters the user of the request may access: admins access all the
+// clusters, other users access the clusters they own and the clusters of their organization
+func accessibleClusters(ctx context.Context) func(*gorm.DB) *gorm.DB {
+	user := auth.FromContext(ctx)
+	return func(db *gorm.DB) *gorm.DB {
+		switch {
+		case user == nil:
+			return db.Where("1 = 0")
+		case user.Admin:
+			return db
+		case user.Organization != "":
+			return db.Where("clusters.owner = ? or clusters.organization = ?", user.Name, user.Organization)
+		default:
+			return db.Where("clusters.owner = ?", user.Name)
+		}
+	}
+}
+
+// verifyClusterAccess returns gorm.ErrRecordNotFound if the cluster doesn't exist or the user of the request may not
+// access it, clusters of other users are reported as missing so that their IDs aren't disclosed
+func (b *bareMetalInventory) verifyClusterAccess(ctx context.Context, clusterID strfmt.UUID) error {
+	return b.db.Scopes(accessibleClusters(ctx)).Select("id").First(&models.Cluster{}, "id = ?", clusterID).Error
+}
+
+// accessibleClusterIDs returns the IDs of the clusters the user of the request may access
+func (b *bareMetalInventory) accessibleClusterIDs(ctx context.Context) ([]strfmt.UUID, error) {
+	var ids []strfmt.UUID
+	if err := b.db.Model(&models.Cluster{}).Scopes(accessibleClusters(ctx)).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func isAdmin(ctx context.Context) bool {
+	user := auth.FromContext(ctx)
+	return user != nil && user.Admin
+}
@@ -3,28 +3,46 @@ package bminventory
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
+	"net"
 	"net/http"
+	"net/url"
 	"strings"
 	"sync"
 	"text/template"
+	"time"
 
+	"github.com/filanov/bm-inventory/internal/agenttoken"
+	"github.com/filanov/bm-inventory/internal/artifacts"
 	"github.com/filanov/bm-inventory/internal/cluster"
+	"github.com/filanov/bm-inventory/internal/events"
+	"github.com/filanov/bm-inventory/internal/hardware"
 	"github.com/filanov/bm-inventory/internal/host"
+	"github.com/filanov/bm-inventory/internal/image"
 	"github.com/filanov/bm-inventory/internal/installcfg"
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/internal/retention"
+	"github.com/filanov/bm-inventory/internal/webhooks"
 	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/auth"
+	"github.com/filanov/bm-inventory/pkg/database"
 	"github.com/filanov/bm-inventory/pkg/filemiddleware"
 	"github.com/filanov/bm-inventory/pkg/job"
 	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/filanov/bm-inventory/pkg/objectstore"
+	"github.com/filanov/bm-inventory/pkg/secret"
 	"github.com/filanov/bm-inventory/restapi/operations/installer"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
 	batch "k8s.io/api/batch/v1"
 	core "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -35,6 +53,15 @@ const kubeconfigPrefix = "generate-kubeconfig"
 
 const defaultJobNamespace = "default"
 
+// the number of events ListClusterEvents returns when the limit is not set, as in the swagger default of the limit
+const defaultEventsLimit = 100
+
+// the environment variables of the job secrets, the configs hold the pull secret, the SSH key and the agent token
+const (
+	ignitionConfigEnv  = "IGNITION_CONFIG"
+	installerConfigEnv = "INSTALLER_CONFIG"
+)
+
 const (
 	ClusterStatusReady      = "ready"
 	ClusterStatusInstalling = "installing"
@@ -45,19 +72,21 @@ const (
 const (
 	ResourceKindHost    = "Host"
 	ResourceKindCluster = "Cluster"
+	ResourceKindImage   = "Image"
 )
 
 type Config struct {
-	ImageBuilder        string `envconfig:"IMAGE_BUILDER" default:"quay.io/oscohen/installer-image-build"`
-	ImageBuilderCmd     string `envconfig:"IMAGE_BUILDER_CMD" default:"echo hello"`
-	AgentDockerImg      string `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/oamizur/agent:latest"`
-	KubeconfigGenerator string `envconfig:"KUBECONFIG_GENERATE_IMAGE" default:"quay.io/oscohen/ignition-manifests-and-kubeconfig-generate"`
-	InventoryURL        string `envconfig:"INVENTORY_URL" default:"10.35.59.36"`
-	InventoryPort       string `envconfig:"INVENTORY_PORT" default:"30485"`
-	S3EndpointURL       string `envconfig:"S3_ENDPOINT_URL" default:"http://10.35.59.36:30925"`
-	S3Bucket            string `envconfig:"S3_BUCKET" default:"test"`
-	AwsAccessKeyID      string `envconfig:"AWS_ACCESS_KEY_ID" default:"accessKey1"`
-	AwsSecretAccessKey  string `envconfig:"AWS_SECRET_ACCESS_KEY" default:"verySecretKey1"`
+	ImageBuilder        string        `envconfig:"IMAGE_BUILDER" default:"quay.io/oscohen/installer-image-build"`
+	ImageBuilderCmd     string        `envconfig:"IMAGE_BUILDER_CMD" default:"echo hello"`
+	AgentDockerImg      string        `envconfig:"AGENT_DOCKER_IMAGE" default:"quay.io/oamizur/agent:latest"`
+	KubeconfigGenerator string        `envconfig:"KUBECONFIG_GENERATE_IMAGE" default:"quay.io/oscohen/ignition-manifests-and-kubeconfig-generate"`
+	InventoryURL        string        `envconfig:"INVENTORY_URL" default:"10.35.59.36"`
+	InventoryPort       string        `envconfig:"INVENTORY_PORT" default:"30485"`
+	PresignedURLExpiry  time.Duration `envconfig:"PRESIGNED_URL_EXPIRY" default:"0"`
+	// the agent tokens of the previous images of a cluster stay valid for this period after a new image is generated
+	AgentTokenGracePeriod time.Duration `envconfig:"AGENT_TOKEN_GRACE_PERIOD" default:"24h"`
+	WatchConfig           events.WatchConfig
+	WebhookConfig         webhooks.Config
 }
 
 const ignitionConfigFormat = `{
@@ -79,7 +108,7 @@ const ignitionConfigFormat = `{
 "units": [{
 "name": "agent.service",
 "enabled": true,
-"contents": "[Service]\nType=simple\nEnvironment=HTTPS_PROXY={{.ProxyURL}}\nEnvironment=HTTP_PROXY={{.ProxyURL}}\nEnvironment=http_proxy={{.ProxyURL}}\nEnvironment=https_proxy={{.ProxyURL}}\nExecStartPre=docker run --privileged --rm -v /usr/local/bin:/hostbin {{.AgentDockerImg}} cp /usr/bin/agent /hostbin\nExecStart=/usr/local/bin/agent --host {{.InventoryURL}} --port {{.InventoryPort}} --cluster-id {{.clusterId}}\n\n[Install]\nWantedBy=multi-user.target"
+"contents": "[Service]\nType=simple\nEnvironment=HTTPS_PROXY={{.ProxyURL}}\nEnvironment=HTTP_PROXY={{.ProxyURL}}\nEnvironment=http_proxy={{.ProxyURL}}\nEnvironment=https_proxy={{.ProxyURL}}\nExecStartPre=docker run --privileged --rm -v /usr/local/bin:/hostbin {{.AgentDockerImg}} cp /usr/bin/agent /hostbin\nExecStart=/usr/local/bin/agent --host {{.InventoryURL}} --port {{.InventoryPort}} --cluster-id {{.clusterId}} --agent-token {{.agentToken}}\n\n[Install]\nWantedBy=multi-user.target"
 }]
 }
 }`
@@ -99,10 +128,15 @@ type bareMetalInventory struct {
 	job           job.API
 	hostApi       host.API
 	clusterApi    cluster.API
+	hwValidator   hardware.Validator
+	objectStore   objectstore.API
+	retention     retention.API
+	watcher       *events.Watcher
 }
 
-func NewBareMetalInventory(db *gorm.DB, log logrus.FieldLogger, hostApi host.API, clusterApi cluster.API, cfg Config,
-	jobApi job.API) *bareMetalInventory {
+func NewBareMetalInventory(db *gorm.DB, log logrus.FieldLogger, hostApi host.API, clusterApi cluster.API,
+	hwValidator hardware.Validator, cfg Config, jobApi job.API, objectStore objectstore.API,
+	retentionApi retention.API) *bareMetalInventory {
 
 	b := &bareMetalInventory{
 		db:          db,
@@ -111,7 +145,11 @@ func NewBareMetalInventory(db *gorm.DB, log logrus.FieldLogger, hostApi host.API
 		debugCmdMap: make(map[strfmt.UUID]debugCmd),
 		hostApi:     hostApi,
 		clusterApi:  clusterApi,
+		hwValidator: hwValidator,
 		job:         jobApi,
+		objectStore: objectStore,
+		retention:   retentionApi,
+		watcher:     events.NewWatcher(log, db, cfg.WatchConfig),
 	}
 
 	if cfg.ImageBuilderCmd != "" {
@@ -121,7 +159,8 @@ func NewBareMetalInventory(db *gorm.DB, log logrus.FieldLogger, hostApi host.API
 }
 
 // create discovery image generation job, return job name and error
-func (b *bareMetalInventory) createImageJob(cluster *models.Cluster, jobName, imgName, ignitionConfig string) *batch.Job {
+func (b *bareMetalInventory) createImageJob(cluster *models.Cluster, jobName, imgName, checksumName string) *batch.Job {
+	storageEnv, storageVolumes, storageMounts := b.objectStore.JobAccess()
 	return &batch.Job{
 		TypeMeta: meta.TypeMeta{
 			Kind:       "Job",
@@ -145,34 +184,21 @@ func (b *bareMetalInventory) createImageJob(cluster *models.Cluster, jobName, im
 							Image:           b.Config.ImageBuilder,
 							Command:         b.imageBuildCmd,
 							ImagePullPolicy: "IfNotPresent",
-							Env: []core.EnvVar{
-								{
-									Name:  "S3_ENDPOINT_URL",
-									Value: b.S3EndpointURL,
-								},
-								{
-									Name:  "IGNITION_CONFIG",
-									Value: ignitionConfig,
-								},
+							Env: append([]core.EnvVar{
+								job.SecretEnv(ignitionConfigEnv, jobName, ignitionConfigEnv),
 								{
 									Name:  "IMAGE_NAME",
 									Value: imgName,
 								},
 								{
-									Name:  "S3_BUCKET",
-									Value: b.S3Bucket,
+									Name:  "IMAGE_CHECKSUM_NAME",
+									Value: checksumName,
 								},
-								{
-									Name:  "aws_access_key_id",
-									Value: b.AwsAccessKeyID,
-								},
-								{
-									Name:  "aws_secret_access_key",
-									Value: b.AwsSecretAccessKey,
-								},
-							},
+							}, storageEnv...),
+							VolumeMounts: storageMounts,
 						},
 					},
+					Volumes:       storageVolumes,
 					RestartPolicy: "Never",
 				},
 			},
@@ -180,13 +206,15 @@ func (b *bareMetalInventory) createImageJob(cluster *models.Cluster, jobName, im
 	}
 }
 
-func (b *bareMetalInventory) formatIgnitionFile(cluster *models.Cluster, params installer.GenerateClusterISOParams) (string, error) {
+func (b *bareMetalInventory) formatIgnitionFile(cluster *models.Cluster, params installer.GenerateClusterISOParams,
+	agentToken string) (string, error) {
 	var ignitionParams = map[string]string{
 		"userSshKey":     b.getUserSshKey(params),
 		"AgentDockerImg": b.AgentDockerImg,
 		"InventoryURL":   b.InventoryURL,
 		"InventoryPort":  b.InventoryPort,
 		"clusterId":      cluster.ID.String(),
+		"agentToken":     agentToken,
 		"ProxyURL":       params.ImageCreateParams.ProxyURL,
 	}
 	tmpl, err := template.New("ignitionConfig").Parse(ignitionConfigFormat)
@@ -229,11 +257,30 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 		IngressVip:               params.NewClusterParams.IngressVip,
 		Name:                     swag.StringValue(params.NewClusterParams.Name),
 		OpenshiftVersion:         swag.StringValue(params.NewClusterParams.OpenshiftVersion),
-		PullSecret:               params.NewClusterParams.PullSecret,
+		PullSecret:               secret.String(params.NewClusterParams.PullSecret),
 		ServiceNetworkCidr:       params.NewClusterParams.ServiceNetworkCidr,
-		SSHPublicKey:             params.NewClusterParams.SSHPublicKey,
+		SSHPublicKey:             secret.String(params.NewClusterParams.SSHPublicKey),
+		HardwareProfile:          params.NewClusterParams.HardwareProfile,
 		UpdatedAt:                strfmt.DateTime{},
 	}
+	if user := auth.FromContext(ctx); user != nil {
+		cluster.Owner = user.Name
+		cluster.Organization = user.Organization
+	}
+	if cluster.HardwareProfile == "" {
+		cluster.HardwareProfile = hardware.DefaultProfileName
+	}
+	if _, err := b.hwValidator.GetProfile(cluster.HardwareProfile); err != nil {
+		log.WithError(err).Errorf("failed to register cluster %s", swag.StringValue(params.NewClusterParams.Name))
+		return installer.NewRegisterClusterBadRequest().
+			WithPayload(generateError(http.StatusBadRequest))
+	}
+
+	if fieldErrors := network.ValidateCluster(&cluster, nil); len(fieldErrors) > 0 {
+		log.Errorf("failed to register cluster %s, invalid network configuration: %s",
+			swag.StringValue(params.NewClusterParams.Name), network.ToString(fieldErrors))
+		return installer.NewRegisterClusterBadRequest().WithPayload(generateFieldErrors(fieldErrors))
+	}
 
 	err := b.clusterApi.RegisterCluster(ctx, &cluster)
 	if err != nil {
@@ -242,6 +289,8 @@ func (b *bareMetalInventory) RegisterCluster(ctx context.Context, params install
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
 
+	setMachineNetworks(&cluster)
+	redactCluster(&cluster)
 	return installer.NewRegisterClusterCreated().WithPayload(&cluster)
 }
 
@@ -249,7 +298,7 @@ func (b *bareMetalInventory) DeregisterCluster(ctx context.Context, params insta
 	log := logutil.FromContext(ctx, b.log)
 	var cluster models.Cluster
 
-	if err := b.db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+	if err := b.db.Scopes(accessibleClusters(ctx)).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
 		return installer.NewDeregisterClusterNotFound().
 			WithPayload(generateError(http.StatusNotFound))
 	}
@@ -261,85 +310,299 @@ func (b *bareMetalInventory) DeregisterCluster(ctx context.Context, params insta
 			WithPayload(generateError(http.StatusNotFound))
 	}
 
+	var images []*models.Image
+	if err := b.db.Where("cluster_id = ?", params.ClusterID).Find(&images).Error; err != nil {
+		log.WithError(err).Errorf("failed to get images of cluster %s", params.ClusterID)
+	}
+	for _, img := range images {
+		for _, objectName := range image.ObjectNames(*img.ClusterID, *img.ID) {
+			if err := b.objectStore.DeleteObject(ctx, objectName); err != nil {
+				log.WithError(err).Warnf("failed to delete image %s of deregistered cluster %s", img.ID, params.ClusterID)
+			}
+		}
+	}
+	if err := b.db.Delete(&models.Image{}, "cluster_id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Warnf("failed to delete images of deregistered cluster %s", params.ClusterID)
+	}
+
 	return installer.NewDeregisterClusterNoContent()
 }
 
 func (b *bareMetalInventory) DownloadClusterISO(ctx context.Context, params installer.DownloadClusterISOParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
-	if err := b.db.First(&models.Cluster{}, "id = ?", params.ClusterID).Error; err != nil {
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
 		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
 		return installer.NewDownloadClusterISONotFound().
 			WithPayload(generateError(http.StatusNotFound))
 	}
-	imgName := getImageName(params.ClusterID, params.ImageID)
-	imageURL := fmt.Sprintf("%s/%s/%s", b.S3EndpointURL, b.S3Bucket, imgName)
-
-	log.Info("Image URL: ", imageURL)
-	resp, err := http.Get(imageURL)
+	var img models.Image
+	if err := b.db.First(&img, "id = ? and cluster_id = ?", params.ImageID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get image %s of cluster %s", params.ImageID, params.ClusterID)
+		return installer.NewDownloadClusterISONotFound().
+			WithPayload(generateError(http.StatusNotFound))
+	}
+	if swag.StringValue(img.Status) != image.StatusReady {
+		log.Errorf("image %s of cluster %s is not ready, status: %s", params.ImageID, params.ClusterID,
+			swag.StringValue(img.Status))
+		return installer.NewDownloadClusterISOConflict().
+			WithPayload(generateError(http.StatusConflict))
+	}
+	imgName := image.ObjectName(params.ClusterID, params.ImageID)
+	info, err := b.objectStore.GetObjectInfo(ctx, imgName)
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get ISO: %s", imgName)
-		return installer.NewDownloadClusterISOInternalServerError().
-			WithPayload(generateError(http.StatusInternalServerError))
-	}
-	if resp.StatusCode != http.StatusOK {
-		defer resp.Body.Close()
-		b, _ := ioutil.ReadAll(resp.Body)
-		log.WithError(fmt.Errorf("%d - %s", resp.StatusCode, string(b))).
-			Errorf("Failed to get ISO: %s", imgName)
-		if resp.StatusCode == http.StatusNotFound {
+		if errors.Cause(err) == objectstore.ErrObjectNotFound {
 			return installer.NewDownloadClusterISONotFound().
 				WithPayload(generateError(http.StatusNotFound))
 		}
 		return installer.NewDownloadClusterISOInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
-
-	return filemiddleware.NewResponder(installer.NewDownloadClusterISOOK().WithPayload(resp.Body),
+	return b.downloadObject(ctx, params.HTTPRequest, info,
 		fmt.Sprintf("%s-cluster-%s-discovery.iso", params.ImageID.String(), params.ClusterID.String()))
 }
 
-// GenerateClusterISO and return image ID that can be used to download the ISO
+// downloadObject serves an object of the store, or redirects the client to download it directly from the store
+// when presigned downloads are enabled and supported by the store
+func (b *bareMetalInventory) downloadObject(ctx context.Context, r *http.Request, info *objectstore.ObjectInfo,
+	fileName string) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if b.PresignedURLExpiry > 0 {
+		url, err := b.objectStore.PresignedDownloadURL(ctx, info.Name, fileName, b.PresignedURLExpiry)
+		if err == nil {
+			return filemiddleware.NewRedirectResponder(url)
+		}
+		log.WithError(err).Warnf("failed to get presigned url of object %s, serving it by the service", info.Name)
+	}
+	return filemiddleware.NewContentResponder(r, fileName,
+		objectstore.NewObjectReader(ctx, b.objectStore, info.Name, info.Size), info.ETag, info.LastModified)
+}
+
+// GenerateClusterISO queues the generation of the cluster discovery ISO and returns the image,
+// the image is generated in the background and can be downloaded once it is ready
 func (b *bareMetalInventory) GenerateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	log.Infof("prepare image for cluster %s", params.ClusterID)
 	var cluster models.Cluster
-	if err := b.db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+	if err := b.db.Scopes(accessibleClusters(ctx)).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
 		return installer.NewGenerateClusterISONotFound().
 			WithPayload(generateError(http.StatusNotFound))
 	}
-	// generating a new uuid for each call to prevent races between concurrent requests
-	imgId := strfmt.UUID(uuid.New().String())
-	imgName := getImageName(params.ClusterID, imgId)
-	// max job name is 63 chars
-	jobName := fmt.Sprintf("create-image-%s-%s", cluster.ID, imgId)[:63]
 
-	ignitionConfig, formatErr := b.formatIgnitionFile(&cluster, params)
+	// the agent token is left out since a new token is generated for each image
+	paramsIgnitionConfig, formatErr := b.formatIgnitionFile(&cluster, params, "")
 	if formatErr != nil {
 		log.WithError(formatErr).Errorf("failed to format ignition config file for cluster %s", cluster.ID)
 		return installer.NewGenerateClusterISOInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
 
-	if err := b.job.Create(ctx, b.createImageJob(&cluster, jobName, imgName, ignitionConfig)); err != nil {
-		log.WithError(err).Error("failed to create image job")
+	// the ignition config holds all the image parameters, images with the same config are identical
+	digest := fmt.Sprintf("%x", sha256.Sum256([]byte(paramsIgnitionConfig)))
+	var images []*models.Image
+	if err := b.db.Where("cluster_id = ? and params_digest = ? and status in (?)", params.ClusterID, digest,
+		[]string{image.StatusQueued, image.StatusBuilding, image.StatusReady}).
+		Order("created_at desc").Limit(1).Find(&images).Error; err != nil {
+		log.WithError(err).Errorf("failed to get images of cluster %s", params.ClusterID)
 		return installer.NewGenerateClusterISOInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
+	if len(images) > 0 {
+		// only the latest image of the cluster is reused, the agent tokens of the previous ones expire
+		current, err := agenttoken.IsCurrent(b.db, params.ClusterID, *images[0].ID)
+		if err != nil {
+			log.WithError(err).Errorf("failed to get agent token of cluster %s", params.ClusterID)
+			return installer.NewGenerateClusterISOInternalServerError().
+				WithPayload(generateError(http.StatusInternalServerError))
+		}
+		if current {
+			log.Infof("reusing image %s of cluster %s generated with identical parameters", images[0].ID, params.ClusterID)
+			redactImage(images[0])
+			return installer.NewGenerateClusterISOCreated().WithPayload(images[0])
+		}
+	}
 
-	if err := b.job.Monitor(ctx, jobName, defaultJobNamespace); err != nil {
-		log.WithError(err).Error("image creation failed")
+	// generating a new uuid for each call to prevent races between concurrent requests
+	imgId := strfmt.UUID(uuid.New().String())
+	now := database.Now()
+	img := models.Image{
+		ID:           &imgId,
+		Href:         swag.String(fmt.Sprintf("%s/clusters/%s/images/%s", baseHref, params.ClusterID, imgId)),
+		Kind:         swag.String(ResourceKindImage),
+		ClusterID:    &params.ClusterID,
+		Status:       swag.String(image.StatusQueued),
+		ParamsDigest: digest,
+		ProxyURL:     redactURL(params.ImageCreateParams.ProxyURL),
+		SSHPublicKey: secret.String(params.ImageCreateParams.SSHPublicKey),
+		CreatedAt:    strfmt.DateTime(now),
+		ExpiresAt:    b.retention.ImageExpiration(now),
+	}
+	agentToken, err := b.createImage(&img)
+	if err != nil {
+		log.WithError(err).Errorf("failed to create image %s of cluster %s", imgId, params.ClusterID)
 		return installer.NewGenerateClusterISOInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
 
-	log.Info("Generated cluster <%s> image <%s> with ignition config %s", params.ClusterID, imgId, ignitionConfig)
-	return installer.NewGenerateClusterISOCreated().
-		WithPayload(&installer.GenerateClusterISOCreatedBody{ImageID: imgId})
+	imgName := image.ObjectName(params.ClusterID, imgId)
+	jobName := image.JobName(params.ClusterID, imgId)
+	ignitionConfig, err := b.formatIgnitionFile(&cluster, params, agentToken)
+	if err == nil {
+		err = b.job.CreateWithSecret(ctx, b.createImageJob(&cluster, jobName, imgName,
+			image.ChecksumObjectName(params.ClusterID, imgId)),
+			map[string]string{ignitionConfigEnv: ignitionConfig})
+	}
+	if err != nil {
+		log.WithError(err).Error("failed to create image job")
+		if updateErr := b.db.Model(&img).Updates(map[string]interface{}{
+			"status": image.StatusFailed, "status_info": "failed to create image generation job"}).Error; updateErr != nil {
+			log.WithError(updateErr).Errorf("failed to update image %s status", imgId)
+		}
+		return installer.NewGenerateClusterISOInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+
+	log.Infof("Queued cluster <%s> image <%s> generation", params.ClusterID, imgId)
+	redactImage(&img)
+	return installer.NewGenerateClusterISOCreated().WithPayload(&img)
 }
 
-func getImageName(clusterID, id strfmt.UUID) string {
-	return fmt.Sprintf("discovery-image-%s-%s", clusterID.String(), id)
+// createImage stores the image and generates its agent token, the tokens of the previous images of the cluster
+// expire after the grace period. It returns the new token.
+func (b *bareMetalInventory) createImage(img *models.Image) (string, error) {
+	tx := b.db.Begin()
+	if tx.Error != nil {
+		return "", errors.Wrapf(tx.Error, "failed to start transaction")
+	}
+	defer tx.Rollback()
+	if err := tx.Create(img).Error; err != nil {
+		return "", errors.Wrapf(err, "failed to create image %s", img.ID)
+	}
+	agentToken, err := agenttoken.Generate(tx, *img.ClusterID, *img.ID, b.AgentTokenGracePeriod)
+	if err != nil {
+		return "", err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return "", errors.Wrapf(err, "failed to commit transaction")
+	}
+	return agentToken, nil
+}
+
+func (b *bareMetalInventory) GetClusterImage(ctx context.Context, params installer.GetClusterImageParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetClusterImageNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewGetClusterImageInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	var img models.Image
+	if err := b.db.First(&img, "id = ? and cluster_id = ?", params.ImageID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get image %s of cluster %s", params.ImageID, params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetClusterImageNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewGetClusterImageInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	redactImage(&img)
+	return installer.NewGetClusterImageOK().WithPayload(&img)
+}
+
+func (b *bareMetalInventory) ListClusterImages(ctx context.Context, params installer.ListClusterImagesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewListClusterImagesNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewListClusterImagesInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	images := models.ImageList{}
+	if err := b.db.Where("cluster_id = ?", params.ClusterID).Order("created_at").Find(&images).Error; err != nil {
+		log.WithError(err).Errorf("failed to list images of cluster %s", params.ClusterID)
+		return installer.NewListClusterImagesInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	for _, img := range images {
+		redactImage(img)
+	}
+	return installer.NewListClusterImagesOK().WithPayload(images)
+}
+
+// DeleteClusterImage deletes the image record together with the image object,
+// images that are being generated can't be deleted since their generation job will upload them later on
+func (b *bareMetalInventory) DeleteClusterImage(ctx context.Context, params installer.DeleteClusterImageParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewDeleteClusterImageNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewDeleteClusterImageInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	var img models.Image
+	if err := b.db.First(&img, "id = ? and cluster_id = ?", params.ImageID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get image %s of cluster %s", params.ImageID, params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewDeleteClusterImageNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewDeleteClusterImageInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	if err := image.Delete(ctx, b.log, &img, b.db, b.objectStore); err != nil {
+		log.WithError(err).Errorf("failed to delete image %s of cluster %s", params.ImageID, params.ClusterID)
+		if errors.Cause(err) == image.ErrImageInProgress {
+			return installer.NewDeleteClusterImageConflict().WithPayload(generateError(http.StatusConflict))
+		}
+		return installer.NewDeleteClusterImageInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return installer.NewDeleteClusterImageNoContent()
+}
+
+// redactCluster blanks the secrets of a cluster response, they are returned only by GetCluster when requested
+func redactCluster(cluster *models.Cluster) {
+	cluster.PullSecret = ""
+	cluster.SSHPublicKey = ""
+}
+
+// setMachineNetworks sets the networks that the enabled hosts of the cluster share, the cluster hosts must be loaded
+func setMachineNetworks(cluster *models.Cluster) {
+	cluster.MachineNetworks = network.MachineNetworksStrings(enabledHosts(cluster.Hosts))
+}
+
+func enabledHosts(hosts []*models.Host) []*models.Host {
+	var enabled []*models.Host
+	for _, h := range hosts {
+		if swag.StringValue(h.Status) != host.HostStatusDisabled {
+			enabled = append(enabled, h)
+		}
+	}
+	return enabled
+}
+
+// redactImage blanks the secrets of an image response
+func redactImage(img *models.Image) {
+	img.SSHPublicKey = ""
+}
+
+// redactURL hides the password of URLs with credentials, such as authenticated proxies
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.User == nil {
+		return rawURL
+	}
+	if _, ok := u.User.Password(); !ok {
+		return rawURL
+	}
+	u.User = url.UserPassword(u.User.Username(), "xxxxx")
+	return u.String()
 }
 
 func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder {
@@ -359,7 +622,8 @@ func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installe
 		}
 	}()
 
-	if err := tx.Preload("Hosts").First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+	if err := tx.Preload("Hosts").Scopes(accessibleClusters(ctx)).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		tx.Rollback()
 		return installer.NewInstallClusterNotFound().
 			WithPayload(generateError(http.StatusNotFound))
 	}
@@ -370,6 +634,13 @@ func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installe
 		return installer.NewInstallClusterConflict().WithPayload(generateError(http.StatusConflict))
 	}
 
+	// choose the roles of the auto-assigned hosts
+	if err := b.hostApi.AutoAssignRoles(ctx, cluster.Hosts, tx); err != nil {
+		log.WithError(err).Errorf("failed to assign hosts roles in cluster %s", cluster.ID.String())
+		tx.Rollback()
+		return installer.NewInstallClusterConflict().WithPayload(generateError(http.StatusConflict))
+	}
+
 	// set one of the master nodes as bootstrap
 	if err := b.setBootstrapHost(ctx, cluster, tx); err != nil {
 		tx.Rollback()
@@ -385,9 +656,6 @@ func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installe
 			return installer.NewInstallClusterConflict().WithPayload(generateError(http.StatusConflict))
 		}
 	}
-	if err := b.generateClusterInstallConfig(ctx, cluster); err != nil {
-		tx.Rollback()
-	}
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
 		log.WithError(err).Errorf("failed to commit cluster %s changes on installation", cluster.ID.String())
@@ -398,53 +666,59 @@ func (b *bareMetalInventory) InstallCluster(ctx context.Context, params installe
 		return installer.NewInstallClusterInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
+
+	// the kubeconfig generation job takes a while, it runs after the installation transitions are committed so
+	// that their events don't stay uncommitted behind later events that the watches already streamed
+	if err := b.generateClusterInstallConfig(ctx, cluster); err != nil {
+		log.WithError(err).Errorf("failed to generate the installation config of cluster %s", cluster.ID)
+		b.clusterApi.HandlePreInstallError(ctx, &cluster, err)
+		return installer.NewInstallClusterInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	setMachineNetworks(&cluster)
+	redactCluster(&cluster)
 	return installer.NewInstallClusterOK().WithPayload(&cluster)
 }
 
 func (b *bareMetalInventory) setBootstrapHost(ctx context.Context, cluster models.Cluster, db *gorm.DB) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 
-	masterNodesIds, err := b.clusterApi.GetMasterNodesIds(ctx, &cluster, db)
+	bootstrap, reason, err := b.hostApi.SelectBootstrap(ctx, cluster.Hosts, cluster.BootstrapHostID, db)
 	if err != nil {
-		log.WithError(err).Errorf("failed to get cluster %s master node id's", cluster.ID)
+		log.WithError(err).Errorf("failed to select bootstrap host for cluster %s", cluster.ID)
+		if errors.Cause(err) == host.ErrNoBootstrapCandidate {
+			return installer.NewInstallClusterConflict().WithPayload(generateError(http.StatusConflict))
+		}
 		return installer.NewInstallClusterInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
-	bootstrapId := masterNodesIds[len(masterNodesIds)-1]
-	log.Infof("Bootstrap ID is %s", bootstrapId)
-	for i := range cluster.Hosts {
-		if cluster.Hosts[i].ID.String() == bootstrapId.String() {
-			err = b.hostApi.SetBootstrap(ctx, cluster.Hosts[i], true)
-			if err != nil {
-				log.WithError(err).Errorf("failed to update bootstrap host for cluster %s", cluster.ID)
-				return installer.NewInstallClusterInternalServerError().
-					WithPayload(generateError(http.StatusInternalServerError))
-			}
-		}
+	log.Infof("Bootstrap ID is %s, %s", bootstrap.ID, reason)
+	if err = b.hostApi.SetBootstrap(ctx, bootstrap, true, db); err != nil {
+		log.WithError(err).Errorf("failed to update bootstrap host for cluster %s", cluster.ID)
+		return installer.NewInstallClusterInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	if err = db.Model(&models.Cluster{}).Where("id = ?", cluster.ID.String()).
+		Updates(map[string]interface{}{"bootstrap_host_id": *bootstrap.ID, "bootstrap_info": reason}).Error; err != nil {
+		log.WithError(err).Errorf("failed to record bootstrap host of cluster %s", cluster.ID)
+		return installer.NewInstallClusterInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
 	}
 	return nil
 }
 
-func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster models.Cluster) middleware.Responder {
-	log := logutil.FromContext(ctx, b.log)
-
+func (b *bareMetalInventory) generateClusterInstallConfig(ctx context.Context, cluster models.Cluster) error {
 	cfg, err := installcfg.GetInstallConfig(&cluster)
 	if err != nil {
-		log.WithError(err).Errorf("failed to get install config for cluster %s", cluster.ID)
-		return installer.NewInstallClusterInternalServerError().
-			WithPayload(generateError(http.StatusInternalServerError))
+		return errors.Wrapf(err, "failed to get install config for cluster %s", cluster.ID)
 	}
 	jobName := fmt.Sprintf("%s-%s-%s", kubeconfigPrefix, cluster.ID.String(), uuid.New().String())[:63]
-	if err := b.job.Create(ctx, b.createKubeconfigJob(&cluster, jobName, cfg)); err != nil {
-		log.WithError(err).Errorf("Failed to create kubeconfig generation job %s for cluster %s", jobName, cluster.ID)
-		return installer.NewInstallClusterInternalServerError().
-			WithPayload(generateError(http.StatusInternalServerError))
+	if err := b.job.CreateWithSecret(ctx, b.createKubeconfigJob(&cluster, jobName),
+		map[string]string{installerConfigEnv: string(cfg)}); err != nil {
+		return errors.Wrapf(err, "failed to create kubeconfig generation job %s for cluster %s", jobName, cluster.ID)
 	}
-
 	if err := b.job.Monitor(ctx, jobName, defaultJobNamespace); err != nil {
-		log.WithError(err).Errorf("Generating kubeconfig files %s failed for cluster %s", jobName, cluster.ID)
-		return installer.NewInstallClusterInternalServerError().
-			WithPayload(generateError(http.StatusInternalServerError))
+		return errors.Wrapf(err, "generating kubeconfig files %s failed for cluster %s", jobName, cluster.ID)
 	}
 	return nil
 }
@@ -466,7 +740,7 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 		log.WithError(tx.Error).Error("failed to start transaction")
 	}
 
-	if err := tx.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+	if err := tx.Scopes(accessibleClusters(ctx)).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID)
 		tx.Rollback()
 		return installer.NewUpdateClusterNotFound().WithPayload(generateError(http.StatusNotFound))
@@ -479,17 +753,96 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 	cluster.ClusterNetworkHostPrefix = params.ClusterUpdateParams.ClusterNetworkHostPrefix
 	cluster.DNSVip = params.ClusterUpdateParams.DNSVip
 	cluster.IngressVip = params.ClusterUpdateParams.IngressVip
-	cluster.PullSecret = params.ClusterUpdateParams.PullSecret
+	cluster.PullSecret = secret.String(params.ClusterUpdateParams.PullSecret)
 	cluster.ServiceNetworkCidr = params.ClusterUpdateParams.ServiceNetworkCidr
-	cluster.SSHPublicKey = params.ClusterUpdateParams.SSHPublicKey
+	cluster.SSHPublicKey = secret.String(params.ClusterUpdateParams.SSHPublicKey)
+
+	profileChanged := params.ClusterUpdateParams.HardwareProfile != "" &&
+		params.ClusterUpdateParams.HardwareProfile != cluster.HardwareProfile
+	if profileChanged {
+		if _, err := b.hwValidator.GetProfile(params.ClusterUpdateParams.HardwareProfile); err != nil {
+			tx.Rollback()
+			log.WithError(err).Errorf("failed to update cluster: %s", params.ClusterID)
+			return installer.NewUpdateClusterBadRequest().WithPayload(generateError(http.StatusBadRequest))
+		}
+		cluster.HardwareProfile = params.ClusterUpdateParams.HardwareProfile
+	}
+
+	var clusterHosts []*models.Host
+	if err := tx.Find(&clusterHosts, "cluster_id = ?", params.ClusterID).Error; err != nil {
+		tx.Rollback()
+		log.WithError(err).Errorf("failed to get hosts of cluster: %s", params.ClusterID)
+		return installer.NewUpdateClusterInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	if fieldErrors := network.ValidateCluster(&cluster, clusterHosts); len(fieldErrors) > 0 {
+		tx.Rollback()
+		log.Errorf("failed to update cluster %s, invalid network configuration: %s",
+			params.ClusterID, network.ToString(fieldErrors))
+		return installer.NewUpdateClusterBadRequest().WithPayload(generateFieldErrors(fieldErrors))
+	}
 
-	if err := tx.Model(&cluster).Update(cluster).Error; err != nil {
+	// the pinned bootstrap host is checked to be a known master only when the installation starts
+	if params.ClusterUpdateParams.BootstrapHostID != "" {
+		var bootstrap models.Host
+		if err := tx.First(&bootstrap, "id = ? and cluster_id = ?",
+			params.ClusterUpdateParams.BootstrapHostID, params.ClusterID).Error; err != nil {
+			tx.Rollback()
+			log.WithError(err).Errorf("failed to find bootstrap host <%s> in cluster <%s>",
+				params.ClusterUpdateParams.BootstrapHostID, params.ClusterID)
+			return installer.NewUpdateClusterNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		cluster.BootstrapHostID = params.ClusterUpdateParams.BootstrapHostID
+	}
+
+	// the configuration is replaced as a whole, updating with a struct would skip the fields that were cleared
+	if err := tx.Model(&cluster).Updates(map[string]interface{}{
+		"name":                        cluster.Name,
+		"api_vip":                     cluster.APIVip,
+		"base_dns_domain":             cluster.BaseDNSDomain,
+		"cluster_network_cidr":        cluster.ClusterNetworkCidr,
+		"cluster_network_host_prefix": cluster.ClusterNetworkHostPrefix,
+		"dns_vip":                     cluster.DNSVip,
+		"ingress_vip":                 cluster.IngressVip,
+		"pull_secret":                 cluster.PullSecret,
+		"service_network_cidr":        cluster.ServiceNetworkCidr,
+		"ssh_public_key":              cluster.SSHPublicKey,
+		"hardware_profile":            cluster.HardwareProfile,
+		"bootstrap_host_id":           cluster.BootstrapHostID,
+	}).Error; err != nil {
+		tx.Rollback()
+		log.WithError(err).Errorf("failed to update cluster: %s", params.ClusterID)
+		return installer.NewUpdateClusterInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	if err := events.AddChange(ctx, tx, *cluster.ID, "", swag.StringValue(cluster.Status),
+		events.ChangeConfiguration); err != nil {
 		tx.Rollback()
 		log.WithError(err).Errorf("failed to update cluster: %s", params.ClusterID)
 		return installer.NewUpdateClusterInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
 
+	if profileChanged {
+		// hosts that were already validated need to be validated again against the new profile
+		var hosts []*models.Host
+		if err := tx.Find(&hosts, "cluster_id = ? and status in (?)", params.ClusterID,
+			[]string{host.HostStatusKnown, host.HostStatusInsufficient}).Error; err != nil {
+			tx.Rollback()
+			log.WithError(err).Errorf("failed to get hosts of cluster: %s", params.ClusterID)
+			return installer.NewUpdateClusterInternalServerError().
+				WithPayload(generateError(http.StatusInternalServerError))
+		}
+		for _, h := range hosts {
+			if _, err := b.hostApi.UpdateRole(ctx, h, h.Role, tx); err != nil {
+				tx.Rollback()
+				log.WithError(err).Errorf("failed to validate host <%s> in cluster <%s>", h.ID, params.ClusterID)
+				return installer.NewUpdateClusterInternalServerError().
+					WithPayload(generateError(http.StatusInternalServerError))
+			}
+		}
+	}
+
 	for i := range params.ClusterUpdateParams.HostsRoles {
 		log.Infof("Update host %s to role: %s", params.ClusterUpdateParams.HostsRoles[i].ID,
 			params.ClusterUpdateParams.HostsRoles[i].Role)
@@ -529,43 +882,131 @@ func (b *bareMetalInventory) UpdateCluster(ctx context.Context, params installer
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
 
+	setMachineNetworks(&cluster)
+	redactCluster(&cluster)
 	return installer.NewUpdateClusterCreated().WithPayload(&cluster)
 }
 
 func (b *bareMetalInventory) ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	var clusters []*models.Cluster
-	if err := b.db.Preload("Hosts").Find(&clusters).Error; err != nil {
+	if err := b.db.Preload("Hosts").Scopes(accessibleClusters(ctx)).Find(&clusters).Error; err != nil {
 		log.WithError(err).Error("failed to list clusters")
 		return installer.NewListClustersInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
 
+	for _, cluster := range clusters {
+		setMachineNetworks(cluster)
+		redactCluster(cluster)
+	}
 	return installer.NewListClustersOK().WithPayload(clusters)
 }
 
 func (b *bareMetalInventory) GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	withSecrets := swag.BoolValue(params.WithSecrets)
+	if user := auth.FromContext(ctx); withSecrets && (user == nil || !user.HasPermission(auth.PermissionWrite)) {
+		log.Warnf("the secrets of cluster %s are not returned, the write permission is required", params.ClusterID)
+		return installer.NewGetClusterForbidden().WithPayload(generateError(http.StatusForbidden))
+	}
+	// the resource version is read before the cluster, so that watches resumed from it don't miss any change
+	version, err := events.ResourceVersion(b.db, b.WatchConfig)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get resource version")
+		return installer.NewGetClusterInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	var cluster models.Cluster
-	if err := b.db.Preload("Hosts").First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+	if err := b.db.Preload("Hosts").Scopes(accessibleClusters(ctx)).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
 		// TODO: check for the right error
 		return installer.NewGetClusterNotFound().
 			WithPayload(generateError(http.StatusNotFound))
 	}
-	return installer.NewGetClusterOK().WithPayload(&cluster)
+	setMachineNetworks(&cluster)
+	if !withSecrets {
+		redactCluster(&cluster)
+	}
+	return installer.NewGetClusterOK().WithPayload(&cluster).WithXResourceVersion(version)
+}
+
+func (b *bareMetalInventory) WatchCluster(ctx context.Context, params installer.WatchClusterParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewWatchClusterNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewWatchClusterInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	var version int64
+	if params.ResourceVersion != nil {
+		version = *params.ResourceVersion
+	} else {
+		var err error
+		if version, err = events.ResourceVersion(b.db, b.WatchConfig); err != nil {
+			log.WithError(err).Errorf("failed to get resource version")
+			return installer.NewWatchClusterInternalServerError().
+				WithPayload(generateError(http.StatusInternalServerError))
+		}
+	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+		rw.WriteHeader(http.StatusOK)
+		flusher, _ := rw.(http.Flusher)
+		encoder := json.NewEncoder(rw)
+		err := b.watcher.Watch(ctx, params.ClusterID, version, func(event *models.WatchEvent) error {
+			if err := encoder.Encode(event); err != nil {
+				return err
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+			return nil
+		})
+		if err != nil {
+			log.WithError(err).Warnf("watch of cluster %s stopped", params.ClusterID)
+		}
+	})
+}
+
+func (b *bareMetalInventory) ListHardwareProfiles(ctx context.Context, params installer.ListHardwareProfilesParams) middleware.Responder {
+	return installer.NewListHardwareProfilesOK().WithPayload(b.hwValidator.ListProfiles())
+}
+
+func (b *bareMetalInventory) GetHardwareProfile(ctx context.Context, params installer.GetHardwareProfileParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	profile, err := b.hwValidator.GetProfile(params.ProfileName)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get hardware profile %s", params.ProfileName)
+		return installer.NewGetHardwareProfileNotFound().
+			WithPayload(generateError(http.StatusNotFound))
+	}
+	return installer.NewGetHardwareProfileOK().WithPayload(profile)
 }
 
 func (b *bareMetalInventory) RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
 	host := &models.Host{
-		ID:        params.NewHostParams.HostID,
-		Href:      swag.String(fmt.Sprintf("%s/clusters/%s/hosts/%s", baseHref, params.ClusterID, *params.NewHostParams.HostID)),
-		Kind:      swag.String(ResourceKindHost),
-		Status:    swag.String("discovering"),
-		ClusterID: params.ClusterID,
+		ID:          params.NewHostParams.HostID,
+		Href:        swag.String(fmt.Sprintf("%s/clusters/%s/hosts/%s", baseHref, params.ClusterID, *params.NewHostParams.HostID)),
+		Kind:        swag.String(ResourceKindHost),
+		Status:      swag.String("discovering"),
+		ClusterID:   params.ClusterID,
+		CheckedInAt: strfmt.DateTime(database.Now()),
 	}
 
 	log.Infof("Register host: %+v", host)
 
+	if err := b.verifyAgentToken(ctx, params.ClusterID, params.XAgentToken); err != nil {
+		if errors.Cause(err) == agenttoken.ErrInvalidToken {
+			return installer.NewRegisterHostUnauthorized().WithPayload(generateError(http.StatusUnauthorized))
+		}
+		return installer.NewRegisterHostInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+
 	if err := b.db.First(&models.Cluster{}, "id = ?", params.ClusterID.String()).Error; err != nil {
 		log.WithError(err).Errorf("failed to get cluster: %s", params.ClusterID.String())
 		return installer.NewRegisterHostBadRequest().
@@ -583,6 +1024,15 @@ func (b *bareMetalInventory) RegisterHost(ctx context.Context, params installer.
 }
 
 func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewDeregisterHostNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewDeregisterHostInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	if err := b.db.Where("id = ? and cluster_id = ?", params.HostID, params.ClusterID).
 		Delete(&models.Host{}).Error; err != nil {
 		// TODO: check error type
@@ -595,6 +1045,15 @@ func (b *bareMetalInventory) DeregisterHost(ctx context.Context, params installe
 }
 
 func (b *bareMetalInventory) GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetHostNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewGetHostInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	var host models.Host
 	// TODO: validate what is the error
 	if err := b.db.Where("id = ? and cluster_id = ?", params.HostID, params.ClusterID).
@@ -607,13 +1066,28 @@ func (b *bareMetalInventory) GetHost(ctx context.Context, params installer.GetHo
 
 func (b *bareMetalInventory) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewListHostsNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewListHostsInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	// the resource version is read before the hosts, so that watches resumed from it don't miss any change
+	version, err := events.ResourceVersion(b.db, b.WatchConfig)
+	if err != nil {
+		log.WithError(err).Errorf("failed to get resource version")
+		return installer.NewListHostsInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	var hosts []*models.Host
 	if err := b.db.Find(&hosts, "cluster_id = ?", params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to get list of hosts for cluster %s", params.ClusterID)
 		return installer.NewListHostsInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
-	return installer.NewListHostsOK().WithPayload(hosts)
+	return installer.NewListHostsOK().WithPayload(hosts).WithXResourceVersion(version)
 }
 
 func createStepID(stepType models.StepType) string {
@@ -625,6 +1099,14 @@ func (b *bareMetalInventory) GetNextSteps(ctx context.Context, params installer.
 	var steps models.Steps
 	var host models.Host
 
+	if err := b.verifyAgentToken(ctx, params.ClusterID, params.XAgentToken); err != nil {
+		if errors.Cause(err) == agenttoken.ErrInvalidToken {
+			return installer.NewGetNextStepsUnauthorized().WithPayload(generateError(http.StatusUnauthorized))
+		}
+		return installer.NewGetNextStepsInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+
 	//TODO check the error type
 	if err := b.db.First(&host, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to find host %s", params.HostID)
@@ -632,6 +1114,11 @@ func (b *bareMetalInventory) GetNextSteps(ctx context.Context, params installer.
 			WithPayload(generateError(http.StatusNotFound))
 	}
 
+	// asking for instructions is the host keepalive
+	if err := b.db.Model(&host).UpdateColumn("checked_in_at", strfmt.DateTime(database.Now())).Error; err != nil {
+		log.WithError(err).Errorf("failed to update host %s check in time", params.HostID)
+	}
+
 	var err error
 	steps, err = b.hostApi.GetNextSteps(ctx, &host)
 	if err != nil {
@@ -658,6 +1145,14 @@ func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer
 	log.Infof("Received step reply <%s> from cluster <%s> host <%s>  exit-code <%d> stdout <%s> stderr <%s>", params.Reply.StepID, params.ClusterID,
 		params.HostID, params.Reply.ExitCode, params.Reply.Output, params.Reply.Error)
 
+	if err := b.verifyAgentToken(ctx, params.ClusterID, params.XAgentToken); err != nil {
+		if errors.Cause(err) == agenttoken.ErrInvalidToken {
+			return installer.NewPostStepReplyUnauthorized().WithPayload(generateError(http.StatusUnauthorized))
+		}
+		return installer.NewPostStepReplyInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+
 	var host models.Host
 	if err := b.db.First(&host, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("Failed to find host <%s> cluster <%s> step <%s>",
@@ -666,27 +1161,101 @@ func (b *bareMetalInventory) PostStepReply(ctx context.Context, params installer
 			WithPayload(generateError(http.StatusNotFound))
 	}
 
-	if strings.HasPrefix(params.Reply.StepID, string(models.StepTypeHardwareInfo)) {
-		// To make sure we store only information defined in swagger we unmarshal and marshal hw info.
-		hwInfo, err := filterReply(&models.Introspection{}, params.Reply.Output)
-		if err != nil {
-			log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
-				params.Reply.StepID, params.HostID, params.ClusterID)
-			return installer.NewPostStepReplyBadRequest().
-				WithPayload(generateError(http.StatusBadRequest))
-		}
-
-		if _, err := b.hostApi.UpdateHwInfo(ctx, &host, hwInfo); err != nil {
-			log.WithError(err).Errorf("Failed to update host <%s> cluster <%s> step <%s>",
-				params.HostID, params.ClusterID, params.Reply.StepID)
-			return installer.NewPostStepReplyInternalServerError().
-				WithPayload(generateError(http.StatusInternalServerError))
-		}
+	var err middleware.Responder
+	switch {
+	case strings.HasPrefix(params.Reply.StepID, string(models.StepTypeHardwareInfo)):
+		err = b.updateHwInfo(ctx, &host, params)
+	case strings.HasPrefix(params.Reply.StepID, string(models.StepTypeConnectivityCheck)):
+		err = b.updateConnectivityReport(ctx, &host, params)
+	case strings.HasPrefix(params.Reply.StepID, string(models.StepTypeInventory)):
+		err = b.updateInventory(ctx, &host, params)
+	case strings.HasPrefix(params.Reply.StepID, string(models.StepTypeFreeNetworkAddresses)):
+		err = b.updateFreeAddresses(ctx, &host, params)
+	}
+	if err != nil {
+		return err
 	}
 
 	return installer.NewPostStepReplyNoContent()
 }
 
+func (b *bareMetalInventory) updateHwInfo(ctx context.Context, host *models.Host, params installer.PostStepReplyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	// To make sure we store only information defined in swagger we unmarshal and marshal hw info.
+	hwInfo, err := filterReply(&models.Introspection{}, params.Reply.Output)
+	if err != nil {
+		log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
+			params.Reply.StepID, params.HostID, params.ClusterID)
+		return installer.NewPostStepReplyBadRequest().
+			WithPayload(generateError(http.StatusBadRequest))
+	}
+
+	if _, err := b.hostApi.UpdateHwInfo(ctx, host, hwInfo); err != nil {
+		log.WithError(err).Errorf("Failed to update host <%s> cluster <%s> step <%s>",
+			params.HostID, params.ClusterID, params.Reply.StepID)
+		return installer.NewPostStepReplyInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateConnectivityReport(ctx context.Context, host *models.Host, params installer.PostStepReplyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var connectivityReport models.ConnectivityReport
+	if err := json.Unmarshal([]byte(params.Reply.Output), &connectivityReport); err != nil {
+		log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
+			params.Reply.StepID, params.HostID, params.ClusterID)
+		return installer.NewPostStepReplyBadRequest().
+			WithPayload(generateError(http.StatusBadRequest))
+	}
+
+	if err := b.hostApi.UpdateConnectivityReport(ctx, host, &connectivityReport); err != nil {
+		log.WithError(err).Errorf("Failed to update connectivity report of host <%s> cluster <%s> step <%s>",
+			params.HostID, params.ClusterID, params.Reply.StepID)
+		return installer.NewPostStepReplyInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateInventory(ctx context.Context, host *models.Host, params installer.PostStepReplyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	inventory, err := filterReply(&models.Inventory{}, params.Reply.Output)
+	if err != nil {
+		log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
+			params.Reply.StepID, params.HostID, params.ClusterID)
+		return installer.NewPostStepReplyBadRequest().
+			WithPayload(generateError(http.StatusBadRequest))
+	}
+
+	if err := b.hostApi.UpdateInventory(ctx, host, inventory); err != nil {
+		log.WithError(err).Errorf("Failed to update inventory of host <%s> cluster <%s> step <%s>",
+			params.HostID, params.ClusterID, params.Reply.StepID)
+		return installer.NewPostStepReplyInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return nil
+}
+
+func (b *bareMetalInventory) updateFreeAddresses(ctx context.Context, host *models.Host, params installer.PostStepReplyParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	freeAddresses, err := filterReply(&models.FreeNetworksAddresses{}, params.Reply.Output)
+	if err != nil {
+		log.WithError(err).Errorf("Failed decode <%s> reply for host <%s> cluster <%s>",
+			params.Reply.StepID, params.HostID, params.ClusterID)
+		return installer.NewPostStepReplyBadRequest().
+			WithPayload(generateError(http.StatusBadRequest))
+	}
+
+	if err := b.hostApi.UpdateFreeAddresses(ctx, host, freeAddresses); err != nil {
+		log.WithError(err).Errorf("Failed to update free addresses of host <%s> cluster <%s> step <%s>",
+			params.HostID, params.ClusterID, params.Reply.StepID)
+		return installer.NewPostStepReplyInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return nil
+}
+
 // filterReply return only the expected parameters from the input.
 func filterReply(expected interface{}, input string) (string, error) {
 	if err := json.Unmarshal([]byte(input), expected); err != nil {
@@ -701,6 +1270,14 @@ func filterReply(expected interface{}, input string) (string, error) {
 
 func (b *bareMetalInventory) SetDebugStep(ctx context.Context, params installer.SetDebugStepParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewSetDebugStepNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewSetDebugStepInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	stepID := createStepID(models.StepTypeExecute)
 	b.debugCmdMux.Lock()
 	b.debugCmdMap[params.HostID] = debugCmd{
@@ -715,6 +1292,14 @@ func (b *bareMetalInventory) SetDebugStep(ctx context.Context, params installer.
 
 func (b *bareMetalInventory) DisableHost(ctx context.Context, params installer.DisableHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewDisableHostNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewDisableHostInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	var host models.Host
 	log.Info("disabling host: ", params.HostID)
 
@@ -733,6 +1318,14 @@ func (b *bareMetalInventory) DisableHost(ctx context.Context, params installer.D
 
 func (b *bareMetalInventory) EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewEnableHostNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewEnableHostInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	var host models.Host
 	log.Info("enable host: ", params.HostID)
 
@@ -749,8 +1342,46 @@ func (b *bareMetalInventory) EnableHost(ctx context.Context, params installer.En
 	return installer.NewEnableHostNoContent()
 }
 
-func (b *bareMetalInventory) createKubeconfigJob(cluster *models.Cluster, jobName string, cfg []byte) *batch.Job {
+func (b *bareMetalInventory) SetHostInstallationDisk(ctx context.Context, params installer.SetHostInstallationDiskParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewSetHostInstallationDiskNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewSetHostInstallationDiskInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	var h models.Host
+	log.Infof("set installation disk of host %s to <%s>", params.HostID, params.InstallationDiskParams.Disk)
+
+	if err := b.db.First(&h, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		return installer.NewSetHostInstallationDiskNotFound().
+			WithPayload(generateError(http.StatusNotFound))
+	}
+
+	if err := b.hostApi.SetInstallationDisk(ctx, &h, params.InstallationDiskParams.Disk); err != nil {
+		log.WithError(err).Errorf("failed to set installation disk of host <%s> from cluster <%s>",
+			params.HostID, params.ClusterID)
+		if errors.Cause(err) == host.ErrInstallationStarted {
+			return installer.NewSetHostInstallationDiskConflict().
+				WithPayload(generateError(http.StatusConflict))
+		}
+		return installer.NewSetHostInstallationDiskBadRequest().
+			WithPayload(generateError(http.StatusBadRequest))
+	}
+
+	if err := b.db.First(&h, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get host <%s> from cluster <%s>", params.HostID, params.ClusterID)
+		return installer.NewSetHostInstallationDiskInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return installer.NewSetHostInstallationDiskOK().WithPayload(&h)
+}
+
+func (b *bareMetalInventory) createKubeconfigJob(cluster *models.Cluster, jobName string) *batch.Job {
 	id := cluster.ID
+	storageEnv, storageVolumes, storageMounts := b.objectStore.JobAccess()
 	return &batch.Job{
 		TypeMeta: meta.TypeMeta{
 			Kind:       "Job",
@@ -774,23 +1405,12 @@ func (b *bareMetalInventory) createKubeconfigJob(cluster *models.Cluster, jobNam
 							Image:           b.Config.KubeconfigGenerator,
 							Command:         b.imageBuildCmd,
 							ImagePullPolicy: "IfNotPresent",
-							Env: []core.EnvVar{
-								{
-									Name:  "S3_ENDPOINT_URL",
-									Value: b.S3EndpointURL,
-								},
-								{
-									Name:  "INSTALLER_CONFIG",
-									Value: string(cfg),
-								},
+							Env: append([]core.EnvVar{
+								job.SecretEnv(installerConfigEnv, jobName, installerConfigEnv),
 								{
 									Name:  "IMAGE_NAME",
 									Value: jobName,
 								},
-								{
-									Name:  "S3_BUCKET",
-									Value: b.S3Bucket,
-								},
 								{
 									Name:  "CLUSTER_ID",
 									Value: id.String(),
@@ -799,17 +1419,11 @@ func (b *bareMetalInventory) createKubeconfigJob(cluster *models.Cluster, jobNam
 									Name:  "OPENSHIFT_INSTALL_RELEASE_IMAGE_OVERRIDE",
 									Value: "quay.io/openshift-release-dev/ocp-release:4.4.0-rc.7-x86_64", //TODO: change this to match the cluster openshift version
 								},
-								{
-									Name:  "aws_access_key_id",
-									Value: b.AwsAccessKeyID,
-								},
-								{
-									Name:  "aws_secret_access_key",
-									Value: b.AwsSecretAccessKey,
-								},
-							},
+							}, storageEnv...),
+							VolumeMounts: storageMounts,
 						},
 					},
+					Volumes:       storageVolumes,
 					RestartPolicy: "Never",
 				},
 			},
@@ -821,7 +1435,7 @@ func (b *bareMetalInventory) DownloadClusterFiles(ctx context.Context, params in
 	log := logutil.FromContext(ctx, b.log)
 	var cluster models.Cluster
 
-	if err := b.db.First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+	if err := b.db.Scopes(accessibleClusters(ctx)).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to find cluster %s", params.ClusterID)
 		if gorm.IsRecordNotFoundError(err) {
 			return installer.NewDownloadClusterFilesNotFound().
@@ -838,28 +1452,281 @@ func (b *bareMetalInventory) DownloadClusterFiles(ctx context.Context, params in
 			WithPayload(generateError(http.StatusConflict))
 	}
 
-	filesUrl := fmt.Sprintf("%s/%s/%s", b.S3EndpointURL, b.S3Bucket,
-		fmt.Sprintf("%s/%s", params.ClusterID, params.FileName))
-	log.Info("File URL: ", filesUrl)
-	resp, err := http.Get(filesUrl)
+	sensitive, err := artifacts.IsSensitive(params.FileName)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to download clusters %s %s file", params.ClusterID, params.FileName)
+		return installer.NewDownloadClusterFilesNotFound().
+			WithPayload(generateError(http.StatusNotFound))
+	}
+	if sensitive && !permitsSensitiveArtifacts(ctx) {
+		log.Warnf("Download of sensitive file %s of cluster %s is not permitted", params.FileName, params.ClusterID)
+		return installer.NewDownloadClusterFilesForbidden().
+			WithPayload(generateError(http.StatusForbidden))
+	}
+
+	info, err := b.objectStore.GetObjectInfo(ctx, artifacts.ObjectName(params.ClusterID, params.FileName))
 	if err != nil {
 		log.WithError(err).Errorf("Failed to get clusters %s %s file", params.ClusterID, params.FileName)
+		// the files are generated in the background once the installation starts
+		if errors.Cause(err) == objectstore.ErrObjectNotFound {
+			return installer.NewDownloadClusterFilesConflict().
+				WithPayload(generateError(http.StatusConflict))
+		}
 		return installer.NewDownloadClusterFilesInternalServerError().
 			WithPayload(generateError(http.StatusInternalServerError))
 	}
-	if resp.StatusCode != http.StatusOK {
-		defer resp.Body.Close()
-		b, _ := ioutil.ReadAll(resp.Body)
-		log.WithError(fmt.Errorf("%s", string(b))).
-			Errorf("Failed to get clusters %s kubeKonfig", params.ClusterID)
-		return installer.NewDownloadClusterFilesConflict().
-			WithPayload(generateError(http.StatusConflict))
+	return b.downloadObject(ctx, params.HTTPRequest, info, params.FileName)
+}
+
+func (b *bareMetalInventory) ListClusterEvents(ctx context.Context, params installer.ListClusterEventsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewListClusterEventsNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewListClusterEventsInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	filter := events.Filter{
+		HostID: params.HostID,
+		After:  swag.Int64Value(params.After),
+		Limit:  defaultEventsLimit,
+	}
+	if params.From != nil {
+		filter.From = (*time.Time)(params.From)
+	}
+	if params.To != nil {
+		filter.To = (*time.Time)(params.To)
+	}
+	if params.Limit != nil {
+		filter.Limit = int(*params.Limit)
+	}
+	list, err := events.List(b.db, params.ClusterID, filter)
+	if err != nil {
+		log.WithError(err).Errorf("failed to list events of cluster %s", params.ClusterID)
+		return installer.NewListClusterEventsInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return installer.NewListClusterEventsOK().WithPayload(list)
+}
+
+// verifyAgentToken returns agenttoken.ErrInvalidToken if the token is not the agent token of the cluster
+func (b *bareMetalInventory) verifyAgentToken(ctx context.Context, clusterID strfmt.UUID, token *string) error {
+	log := logutil.FromContext(ctx, b.log)
+	err := agenttoken.Verify(b.db, clusterID, swag.StringValue(token))
+	if errors.Cause(err) == agenttoken.ErrInvalidToken {
+		log.Warnf("Rejected agent request of cluster %s with a missing or invalid agent token", clusterID)
+	} else if err != nil {
+		log.WithError(err).Errorf("failed to verify agent token of cluster %s", clusterID)
+	}
+	return err
+}
+
+func (b *bareMetalInventory) RevokeAgentToken(ctx context.Context, params installer.RevokeAgentTokenParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewRevokeAgentTokenNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewRevokeAgentTokenInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	if err := agenttoken.Revoke(b.db, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to revoke agent token of cluster %s", params.ClusterID)
+		return installer.NewRevokeAgentTokenInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	log.Infof("Revoked agent token of cluster %s", params.ClusterID)
+	return installer.NewRevokeAgentTokenNoContent()
+}
+
+// permitsSensitiveArtifacts returns whether the user of the request may download sensitive artifacts
+func permitsSensitiveArtifacts(ctx context.Context) bool {
+	user := auth.FromContext(ctx)
+	return user != nil && (user.HasPermission(auth.PermissionSensitive) || user.HasPermission(auth.PermissionAdmin))
+}
+
+func (b *bareMetalInventory) ListClusterArtifacts(ctx context.Context, params installer.ListClusterArtifactsParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyClusterAccess(ctx, params.ClusterID); err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewListClusterArtifactsNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewListClusterArtifactsInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	list, err := artifacts.List(ctx, b.objectStore, params.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to list artifacts of cluster %s", params.ClusterID)
+		return installer.NewListClusterArtifactsInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return installer.NewListClusterArtifactsOK().WithPayload(list)
+}
+
+func (b *bareMetalInventory) GetFreeAddresses(ctx context.Context, params installer.GetFreeAddressesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	var cluster models.Cluster
+	if err := b.db.Preload("Hosts").Scopes(accessibleClusters(ctx)).First(&cluster, "id = ?", params.ClusterID).Error; err != nil {
+		log.WithError(err).Errorf("failed to get cluster %s", params.ClusterID)
+		if gorm.IsRecordNotFoundError(err) {
+			return installer.NewGetFreeAddressesNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewGetFreeAddressesInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	hosts := enabledHosts(cluster.Hosts)
+	// the host bits of the requested network are ignored
+	var requested *net.IPNet
+	if params.Network != nil {
+		if _, subnet, err := net.ParseCIDR(*params.Network); err == nil {
+			requested = subnet
+		}
+	}
+	var networks []*net.IPNet
+	for _, subnet := range network.MachineNetworks(hosts) {
+		if params.Network == nil || (requested != nil && subnet.String() == requested.String()) {
+			networks = append(networks, subnet)
+		}
+	}
+	vips := []string{cluster.APIVip.String(), cluster.IngressVip.String(), cluster.DNSVip.String()}
+	limit := 8
+	if params.Limit != nil {
+		limit = int(*params.Limit)
 	}
-	return filemiddleware.NewResponder(installer.NewDownloadClusterFilesOK().WithPayload(resp.Body), params.FileName)
+	return installer.NewGetFreeAddressesOK().WithPayload(network.FreeAddresses(networks, hosts, vips, limit))
+}
+
+func (b *bareMetalInventory) RegisterWebhook(ctx context.Context, params installer.RegisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := webhooks.ValidateURL(swag.StringValue(params.NewWebhookParams.URL), b.WebhookConfig); err != nil {
+		log.WithError(err).Errorf("failed to register webhook")
+		return installer.NewRegisterWebhookBadRequest().WithPayload(generateError(http.StatusBadRequest))
+	}
+	clusterID := params.NewWebhookParams.ClusterID
+	if clusterID == "" && !isAdmin(ctx) {
+		log.Warn("Only admins can subscribe to the events of all the clusters")
+		return installer.NewRegisterWebhookForbidden().WithPayload(generateError(http.StatusForbidden))
+	}
+	if clusterID != "" {
+		if err := b.verifyClusterAccess(ctx, clusterID); err != nil {
+			log.WithError(err).Errorf("failed to get cluster %s", clusterID)
+			if gorm.IsRecordNotFoundError(err) {
+				return installer.NewRegisterWebhookNotFound().WithPayload(generateError(http.StatusNotFound))
+			}
+			return installer.NewRegisterWebhookInternalServerError().
+				WithPayload(generateError(http.StatusInternalServerError))
+		}
+	}
+	webhook, err := webhooks.Register(b.db, params.NewWebhookParams)
+	if err != nil {
+		log.WithError(err).Errorf("failed to register webhook")
+		return installer.NewRegisterWebhookInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	log.Infof("Registered webhook %s to %s", *webhook.ID, swag.StringValue(webhook.URL))
+	return installer.NewRegisterWebhookCreated().WithPayload(webhook)
+}
+
+func (b *bareMetalInventory) ListWebhooks(ctx context.Context, params installer.ListWebhooksParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	list, err := webhooks.List(b.db, params.ClusterID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to list webhooks")
+		return installer.NewListWebhooksInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	if isAdmin(ctx) {
+		return installer.NewListWebhooksOK().WithPayload(list)
+	}
+	// the subscriptions to all the clusters belong to the admins
+	clusterIDs, err := b.accessibleClusterIDs(ctx)
+	if err != nil {
+		log.WithError(err).Errorf("failed to list webhooks")
+		return installer.NewListWebhooksInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	accessible := models.WebhookList{}
+	for _, webhook := range list {
+		if webhook.ClusterID != "" && funk.Contains(clusterIDs, webhook.ClusterID) {
+			accessible = append(accessible, webhook)
+		}
+	}
+	return installer.NewListWebhooksOK().WithPayload(accessible)
+}
+
+// verifyWebhookAccess returns gorm.ErrRecordNotFound if the webhook doesn't exist or the user of the request may not
+// access it, only admins access the subscriptions to all the clusters
+func (b *bareMetalInventory) verifyWebhookAccess(ctx context.Context, webhookID strfmt.UUID) error {
+	webhook, err := webhooks.Get(b.db, webhookID)
+	if err != nil {
+		return err
+	}
+	if webhook.ClusterID == "" {
+		if !isAdmin(ctx) {
+			return errors.Wrapf(gorm.ErrRecordNotFound, "webhook %s", webhookID)
+		}
+		return nil
+	}
+	return b.verifyClusterAccess(ctx, webhook.ClusterID)
+}
+
+func (b *bareMetalInventory) DeregisterWebhook(ctx context.Context, params installer.DeregisterWebhookParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyWebhookAccess(ctx, params.WebhookID); err != nil {
+		log.WithError(err).Errorf("failed to get webhook %s", params.WebhookID)
+		if gorm.IsRecordNotFoundError(errors.Cause(err)) {
+			return installer.NewDeregisterWebhookNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewDeregisterWebhookInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	if err := webhooks.Deregister(b.db, params.WebhookID); err != nil {
+		log.WithError(err).Errorf("failed to deregister webhook %s", params.WebhookID)
+		if gorm.IsRecordNotFoundError(errors.Cause(err)) {
+			return installer.NewDeregisterWebhookNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewDeregisterWebhookInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return installer.NewDeregisterWebhookNoContent()
+}
+
+func (b *bareMetalInventory) ListWebhookDeliveries(ctx context.Context, params installer.ListWebhookDeliveriesParams) middleware.Responder {
+	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyWebhookAccess(ctx, params.WebhookID); err != nil {
+		log.WithError(err).Errorf("failed to get webhook %s", params.WebhookID)
+		if gorm.IsRecordNotFoundError(errors.Cause(err)) {
+			return installer.NewListWebhookDeliveriesNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewListWebhookDeliveriesInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	list, err := webhooks.ListDeliveries(b.db, params.WebhookID)
+	if err != nil {
+		log.WithError(err).Errorf("failed to list deliveries of webhook %s", params.WebhookID)
+		if gorm.IsRecordNotFoundError(errors.Cause(err)) {
+			return installer.NewListWebhookDeliveriesNotFound().WithPayload(generateError(http.StatusNotFound))
+		}
+		return installer.NewListWebhookDeliveriesInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
+	return installer.NewListWebhookDeliveriesOK().WithPayload(list)
 }
 
 func (b *bareMetalInventory) UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder {
 	log := logutil.FromContext(ctx, b.log)
+	if err := b.verifyAgentToken(ctx, params.ClusterID, params.XAgentToken); err != nil {
+		if errors.Cause(err) == agenttoken.ErrInvalidToken {
+			return installer.NewUpdateHostInstallProgressUnauthorized().
+				WithPayload(generateError(http.StatusUnauthorized))
+		}
+		return installer.NewUpdateHostInstallProgressInternalServerError().
+			WithPayload(generateError(http.StatusInternalServerError))
+	}
 	var host models.Host
 	if err := b.db.First(&host, "id = ? and cluster_id = ?", params.HostID, params.ClusterID).Error; err != nil {
 		log.WithError(err).Errorf("failed to find host %s", params.HostID)
@@ -883,3 +1750,11 @@ func generateError(id int32) *models.Error {
 		Reason: swag.String(""),
 	}
 }
+
+// generateFieldErrors returns a bad request error that lists the invalid fields of the request
+func generateFieldErrors(fieldErrors []*models.FieldError) *models.Error {
+	err := generateError(http.StatusBadRequest)
+	err.Reason = swag.String(network.ToString(fieldErrors))
+	err.FieldErrors = fieldErrors
+	return err
+}
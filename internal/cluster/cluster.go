@@ -7,6 +7,7 @@ import (
 	"github.com/go-openapi/strfmt"
 
 	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/leader"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
@@ -31,15 +32,21 @@ type InstallationAPI interface {
 	Install(ctx context.Context, c *models.Cluster, db *gorm.DB) error
 	// Get the cluster master nodes ID's
 	GetMasterNodesIds(ctx context.Context, c *models.Cluster, db *gorm.DB) ([]*strfmt.UUID, error)
+	// Move the installing cluster to error when the preparation of its installation failed after it was committed
+	HandlePreInstallError(ctx context.Context, c *models.Cluster, err error)
 }
 
 type API interface {
 	StateAPI
 	RegistrationAPI
 	InstallationAPI
+	// Refresh the status of all the monitored clusters, should be called periodically
+	ClusterMonitoring()
 }
 
 type Manager struct {
+	log             logrus.FieldLogger
+	db              *gorm.DB
 	insufficient    StateAPI
 	ready           StateAPI
 	installing      StateAPI
@@ -47,10 +54,13 @@ type Manager struct {
 	error           StateAPI
 	registrationAPI RegistrationAPI
 	installationAPI InstallationAPI
+	leaderElector   leader.ElectorInterface
 }
 
-func NewManager(log logrus.FieldLogger, db *gorm.DB) *Manager {
+func NewManager(log logrus.FieldLogger, db *gorm.DB, leaderElector leader.ElectorInterface) *Manager {
 	return &Manager{
+		log:             log,
+		db:              db,
 		insufficient:    NewInsufficientState(log, db),
 		ready:           NewReadyState(log, db),
 		installing:      NewInstallingState(log, db),
@@ -58,6 +68,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB) *Manager {
 		error:           NewErrorState(log, db),
 		registrationAPI: NewRegistrar(log, db),
 		installationAPI: NewInstaller(log, db),
+		leaderElector:   leaderElector,
 	}
 }
 
@@ -100,3 +111,7 @@ func (m *Manager) Install(ctx context.Context, c *models.Cluster, db *gorm.DB) e
 func (m *Manager) GetMasterNodesIds(ctx context.Context, c *models.Cluster, db *gorm.DB) ([]*strfmt.UUID, error) {
 	return m.installationAPI.GetMasterNodesIds(ctx, c, db)
 }
+
+func (m *Manager) HandlePreInstallError(ctx context.Context, c *models.Cluster, err error) {
+	m.installationAPI.HandlePreInstallError(ctx, c, err)
+}
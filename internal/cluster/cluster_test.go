@@ -13,6 +13,8 @@ import (
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	"github.com/sirupsen/logrus"
 
+	"github.com/filanov/bm-inventory/internal/agenttoken"
+	"github.com/filanov/bm-inventory/internal/webhooks"
 	"github.com/filanov/bm-inventory/models"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -30,7 +32,7 @@ var _ = Describe("statemachine", func() {
 
 	BeforeEach(func() {
 		db = prepareDB()
-		state = NewManager(getTestLog(), db)
+		state = NewManager(getTestLog(), db, nil)
 		id := strfmt.UUID(uuid.New().String())
 		cluster = models.Cluster{
 			ID:     &id,
@@ -61,6 +63,7 @@ func prepareDB() *gorm.DB {
 	Expect(err).ShouldNot(HaveOccurred())
 	db.AutoMigrate(&models.Cluster{})
 	db.AutoMigrate(&models.Host{})
+	db.AutoMigrate(&models.Event{}, &webhooks.Subscription{}, &models.WebhookDelivery{}, &agenttoken.Token{})
 	return db
 }
 
@@ -81,17 +84,31 @@ func geCluster(clusterId strfmt.UUID, db *gorm.DB) models.Cluster {
 	return cluster
 }
 func addInstallationRequirements(clusterId strfmt.UUID, db *gorm.DB) {
-	var hostId strfmt.UUID
-	var host models.Host
+	var hostIds []strfmt.UUID
 	for i := 0; i < 3; i++ {
-		hostId = strfmt.UUID(uuid.New().String())
-		host = models.Host{
-			ID:        &hostId,
-			ClusterID: clusterId,
-			Role:      "master",
-			Status:    swag.String("known"),
+		hostIds = append(hostIds, strfmt.UUID(uuid.New().String()))
+	}
+	for _, hostId := range hostIds {
+		hostId := hostId
+		host := models.Host{
+			ID:           &hostId,
+			ClusterID:    clusterId,
+			Role:         "master",
+			Status:       swag.String("known"),
+			Connectivity: getTestConnectivityReport(hostIds...),
 		}
 		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	}
+}
 
+// getTestConnectivityReport returns a connectivity report with successful l2 connectivity to the given hosts
+func getTestConnectivityReport(remoteHostIds ...strfmt.UUID) *models.ConnectivityReport {
+	var report models.ConnectivityReport
+	for _, id := range remoteHostIds {
+		report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+			HostID:         id,
+			L2Connectivity: []*models.L2Connectivity{{Successful: true}},
+		})
 	}
+	return &report
 }
@@ -1,6 +1,14 @@
 package cluster
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/filanov/bm-inventory/internal/events"
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/internal/validations"
+	"github.com/filanov/bm-inventory/internal/webhooks"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -17,6 +25,11 @@ const (
 	clusterStatusError        = "error"
 )
 
+const (
+	statusInfoReady            = "Cluster is ready for installation"
+	statusInfoPreInstallFailed = "Failed to generate the installation config and the kubeconfig of the cluster"
+)
+
 type UpdateReply struct {
 	State     string
 	IsChanged bool
@@ -28,24 +41,60 @@ type baseState struct {
 	db  *gorm.DB           //nolint:structcheck
 }
 
-func updateState(state string, c *models.Cluster, db *gorm.DB, log logrus.FieldLogger) (*UpdateReply, error) {
-	dbReply := db.Model(&models.Cluster{}).Where("id = ? and status = ?",
-		c.ID.String(), swag.StringValue(c.Status)).Update("status", state)
-	if dbReply.Error != nil {
-		return nil, errors.Wrapf(dbReply.Error, "failed to update cluster %s state from %s to %s",
-			c.ID.String(), swag.StringValue(c.Status), state)
+func updateState(ctx context.Context, state string, c *models.Cluster, db *gorm.DB, log logrus.FieldLogger) (*UpdateReply, error) {
+	return updateStateWithParams(ctx, state, swag.StringValue(c.StatusInfo), c, db, log)
+}
+
+// updateStateWithParams moves the cluster to the given state together with the given extra fields, and records
+// the transition as an event in the same transaction
+func updateStateWithParams(ctx context.Context, state, statusInfo string, c *models.Cluster, db *gorm.DB,
+	log logrus.FieldLogger, extra ...interface{}) (*UpdateReply, error) {
+	updates := map[string]interface{}{"status": state, "status_info": statusInfo}
+	if len(extra)%2 != 0 {
+		return nil, errors.Errorf("invalid update extra parameters %+v", extra)
 	}
-	if dbReply.RowsAffected == 0 {
-		return nil, errors.Errorf("failed to update cluster %s state from %s to %s, nothing have changed",
-			c.ID.String(), swag.StringValue(c.Status), state)
+	for i := 0; i < len(extra); i += 2 {
+		updates[extra[i].(string)] = extra[i+1]
 	}
-	log.Infof("updated cluster %s from state <%s> to state <%s>", c.ID.String(), swag.StringValue(c.Status), state)
+	err := events.Transaction(db, func(tx *gorm.DB) error {
+		dbReply := tx.Model(&models.Cluster{}).Where("id = ? and status = ?",
+			c.ID.String(), swag.StringValue(c.Status)).
+			Updates(updates)
+		if dbReply.Error != nil {
+			return errors.Wrapf(dbReply.Error, "failed to update cluster %s state from %s to %s",
+				c.ID.String(), swag.StringValue(c.Status), state)
+		}
+		if dbReply.RowsAffected == 0 {
+			return errors.Errorf("failed to update cluster %s state from %s to %s, nothing have changed",
+				c.ID.String(), swag.StringValue(c.Status), state)
+		}
+		if state == swag.StringValue(c.Status) && statusInfo == swag.StringValue(c.StatusInfo) {
+			return nil
+		}
+		return addStateTransition(ctx, tx, c, swag.StringValue(c.Status), state, statusInfo)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("updated cluster %s from state <%s> to state <%s> with status info <%s>",
+		c.ID.String(), swag.StringValue(c.Status), state, statusInfo)
 	return &UpdateReply{
 		State:     state,
 		IsChanged: state != swag.StringValue(c.Status),
 	}, nil
 }
 
+// addStateTransition records the cluster state transition and notifies the webhooks subscribed to it,
+// in the transaction of the transition
+func addStateTransition(ctx context.Context, tx *gorm.DB, c *models.Cluster,
+	fromStatus, toStatus, statusInfo string) error {
+	event, err := events.AddStateTransition(ctx, tx, *c.ID, "", fromStatus, toStatus, statusInfo)
+	if err != nil {
+		return err
+	}
+	return webhooks.Enqueue(tx, event)
+}
+
 func getKnownMastersNodesIds(c *models.Cluster, db *gorm.DB) ([]*strfmt.UUID, error) {
 
 	var cluster models.Cluster
@@ -61,17 +110,98 @@ func getKnownMastersNodesIds(c *models.Cluster, db *gorm.DB) ([]*strfmt.UUID, er
 	return masterNodesIds, nil
 }
 
-func isClusterReady(c *models.Cluster, db *gorm.DB, log logrus.FieldLogger) (bool, error) {
-	masterNodesIds, err := getKnownMastersNodesIds(c, db)
-	if err != nil {
-		return false, errors.Errorf("unable to determine cluster %s hosts state ", c.ID)
+// IDs of the cluster validations
+const (
+	validationMasterHostsCount  = "master-hosts-count"
+	validationHostsConnectivity = "hosts-connectivity"
+	validationNetworkConfig     = "network-configuration"
+)
+
+type isReadyReply struct {
+	IsReady     bool
+	Reason      string
+	Validations []*models.ValidationResult
+}
+
+// isClusterReady checks that the cluster has enough known masters, that the known hosts can reach each other
+// and that the network configuration is valid.
+// When the cluster is not ready the returned reason describes what is missing.
+func isClusterReady(c *models.Cluster, db *gorm.DB, log logrus.FieldLogger) (*isReadyReply, error) {
+	var cluster models.Cluster
+	if err := db.Preload("Hosts").First(&cluster, "id = ?", c.ID).Error; err != nil {
+		return nil, errors.Errorf("unable to determine cluster %s hosts state ", c.ID)
+	}
+	// auto-assigned hosts may become masters when the installation starts, so until then
+	// they are counted and checked for connectivity as masters
+	var masters, autoAssigned, workers []*models.Host
+	for _, host := range cluster.Hosts {
+		if swag.StringValue(host.Status) != "known" {
+			continue
+		}
+		switch host.Role {
+		case "master":
+			masters = append(masters, host)
+		case "auto-assign":
+			autoAssigned = append(autoAssigned, host)
+		case "worker":
+			workers = append(workers, host)
+		}
 	}
+	reply := &isReadyReply{}
 	minimumKnownMasterNodes := 3
-	if len(masterNodesIds) < minimumKnownMasterNodes {
-		log.Infof("cluster %s has %d known master hosts which is less then the %d minimum needed for cluster installation",
-			c.ID, len(masterNodesIds), minimumKnownMasterNodes)
-		return false, nil
-	} else {
-		return true, nil
+	mastersOk := len(masters)+len(autoAssigned) >= minimumKnownMasterNodes
+	mastersActual := fmt.Sprintf("%d", len(masters))
+	if len(autoAssigned) > 0 {
+		mastersActual = fmt.Sprintf("%d masters and %d auto-assigned hosts", len(masters), len(autoAssigned))
+	}
+	reply.Validations = append(reply.Validations, validations.New(validationMasterHostsCount,
+		models.ValidationResultCategoryRole, mastersOk,
+		fmt.Sprintf("%d", minimumKnownMasterNodes), mastersActual))
+	// the VIPs have to be inside the subnets of the hosts that are going to be installed
+	knownHosts := append(append(append([]*models.Host{}, masters...), autoAssigned...), workers...)
+	networkErrors := network.ValidateCluster(&cluster, knownHosts)
+	const networkExpected = "valid and non-overlapping networks and VIPs inside the hosts machine networks"
+	networkActual := networkExpected
+	if len(networkErrors) > 0 {
+		networkActual = network.ToString(networkErrors)
+	}
+	networkValidation := validations.New(validationNetworkConfig,
+		models.ValidationResultCategoryNetwork, len(networkErrors) == 0, networkExpected, networkActual)
+	const connectivityExpected = "all masters reachable from every known host"
+	if !mastersOk {
+		log.Infof("cluster %s has %d known master hosts and %d known auto-assigned hosts which is less then "+
+			"the %d minimum needed for cluster installation",
+			c.ID, len(masters), len(autoAssigned), minimumKnownMasterNodes)
+		if len(autoAssigned) > 0 {
+			reply.Reason = fmt.Sprintf("cluster has %d known master hosts and %d known auto-assigned hosts, "+
+				"at least %d masters are required", len(masters), len(autoAssigned), minimumKnownMasterNodes)
+		} else {
+			reply.Reason = fmt.Sprintf("cluster has %d known master hosts, at least %d are required",
+				len(masters), minimumKnownMasterNodes)
+		}
+		// connectivity is checked only once all the masters are known
+		reply.Validations = append(reply.Validations, validations.NewPending(validationHostsConnectivity,
+			models.ValidationResultCategoryNetwork, connectivityExpected), networkValidation)
+		return reply, nil
+	}
+	failures := getConnectivityFailures(append(masters, autoAssigned...), workers)
+	connectivityActual := connectivityExpected
+	if len(failures) > 0 {
+		connectivityActual = fmt.Sprintf("no connectivity between: %s", strings.Join(failures, ", "))
+	}
+	reply.Validations = append(reply.Validations, validations.New(validationHostsConnectivity,
+		models.ValidationResultCategoryNetwork, len(failures) == 0, connectivityExpected, connectivityActual),
+		networkValidation)
+	if len(failures) > 0 {
+		log.Infof("cluster %s hosts connectivity check failed between: %s", c.ID, strings.Join(failures, ", "))
+		reply.Reason = fmt.Sprintf("no connectivity between hosts: %s", strings.Join(failures, ", "))
+		return reply, nil
+	}
+	if len(networkErrors) > 0 {
+		log.Infof("cluster %s network configuration is invalid: %s", c.ID, networkActual)
+		reply.Reason = fmt.Sprintf("invalid network configuration: %s", networkActual)
+		return reply, nil
 	}
+	reply.IsReady = true
+	return reply, nil
 }
@@ -0,0 +1,47 @@
+package cluster
+
+import (
+	"fmt"
+
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// connectivityMatrix holds for every host the set of remote hosts it managed to reach,
+// according to the last connectivity report it sent
+type connectivityMatrix map[strfmt.UUID]map[strfmt.UUID]bool
+
+func newConnectivityMatrix(hosts ...[]*models.Host) connectivityMatrix {
+	matrix := make(connectivityMatrix)
+	for _, group := range hosts {
+		for _, h := range group {
+			matrix[*h.ID] = network.ReachableHosts(h)
+		}
+	}
+	return matrix
+}
+
+func (m connectivityMatrix) canReach(from, to *models.Host) bool {
+	return m[*from.ID][*to.ID]
+}
+
+// getConnectivityFailures returns the host pairs that failed the connectivity check.
+// Every master must reach every other master, and every worker must reach all the masters.
+func getConnectivityFailures(masters, workers []*models.Host) []string {
+	matrix := newConnectivityMatrix(masters, workers)
+	hosts := make([]*models.Host, 0, len(masters)+len(workers))
+	hosts = append(append(hosts, masters...), workers...)
+	var failures []string
+	for _, from := range hosts {
+		for _, to := range masters {
+			if from.ID.String() == to.ID.String() {
+				continue
+			}
+			if !matrix.canReach(from, to) {
+				failures = append(failures, fmt.Sprintf("%s -> %s", from.ID, to.ID))
+			}
+		}
+	}
+	return failures
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/go-openapi/swag"
 
 	"github.com/filanov/bm-inventory/models"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
 	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
 )
@@ -45,7 +46,7 @@ func (i *installer) Install(ctx context.Context, c *models.Cluster, db *gorm.DB)
 		return errors.Errorf("cluster %s state is unclear - cluster state: %s", c.ID, swag.StringValue(c.Status))
 	}
 
-	_, err := updateState(clusterStatusInstalling, c, db, i.log)
+	_, err := updateState(ctx, clusterStatusInstalling, c, db, i.log)
 	if err != nil {
 		return err
 	}
@@ -53,6 +54,15 @@ func (i *installer) Install(ctx context.Context, c *models.Cluster, db *gorm.DB)
 	return nil
 }
 
+func (i *installer) HandlePreInstallError(ctx context.Context, c *models.Cluster, err error) {
+	log := logutil.FromContext(ctx, i.log)
+	log.WithError(err).Warnf("cluster %s installation preparation failed", c.ID)
+	if _, updateErr := updateStateWithParams(ctx, clusterStatusError, statusInfoPreInstallFailed, c, i.db,
+		i.log); updateErr != nil {
+		log.WithError(updateErr).Errorf("failed to move cluster %s to error", c.ID)
+	}
+}
+
 func (i *installer) GetMasterNodesIds(ctx context.Context, cluster *models.Cluster, db *gorm.DB) ([]*strfmt.UUID, error) {
 	return getKnownMastersNodesIds(cluster, db)
 }
@@ -74,6 +74,14 @@ var _ = Describe("installer", func() {
 		})
 	})
 
+	It("pre_install_error", func() {
+		cluster = updateClusterState(cluster, clusterStatusInstalling, db)
+		installerManager.HandlePreInstallError(ctx, &cluster, errors.Errorf("job failed"))
+		Expect(db.First(&cluster, "id = ?", cluster.ID).Error).ShouldNot(HaveOccurred())
+		Expect(swag.StringValue(cluster.Status)).Should(Equal(clusterStatusError))
+		Expect(swag.StringValue(cluster.StatusInfo)).Should(Equal(statusInfoPreInstallFailed))
+	})
+
 	Context("get master nodes ids", func() {
 		It("test getting master ids", func() {
 
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/filanov/bm-inventory/internal/validations"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
@@ -22,15 +23,24 @@ type insufficientState baseState
 
 func (i *insufficientState) RefreshStatus(ctx context.Context, c *models.Cluster, db *gorm.DB) (*UpdateReply, error) {
 
-	clusterIsReady, err := isClusterReady(c, db, i.log)
+	reply, err := isClusterReady(c, db, i.log)
 	if err != nil {
 		return nil, errors.Errorf("unable to determine cluster %s hosts state ", c.ID)
 	}
+	validationsInfo, err := validations.ToString(reply.Validations)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode validations of cluster %s", c.ID)
+	}
 
-	if clusterIsReady {
-		return updateState(clusterStatusReady, c, db, i.log)
+	if reply.IsReady {
+		return updateStateWithParams(ctx, clusterStatusReady, statusInfoReady, c, db, i.log,
+			"validations_info", validationsInfo)
 	} else {
-		i.log.Infof("Cluster %s does not have sufficient resources to be installed.", c.ID)
+		i.log.Infof("Cluster %s does not have sufficient resources to be installed: %s", c.ID, reply.Reason)
+		if reply.Reason != swag.StringValue(c.StatusInfo) || validationsInfo != c.ValidationsInfo {
+			return updateStateWithParams(ctx, clusterStatusInsufficient, reply.Reason, c, db, i.log,
+				"validations_info", validationsInfo)
+		}
 		return &UpdateReply{
 			State:     clusterStatusInsufficient,
 			IsChanged: false,
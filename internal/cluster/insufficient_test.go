@@ -2,6 +2,7 @@ package cluster
 
 import (
 	context "context"
+	"encoding/json"
 
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
@@ -58,7 +59,71 @@ var _ = Describe("insufficient_state", func() {
 			Expect(updateReply.State).Should(Equal(clusterStatusReady))
 			c := geCluster(*cluster.ID, db)
 			Expect(swag.StringValue(c.Status)).Should(Equal(clusterStatusReady))
+			Expect(swag.StringValue(c.StatusInfo)).Should(Equal(statusInfoReady))
+		})
+
+		It("not enough masters status info", func() {
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+			Expect(updateErr).Should(BeNil())
+			Expect(updateReply.IsChanged).Should(BeFalse())
+			c := geCluster(*cluster.ID, db)
+			Expect(swag.StringValue(c.StatusInfo)).Should(Equal("cluster has 0 known master hosts, at least 3 are required"))
+			var results models.ValidationResults
+			Expect(json.Unmarshal([]byte(c.ValidationsInfo), &results)).ShouldNot(HaveOccurred())
+			Expect(results).Should(HaveLen(3))
+			Expect(swag.StringValue(results[0].ID)).Should(Equal(validationMasterHostsCount))
+			Expect(swag.StringValue(results[0].Status)).Should(Equal(models.ValidationResultStatusFailure))
+			Expect(results[0].Actual).Should(Equal("0"))
+			Expect(swag.StringValue(results[1].ID)).Should(Equal(validationHostsConnectivity))
+			Expect(swag.StringValue(results[1].Status)).Should(Equal(models.ValidationResultStatusPending))
+		})
+
+		It("auto-assigned hosts count as masters", func() {
+			addInstallationRequirements(id, db)
+			Expect(db.Model(&models.Host{}).Where("cluster_id = ?", id).
+				Update("role", "auto-assign").Error).ShouldNot(HaveOccurred())
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+			Expect(updateErr).Should(BeNil())
+			Expect(updateReply.State).Should(Equal(clusterStatusReady))
+		})
 
+		It("not enough auto-assigned hosts status info", func() {
+			hostId := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&models.Host{
+				ID:        &hostId,
+				ClusterID: id,
+				Role:      "auto-assign",
+				Status:    swag.String("known"),
+			}).Error).ShouldNot(HaveOccurred())
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+			Expect(updateErr).Should(BeNil())
+			Expect(updateReply.State).Should(Equal(clusterStatusInsufficient))
+			c := geCluster(*cluster.ID, db)
+			Expect(swag.StringValue(c.StatusInfo)).Should(Equal(
+				"cluster has 0 known master hosts and 1 known auto-assigned hosts, at least 3 masters are required"))
+		})
+
+		It("worker without connectivity to masters", func() {
+			addInstallationRequirements(id, db)
+			workerId := strfmt.UUID(uuid.New().String())
+			Expect(db.Create(&models.Host{
+				ID:           &workerId,
+				ClusterID:    id,
+				Role:         "worker",
+				Status:       swag.String("known"),
+				Connectivity: getTestConnectivityReport(),
+			}).Error).ShouldNot(HaveOccurred())
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+			Expect(updateErr).Should(BeNil())
+			Expect(updateReply.State).Should(Equal(clusterStatusInsufficient))
+			c := geCluster(*cluster.ID, db)
+			Expect(swag.StringValue(c.Status)).Should(Equal(clusterStatusInsufficient))
+			Expect(swag.StringValue(c.StatusInfo)).Should(HavePrefix("no connectivity between hosts: "))
+			for _, h := range c.Hosts {
+				if h.Role == "master" {
+					Expect(swag.StringValue(c.StatusInfo)).Should(ContainSubstring(workerId.String() + " -> " + h.ID.String()))
+				}
+			}
 		})
 	})
 
@@ -154,6 +154,18 @@ func (mr *MockInstallationAPIMockRecorder) GetMasterNodesIds(ctx, c, db interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterNodesIds", reflect.TypeOf((*MockInstallationAPI)(nil).GetMasterNodesIds), ctx, c, db)
 }
 
+// HandlePreInstallError mocks base method.
+func (m *MockInstallationAPI) HandlePreInstallError(ctx context.Context, c *models.Cluster, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandlePreInstallError", ctx, c, err)
+}
+
+// HandlePreInstallError indicates an expected call of HandlePreInstallError.
+func (mr *MockInstallationAPIMockRecorder) HandlePreInstallError(ctx, c, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePreInstallError", reflect.TypeOf((*MockInstallationAPI)(nil).HandlePreInstallError), ctx, c, err)
+}
+
 // MockAPI is a mock of API interface.
 type MockAPI struct {
 	ctrl     *gomock.Controller
@@ -248,3 +260,27 @@ func (mr *MockAPIMockRecorder) GetMasterNodesIds(ctx, c, db interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMasterNodesIds", reflect.TypeOf((*MockAPI)(nil).GetMasterNodesIds), ctx, c, db)
 }
+
+// HandlePreInstallError mocks base method.
+func (m *MockAPI) HandlePreInstallError(ctx context.Context, c *models.Cluster, err error) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HandlePreInstallError", ctx, c, err)
+}
+
+// HandlePreInstallError indicates an expected call of HandlePreInstallError.
+func (mr *MockAPIMockRecorder) HandlePreInstallError(ctx, c, err interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePreInstallError", reflect.TypeOf((*MockAPI)(nil).HandlePreInstallError), ctx, c, err)
+}
+
+// ClusterMonitoring mocks base method.
+func (m *MockAPI) ClusterMonitoring() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ClusterMonitoring")
+}
+
+// ClusterMonitoring indicates an expected call of ClusterMonitoring.
+func (mr *MockAPIMockRecorder) ClusterMonitoring() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClusterMonitoring", reflect.TypeOf((*MockAPI)(nil).ClusterMonitoring))
+}
@@ -0,0 +1,42 @@
+package cluster
+
+import (
+	"context"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+)
+
+const monitorBatchSize = 100
+
+// clusters in these states are changed according to the state of their hosts
+var monitorStates = []string{clusterStatusInsufficient, clusterStatusReady}
+
+// ClusterMonitoring refreshes the status of all the clusters in a monitored state, in batches.
+// Only the leader instance is running the monitoring to avoid duplicate state transitions.
+func (m *Manager) ClusterMonitoring() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	// clusters are scanned by their primary key rather than by offset, since refreshed clusters may leave
+	// the monitored states and shift the offsets of the rest
+	lastID := ""
+	for {
+		var clusters []*models.Cluster
+		if err := m.db.Where("status in (?) and id > ?", monitorStates, lastID).Order("id").
+			Limit(monitorBatchSize).Find(&clusters).Error; err != nil {
+			m.log.WithError(err).Errorf("failed to get clusters for monitoring")
+			return
+		}
+		for _, cluster := range clusters {
+			if _, err := m.RefreshStatus(ctx, cluster, m.db); err != nil {
+				m.log.WithError(err).Errorf("failed to refresh cluster %s state", cluster.ID)
+			}
+		}
+		if len(clusters) < monitorBatchSize {
+			return
+		}
+		lastID = clusters[len(clusters)-1].ID.String()
+	}
+}
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("monitor", func() {
+	var (
+		db         *gorm.DB
+		ctrl       *gomock.Controller
+		mockLeader *leader.MockElectorInterface
+		state      *Manager
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockLeader = leader.NewMockElectorInterface(ctrl)
+		state = NewManager(getTestLog(), db, mockLeader)
+	})
+
+	addCluster := func(status string, withHosts bool) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Cluster{ID: &id, Status: swag.String(status)}).Error).ShouldNot(HaveOccurred())
+		if withHosts {
+			addInstallationRequirements(id, db)
+		}
+		return id
+	}
+
+	It("refresh_clusters", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		var toReady []strfmt.UUID
+		// more clusters than a single batch
+		for i := 0; i < monitorBatchSize+10; i++ {
+			toReady = append(toReady, addCluster(clusterStatusInsufficient, true))
+		}
+		toInsufficient := addCluster(clusterStatusReady, false)
+		installing := addCluster(clusterStatusInstalling, false)
+
+		state.ClusterMonitoring()
+
+		for _, id := range toReady {
+			Expect(swag.StringValue(geCluster(id, db).Status)).Should(Equal(clusterStatusReady))
+		}
+		Expect(swag.StringValue(geCluster(toInsufficient, db).Status)).Should(Equal(clusterStatusInsufficient))
+		Expect(swag.StringValue(geCluster(installing, db).Status)).Should(Equal(clusterStatusInstalling))
+	})
+
+	It("not_leader", func() {
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+		id := addCluster(clusterStatusInsufficient, true)
+		state.ClusterMonitoring()
+		Expect(swag.StringValue(geCluster(id, db).Status)).Should(Equal(clusterStatusInsufficient))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		db.Close()
+	})
+})
@@ -5,6 +5,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/filanov/bm-inventory/internal/validations"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/jinzhu/gorm"
 	"github.com/pkg/errors"
@@ -22,23 +23,32 @@ type readyState baseState
 var _ StateAPI = (*Manager)(nil)
 
 func (r *readyState) RefreshStatus(ctx context.Context, c *models.Cluster, db *gorm.DB) (*UpdateReply, error) {
-	clusterIsReady, err := isClusterReady(c, db, r.log)
+	reply, err := isClusterReady(c, db, r.log)
 	if err != nil {
 		return nil, errors.Errorf("unable to determine cluster %s hosts state ", c.ID)
 	}
 
-	if clusterIsReady {
+	validationsInfo, err := validations.ToString(reply.Validations)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode validations of cluster %s", c.ID)
+	}
 
+	if reply.IsReady {
+		// the actual values of the validations may change while the cluster stays ready
+		if validationsInfo != c.ValidationsInfo {
+			return updateStateWithParams(ctx, clusterStatusReady, statusInfoReady, c, db, r.log,
+				"validations_info", validationsInfo)
+		}
 		return &UpdateReply{
 			State:     clusterStatusReady,
 			IsChanged: false,
 		}, nil
 	} else {
-		return updateState(clusterStatusInsufficient, c, db, r.log)
-
+		return updateStateWithParams(ctx, clusterStatusInsufficient, reply.Reason, c, db, r.log,
+			"validations_info", validationsInfo)
 	}
 }
 
 func (r *readyState) Install(ctx context.Context, c *models.Cluster) (*UpdateReply, error) {
-	return updateState(clusterStatusInstalling, c, r.db, r.log)
+	return updateState(ctx, clusterStatusInstalling, c, r.db, r.log)
 }
@@ -2,6 +2,7 @@ package cluster
 
 import (
 	context "context"
+	"encoding/json"
 
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
@@ -53,6 +54,42 @@ var _ = Describe("ready_state", func() {
 			Expect(swag.StringValue(cluster.Status)).Should(Equal(clusterStatusReady))
 		})
 
+		It("validations actual values changed", func() {
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+			Expect(updateErr).Should(BeNil())
+			cluster = geCluster(*cluster.ID, db)
+			Expect(swag.StringValue(cluster.StatusInfo)).Should(Equal(statusInfoReady))
+			var results models.ValidationResults
+			Expect(json.Unmarshal([]byte(cluster.ValidationsInfo), &results)).ShouldNot(HaveOccurred())
+			Expect(results[0].Actual).Should(Equal("3"))
+
+			// a fourth host that may become a master, with full mesh connectivity to the masters
+			hostID := strfmt.UUID(uuid.New().String())
+			hostIDs := []strfmt.UUID{*cluster.Hosts[0].ID, *cluster.Hosts[1].ID, *cluster.Hosts[2].ID, hostID}
+			Expect(db.Create(&models.Host{
+				ID:           &hostID,
+				ClusterID:    id,
+				Role:         "auto-assign",
+				Status:       swag.String("known"),
+				Connectivity: getTestConnectivityReport(hostIDs...),
+			}).Error).ShouldNot(HaveOccurred())
+			for _, h := range cluster.Hosts {
+				Expect(db.Model(h).Update("connectivity", getTestConnectivityReport(hostIDs...)).Error).
+					ShouldNot(HaveOccurred())
+			}
+
+			cluster = geCluster(*cluster.ID, db)
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+			Expect(updateErr).Should(BeNil())
+			Expect(updateReply.State).Should(Equal(clusterStatusReady))
+			Expect(updateReply.IsChanged).Should(Equal(false))
+
+			cluster = geCluster(*cluster.ID, db)
+			Expect(swag.StringValue(cluster.Status)).Should(Equal(clusterStatusReady))
+			Expect(json.Unmarshal([]byte(cluster.ValidationsInfo), &results)).ShouldNot(HaveOccurred())
+			Expect(results[0].Actual).Should(Equal("3 masters and 1 auto-assigned hosts"))
+		})
+
 		It("cluster is not satisfying the install requirements", func() {
 			Expect(db.Where("cluster_id = ?", cluster.ID).Delete(&models.Host{}).Error).NotTo(HaveOccurred())
 
@@ -67,6 +104,52 @@ var _ = Describe("ready_state", func() {
 			Expect(swag.StringValue(cluster.Status)).Should(Equal(clusterStatusInsufficient))
 
 		})
+
+		It("masters without full mesh connectivity", func() {
+			master := cluster.Hosts[0]
+			other := cluster.Hosts[1]
+			Expect(db.Model(master).Update("connectivity",
+				getTestConnectivityReport(*cluster.Hosts[2].ID)).Error).ShouldNot(HaveOccurred())
+
+			cluster = geCluster(*cluster.ID, db)
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+
+			Expect(updateErr).Should(BeNil())
+			Expect(updateReply.State).Should(Equal(clusterStatusInsufficient))
+			Expect(updateReply.IsChanged).Should(Equal(true))
+
+			cluster = geCluster(*cluster.ID, db)
+			Expect(swag.StringValue(cluster.Status)).Should(Equal(clusterStatusInsufficient))
+			Expect(swag.StringValue(cluster.StatusInfo)).Should(Equal(
+				"no connectivity between hosts: " + master.ID.String() + " -> " + other.ID.String()))
+			var results models.ValidationResults
+			Expect(json.Unmarshal([]byte(cluster.ValidationsInfo), &results)).ShouldNot(HaveOccurred())
+			Expect(results).Should(HaveLen(3))
+			Expect(swag.StringValue(results[0].Status)).Should(Equal(models.ValidationResultStatusSuccess))
+			Expect(swag.StringValue(results[1].Category)).Should(Equal(models.ValidationResultCategoryNetwork))
+			Expect(swag.StringValue(results[1].Status)).Should(Equal(models.ValidationResultStatusFailure))
+		})
+
+		It("invalid network configuration", func() {
+			Expect(db.Model(&cluster).Updates(map[string]interface{}{
+				"cluster_network_cidr": "10.128.0.0/14",
+				"service_network_cidr": "10.128.0.0/16",
+			}).Error).ShouldNot(HaveOccurred())
+
+			cluster = geCluster(*cluster.ID, db)
+			updateReply, updateErr = state.RefreshStatus(ctx, &cluster, db)
+
+			Expect(updateErr).Should(BeNil())
+			Expect(updateReply.State).Should(Equal(clusterStatusInsufficient))
+			cluster = geCluster(*cluster.ID, db)
+			Expect(swag.StringValue(cluster.StatusInfo)).Should(Equal("invalid network configuration: " +
+				"service_network_cidr: service network 10.128.0.0/16 overlaps with the cluster network 10.128.0.0/14"))
+			var results models.ValidationResults
+			Expect(json.Unmarshal([]byte(cluster.ValidationsInfo), &results)).ShouldNot(HaveOccurred())
+			Expect(results).Should(HaveLen(3))
+			Expect(swag.StringValue(results[2].ID)).Should(Equal(validationNetworkConfig))
+			Expect(swag.StringValue(results[2].Status)).Should(Equal(models.ValidationResultStatusFailure))
+		})
 	})
 
 	AfterEach(func() {
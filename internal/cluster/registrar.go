@@ -7,6 +7,8 @@ import (
 
 	"github.com/go-openapi/swag"
 
+	"github.com/filanov/bm-inventory/internal/agenttoken"
+	"github.com/filanov/bm-inventory/internal/webhooks"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
@@ -44,6 +46,13 @@ func (r *registrar) RegisterCluster(ctx context.Context, cluster *models.Cluster
 		return err
 	}
 
+	if err := addStateTransition(ctx, tx, cluster, "", clusterStatusInsufficient,
+		swag.StringValue(cluster.StatusInfo)); err != nil {
+		r.log.WithError(err).Errorf("Error registering cluster %s", cluster.Name)
+		tx.Rollback()
+		return err
+	}
+
 	if err := tx.Commit().Error; err != nil {
 		tx.Rollback()
 		return err
@@ -72,6 +81,21 @@ func (r *registrar) DeregisterCluster(ctx context.Context, cluster *models.Clust
 		return errors.Errorf("failed to deregister host while unregistering cluster %s", cluster.ID)
 	}
 
+	if txErr = tx.Where("cluster_id = ?", cluster.ID).Delete(&models.Event{}).Error; txErr != nil {
+		tx.Rollback()
+		return errors.Errorf("failed to delete events of cluster %s", cluster.ID)
+	}
+
+	if txErr = webhooks.DeregisterCluster(tx, *cluster.ID); txErr != nil {
+		tx.Rollback()
+		return errors.Wrapf(txErr, "failed to delete webhooks of cluster %s", cluster.ID)
+	}
+
+	if txErr = agenttoken.Revoke(tx, *cluster.ID); txErr != nil {
+		tx.Rollback()
+		return errors.Wrapf(txErr, "failed to delete agent token of cluster %s", cluster.ID)
+	}
+
 	if txErr = tx.Delete(cluster).Error; txErr != nil {
 		tx.Rollback()
 		return errors.Errorf("failed to delete cluster %s", cluster.ID)
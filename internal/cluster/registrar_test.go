@@ -3,6 +3,8 @@ package cluster
 import (
 	context "context"
 
+	"github.com/filanov/bm-inventory/internal/agenttoken"
+	"github.com/filanov/bm-inventory/internal/events"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -10,6 +12,7 @@ import (
 	"github.com/jinzhu/gorm"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 )
 
 var _ = Describe("registrar", func() {
@@ -42,6 +45,15 @@ var _ = Describe("registrar", func() {
 	})
 
 	Context("register cluster", func() {
+		It("records the registration event", func() {
+			clusterEvents, err := events.List(db, id, events.Filter{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(len(clusterEvents)).Should(Equal(1))
+			Expect(clusterEvents[0].HostID).Should(Equal(strfmt.UUID("")))
+			Expect(clusterEvents[0].FromStatus).Should(Equal(""))
+			Expect(*clusterEvents[0].ToStatus).Should(Equal(clusterStatusInsufficient))
+		})
+
 		It("register a registered cluster", func() {
 			updateErr = registerManager.RegisterCluster(ctx, &cluster)
 			Expect(updateErr).Should(HaveOccurred())
@@ -53,6 +65,8 @@ var _ = Describe("registrar", func() {
 
 	Context("deregister", func() {
 		It("unregister a registered cluster", func() {
+			token, err := agenttoken.Generate(db, id, strfmt.UUID(uuid.New().String()), 0)
+			Expect(err).ShouldNot(HaveOccurred())
 			updateErr = registerManager.DeregisterCluster(ctx, &cluster)
 			Expect(updateErr).Should(BeNil())
 
@@ -61,6 +75,11 @@ var _ = Describe("registrar", func() {
 			Expect(db.First(&cluster, "id = ?", cluster.ID).Error).Should(HaveOccurred())
 			Expect(db.First(&host, "cluster_id = ?", cluster.ID).Error).Should(HaveOccurred())
 
+			clusterEvents, err := events.List(db, id, events.Filter{})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(clusterEvents).Should(BeEmpty())
+			Expect(errors.Cause(agenttoken.Verify(db, id, token))).Should(Equal(agenttoken.ErrInvalidToken))
+
 		})
 		It("unregister a cluster in installing state", func() {
 			// cluster state to installing
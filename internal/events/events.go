@@ -0,0 +1,134 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/database"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// the changes recorded by AddChange, named as the changed fields
+const (
+	ChangeRole             = "role"
+	ChangeHardwareInfo     = "hardware_info"
+	ChangeInventory        = "inventory"
+	ChangeConnectivity     = "connectivity"
+	ChangeBootstrap        = "bootstrap"
+	ChangeInstallationDisk = "installation_disk"
+	ChangeConfiguration    = "configuration"
+)
+
+// AddStateTransition records a state transition of the cluster, or of its host when hostID is set, and returns the
+// recorded event. It should be called with the transaction of the transition, so that the event is recorded only if
+// the transition is.
+func AddStateTransition(ctx context.Context, db *gorm.DB, clusterID, hostID strfmt.UUID,
+	fromStatus, toStatus, statusInfo string) (*models.Event, error) {
+	event := &models.Event{
+		ClusterID:  &clusterID,
+		HostID:     hostID,
+		FromStatus: fromStatus,
+		ToStatus:   swag.String(toStatus),
+		StatusInfo: statusInfo,
+	}
+	if err := add(ctx, db, event); err != nil {
+		return nil, errors.Wrapf(err, "failed to add event of cluster %s host %s transition from %s to %s",
+			clusterID, hostID, fromStatus, toStatus)
+	}
+	return event, nil
+}
+
+// AddChange records a change of the cluster, or of its host when hostID is set, that did not transition its
+// status, so that the watches stream it. It should be called with the transaction of the change.
+func AddChange(ctx context.Context, db *gorm.DB, clusterID, hostID strfmt.UUID, status, change string) error {
+	event := &models.Event{
+		ClusterID:  &clusterID,
+		HostID:     hostID,
+		FromStatus: status,
+		ToStatus:   swag.String(status),
+		Change:     change,
+	}
+	if err := add(ctx, db, event); err != nil {
+		return errors.Wrapf(err, "failed to add event of cluster %s host %s %s change", clusterID, hostID, change)
+	}
+	return nil
+}
+
+func add(ctx context.Context, db *gorm.DB, event *models.Event) error {
+	now := strfmt.DateTime(database.Now())
+	event.RequestID = requestid.FromContext(ctx)
+	event.EventTime = &now
+	return db.Create(event).Error
+}
+
+// Transaction runs fn in a new transaction, or in the transaction db is already part of,
+// in which case the caller commits or rolls it back
+func Transaction(db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	if _, ok := db.CommonDB().(*sql.Tx); ok {
+		return fn(db)
+	}
+	tx := db.Begin()
+	if tx.Error != nil {
+		return errors.Wrapf(tx.Error, "failed to start transaction")
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit().Error; err != nil {
+		return errors.Wrapf(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// Filter selects the events that List returns, the zero value selects all the events of the cluster
+type Filter struct {
+	// HostID selects only the events of the host
+	HostID *strfmt.UUID
+	// From and To select only the events that occurred in [From, To)
+	From, To *time.Time
+	// After selects only the events with greater IDs, it is the ID of the last event of the previous page
+	After int64
+	// Limit is the maximal number of events that are returned, unlimited when it is 0
+	Limit int
+}
+
+// List returns the events of the cluster that the filter selects, in the order they occurred at
+func List(db *gorm.DB, clusterID strfmt.UUID, filter Filter) (models.EventList, error) {
+	query := db.Where("cluster_id = ?", clusterID.String())
+	if filter.HostID != nil {
+		query = query.Where("host_id = ?", filter.HostID.String())
+	}
+	if filter.From != nil {
+		query = query.Where("event_time >= ?", database.Time(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where("event_time < ?", database.Time(*filter.To))
+	}
+	if filter.After > 0 {
+		query = query.Where("id > ?", filter.After)
+	}
+	if filter.Limit > 0 {
+		query = query.Limit(filter.Limit)
+	}
+	events := models.EventList{}
+	if err := query.Order("id").Find(&events).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list events of cluster %s", clusterID)
+	}
+	return events, nil
+}
+
+// DeleteOlderThan deletes the events that occurred before the given time and returns how many were deleted
+func DeleteOlderThan(db *gorm.DB, before time.Time) (int, error) {
+	reply := db.Where("event_time < ?", database.Time(before)).Delete(&models.Event{})
+	if reply.Error != nil {
+		return 0, errors.Wrapf(reply.Error, "failed to delete events that occurred before %s", before)
+	}
+	return int(reply.RowsAffected), nil
+}
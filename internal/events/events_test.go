@@ -0,0 +1,195 @@
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+func TestEvents(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "events tests")
+}
+
+func prepareDB() *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	Expect(err).ShouldNot(HaveOccurred())
+	db.AutoMigrate(&models.Event{})
+	return db
+}
+
+var _ = Describe("events", func() {
+	var (
+		db        *gorm.DB
+		ctx       context.Context
+		clusterID strfmt.UUID
+		hostID    strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctx = requestid.ToContext(context.Background(), "request-id")
+		clusterID = strfmt.UUID(uuid.New().String())
+		hostID = strfmt.UUID(uuid.New().String())
+	})
+
+	// the events are stored with their times in UTC, as AddStateTransition stores them
+	addEvent := func(host strfmt.UUID, eventTime time.Time) {
+		t := strfmt.DateTime(eventTime.UTC())
+		Expect(db.Create(&models.Event{ClusterID: &clusterID, HostID: host, ToStatus: swag.String("known"),
+			EventTime: &t}).Error).ShouldNot(HaveOccurred())
+	}
+
+	It("add_state_transition", func() {
+		event, err := AddStateTransition(ctx, db, clusterID, hostID, "discovering", "known", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		list, err := List(db, clusterID, Filter{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(1))
+		Expect(list[0].ID).Should(Equal(event.ID))
+		Expect(list[0].HostID).Should(Equal(hostID))
+		Expect(list[0].FromStatus).Should(Equal("discovering"))
+		Expect(swag.StringValue(list[0].ToStatus)).Should(Equal("known"))
+		Expect(list[0].RequestID).Should(Equal("request-id"))
+		Expect(list[0].ID).ShouldNot(BeNil())
+	})
+
+	It("transaction_rollback", func() {
+		err := Transaction(db, func(tx *gorm.DB) error {
+			_, err := AddStateTransition(ctx, tx, clusterID, "", "", "insufficient", "")
+			Expect(err).ShouldNot(HaveOccurred())
+			return errors.Errorf("transition failed")
+		})
+		Expect(err).Should(HaveOccurred())
+		list, err := List(db, clusterID, Filter{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(BeEmpty())
+	})
+
+	It("nested_transaction", func() {
+		tx := db.Begin()
+		Expect(Transaction(tx, func(inner *gorm.DB) error {
+			Expect(inner).Should(Equal(tx))
+			_, err := AddStateTransition(ctx, inner, clusterID, "", "", "insufficient", "")
+			return err
+		})).ShouldNot(HaveOccurred())
+		tx.Rollback()
+		list, err := List(db, clusterID, Filter{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(BeEmpty())
+	})
+
+	It("list_filters", func() {
+		// the event times are stored in millisecond precision
+		now := time.Now().Truncate(time.Second)
+		otherHost := strfmt.UUID(uuid.New().String())
+		addEvent(hostID, now.Add(-3*time.Hour))
+		addEvent("", now.Add(-2*time.Hour))
+		addEvent(otherHost, now.Add(-time.Hour))
+		addEvent(hostID, now)
+		otherCluster := strfmt.UUID(uuid.New().String())
+		_, err := AddStateTransition(ctx, db, otherCluster, hostID, "", "known", "")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		list, err := List(db, clusterID, Filter{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(4))
+		for i := 1; i < len(list); i++ {
+			Expect(*list[i].ID).Should(BeNumerically(">", *list[i-1].ID))
+		}
+
+		list, err = List(db, clusterID, Filter{HostID: &hostID})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(2))
+
+		from := now.Add(-2 * time.Hour)
+		to := now
+		list, err = List(db, clusterID, Filter{From: &from, To: &to})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(2))
+		Expect(list[0].HostID).Should(BeEmpty())
+		Expect(list[1].HostID).Should(Equal(otherHost))
+	})
+
+	It("list_non_utc_offset", func() {
+		now := time.Now().Truncate(time.Second)
+		addEvent(hostID, now.Add(-2*time.Hour))
+		addEvent(hostID, now.Add(-time.Hour))
+		addEvent(hostID, now)
+
+		// the same times in an offset that is east and west of UTC
+		for _, offset := range []int{5*60*60 + 30*60, -7 * 60 * 60} {
+			zone := time.FixedZone("test", offset)
+			from := now.Add(-time.Hour).In(zone)
+			to := now.In(zone)
+			list, err := List(db, clusterID, Filter{From: &from, To: &to})
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(list).Should(HaveLen(1))
+			Expect(time.Time(*list[0].EventTime).Equal(now.Add(-time.Hour))).Should(BeTrue())
+		}
+
+		deleted, err := DeleteOlderThan(db, now.Add(-90*time.Minute).In(time.FixedZone("test", -7*60*60)))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(deleted).Should(Equal(1))
+	})
+
+	It("stored_in_utc", func() {
+		_, err := AddStateTransition(ctx, db, clusterID, hostID, "discovering", "known", "")
+		Expect(err).ShouldNot(HaveOccurred())
+		var stored struct{ EventTime string }
+		Expect(db.Table("events").Select("event_time").Scan(&stored).Error).ShouldNot(HaveOccurred())
+		Expect(stored.EventTime).Should(HaveSuffix("Z"))
+	})
+
+	It("list_pages", func() {
+		now := time.Now()
+		for i := 0; i < 5; i++ {
+			addEvent(hostID, now)
+		}
+		var all models.EventList
+		var after int64
+		for {
+			page, err := List(db, clusterID, Filter{After: after, Limit: 2})
+			Expect(err).ShouldNot(HaveOccurred())
+			if len(page) == 0 {
+				break
+			}
+			Expect(len(page)).Should(BeNumerically("<=", 2))
+			all = append(all, page...)
+			after = *page[len(page)-1].ID
+		}
+		Expect(all).Should(HaveLen(5))
+		for i := 1; i < len(all); i++ {
+			Expect(*all[i].ID).Should(BeNumerically(">", *all[i-1].ID))
+		}
+	})
+
+	It("delete_older_than", func() {
+		now := time.Now()
+		for i := 0; i < 10; i++ {
+			addEvent(hostID, now.Add(-2*time.Hour))
+		}
+		addEvent(hostID, now)
+		deleted, err := DeleteOlderThan(db, now.Add(-time.Hour))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(deleted).Should(Equal(10))
+		list, err := List(db, clusterID, Filter{})
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(1))
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
@@ -0,0 +1,280 @@
+package events
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// the maximal number of events that are scanned for each read of the watched changes
+const watchPageSize = 1000
+
+// WatchConfig of the watches. The resource version is the ID of the last event a watch is up to date with.
+//
+// The event IDs are allocated when the events are added, before the transitions are committed, so a transaction can
+// commit an event after a later event was already streamed. Watches don't advance past such a gap in the event IDs
+// until CommitGrace passed since the later event occurred, the gaps that are left after it are events of transactions
+// that were rolled back or events that were deleted. The transactions that add events must therefore commit within
+// CommitGrace, and not wait for slow operations such as jobs.
+type WatchConfig struct {
+	PollInterval     time.Duration `envconfig:"WATCH_POLL_INTERVAL" default:"1s"`
+	BookmarkInterval time.Duration `envconfig:"WATCH_BOOKMARK_INTERVAL" default:"30s"`
+	CommitGrace      time.Duration `envconfig:"WATCH_COMMIT_GRACE" default:"5s"`
+}
+
+// committedVersion returns the greatest resource version after the given version that all the events up to it
+// were committed, and whether there may be more committed events after it
+func committedVersion(db *gorm.DB, after int64, grace time.Duration) (int64, bool, error) {
+	var recent []*models.Event
+	if err := db.Select("id, event_time").Where("id > ?", after).Order("id").Limit(watchPageSize).
+		Find(&recent).Error; err != nil {
+		return after, false, errors.Wrapf(err, "failed to get events after resource version %d", after)
+	}
+	version := after
+	for _, event := range recent {
+		if *event.ID != version+1 && time.Since(time.Time(*event.EventTime)) < grace {
+			return version, false, nil
+		}
+		version = *event.ID
+	}
+	return version, len(recent) == watchPageSize, nil
+}
+
+// ResourceVersion returns the current resource version, a watch resumed from a resource version that was returned
+// before reading the cluster state streams all the changes that were made after the read
+func ResourceVersion(db *gorm.DB, cfg WatchConfig) (int64, error) {
+	var last models.Event
+	if err := db.Select("id").Order("id desc").First(&last).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrapf(err, "failed to get the last event")
+	}
+	after := *last.ID - watchPageSize
+	if after < 0 {
+		after = 0
+	}
+	version, _, err := committedVersion(db, after, cfg.CommitGrace)
+	return version, err
+}
+
+// Watcher serves the watches of all the clusters from a single poller of the committed events, which runs while
+// there are watches
+type Watcher struct {
+	log     logrus.FieldLogger
+	db      *gorm.DB
+	cfg     WatchConfig
+	mu      sync.Mutex
+	watches map[*watch]struct{}
+	// the resource version the poller published the events up to, and the channel that stops it,
+	// nil when the poller is not running
+	version int64
+	stop    chan struct{}
+}
+
+// watch is the queue of the events the poller published to a single watch
+type watch struct {
+	clusterID strfmt.UUID
+	mu        sync.Mutex
+	changes   models.EventList
+	version   int64
+	notify    chan struct{}
+}
+
+func NewWatcher(log logrus.FieldLogger, db *gorm.DB, cfg WatchConfig) *Watcher {
+	return &Watcher{
+		log:     log,
+		db:      db,
+		cfg:     cfg,
+		watches: make(map[*watch]struct{}),
+	}
+}
+
+// subscribe adds a watch of the cluster, starting the poller when it is the first watch, and returns the
+// resource version that the events after it are published to the watch
+func (w *Watcher) subscribe(clusterID strfmt.UUID) (*watch, int64, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stop == nil {
+		version, err := ResourceVersion(w.db, w.cfg)
+		if err != nil {
+			return nil, 0, err
+		}
+		w.version = version
+		w.stop = make(chan struct{})
+		go w.poll(w.stop, version)
+	}
+	sub := &watch{clusterID: clusterID, version: w.version, notify: make(chan struct{}, 1)}
+	w.watches[sub] = struct{}{}
+	return sub, w.version, nil
+}
+
+// unsubscribe removes the watch, and stops the poller when it was the last watch
+func (w *Watcher) unsubscribe(sub *watch) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.watches, sub)
+	if len(w.watches) == 0 && w.stop != nil {
+		close(w.stop)
+		w.stop = nil
+	}
+}
+
+// poll publishes the committed events to the watches every PollInterval, until stop is closed
+func (w *Watcher) poll(stop chan struct{}, version int64) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		next, more, err := committedVersion(w.db, version, w.cfg.CommitGrace)
+		if err != nil {
+			w.log.WithError(err).Error("failed to get the committed events")
+		} else if next > version {
+			var changes models.EventList
+			if err := w.db.Where("id > ? and id <= ?", version, next).Order("id").Find(&changes).Error; err != nil {
+				w.log.WithError(err).Errorf("failed to get the events after resource version %d", version)
+				more = false
+			} else if w.publish(stop, changes, next) {
+				version = next
+			}
+		}
+		if more {
+			continue
+		}
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// publish queues the events to the watches of their clusters, and returns false when the poller was stopped
+func (w *Watcher) publish(stop chan struct{}, changes models.EventList, version int64) bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.stop != stop {
+		return false
+	}
+	w.version = version
+	for sub := range w.watches {
+		sub.mu.Lock()
+		for _, event := range changes {
+			if event.ClusterID.String() == sub.clusterID.String() {
+				sub.changes = append(sub.changes, event)
+			}
+		}
+		sub.version = version
+		queued := len(sub.changes) > 0
+		sub.mu.Unlock()
+		if queued {
+			select {
+			case sub.notify <- struct{}{}:
+			default:
+			}
+		}
+	}
+	return true
+}
+
+// take returns the queued events of the watch and the resource version they were published up to
+func (sub *watch) take() (models.EventList, int64) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	changes := sub.changes
+	sub.changes = nil
+	return changes, sub.version
+}
+
+// Watch sends the state transitions of the cluster and its hosts that are committed after the resource version,
+// in the order of their resource versions, until ctx is done or send fails. Bookmarks are sent when the watch starts
+// and when no change was sent for BookmarkInterval.
+func (w *Watcher) Watch(ctx context.Context, clusterID strfmt.UUID, version int64,
+	send func(*models.WatchEvent) error) error {
+	bookmark := func() error {
+		return send(&models.WatchEvent{
+			Type:            swag.String(models.WatchEventTypeBookmark),
+			ResourceVersion: swag.Int64(version),
+		})
+	}
+	// sendChanges sends the events and returns how many were sent
+	sendChanges := func(changes models.EventList) (int, error) {
+		sent := 0
+		for _, event := range changes {
+			// a watch resumed from a resource version after the one of the poller skips the events it has
+			if *event.ID <= version {
+				continue
+			}
+			if err := send(&models.WatchEvent{
+				Type:            swag.String(models.WatchEventTypeChange),
+				ResourceVersion: event.ID,
+				Event:           event,
+			}); err != nil {
+				return sent, err
+			}
+			version = *event.ID
+			sent++
+		}
+		return sent, nil
+	}
+
+	sub, published, err := w.subscribe(clusterID)
+	if err != nil {
+		return err
+	}
+	defer w.unsubscribe(sub)
+	if err := bookmark(); err != nil {
+		return err
+	}
+	// the events up to the version of the poller were published before the watch started
+	if version < published {
+		var changes models.EventList
+		if err := w.db.Where("cluster_id = ? and id > ? and id <= ?", clusterID.String(), version, published).
+			Order("id").Find(&changes).Error; err != nil {
+			return errors.Wrapf(err, "failed to get the events of cluster %s after resource version %d",
+				clusterID, version)
+		}
+		if _, err := sendChanges(changes); err != nil {
+			return err
+		}
+		version = published
+	}
+
+	timer := time.NewTimer(w.cfg.BookmarkInterval)
+	defer timer.Stop()
+	for {
+		var expired bool
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.notify:
+		case <-timer.C:
+			expired = true
+		}
+		changes, next := sub.take()
+		sent, err := sendChanges(changes)
+		if err != nil {
+			return err
+		}
+		if next > version {
+			version = next
+		}
+		if expired && sent == 0 {
+			if err := bookmark(); err != nil {
+				return err
+			}
+		}
+		if expired || sent > 0 {
+			if !expired && !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(w.cfg.BookmarkInterval)
+		}
+	}
+}
@@ -0,0 +1,231 @@
+package events
+
+import (
+	"context"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+var _ = Describe("watch", func() {
+	var (
+		db        *gorm.DB
+		ctx       context.Context
+		cancel    context.CancelFunc
+		cfg       WatchConfig
+		clusterID strfmt.UUID
+		done      chan struct{}
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		// each connection to an in-memory DB opens a different DB, and the watches run concurrently with the test
+		db.DB().SetMaxOpenConns(1)
+		ctx, cancel = context.WithCancel(context.Background())
+		cfg = WatchConfig{
+			PollInterval:     10 * time.Millisecond,
+			BookmarkInterval: time.Hour,
+			CommitGrace:      time.Minute,
+		}
+		clusterID = strfmt.UUID(uuid.New().String())
+		done = nil
+	})
+
+	addEvent := func(id int64, cluster strfmt.UUID, toStatus string, eventTime time.Time) {
+		t := strfmt.DateTime(eventTime)
+		Expect(db.Create(&models.Event{ID: swag.Int64(id), ClusterID: &cluster, ToStatus: swag.String(toStatus),
+			EventTime: &t}).Error).ShouldNot(HaveOccurred())
+	}
+
+	// watchCluster runs a watch of the cluster from the resource version and returns the channel its watch events
+	// are sent to
+	watchCluster := func(watcher *Watcher, cluster strfmt.UUID, version int64) <-chan *models.WatchEvent {
+		received := make(chan *models.WatchEvent, 100)
+		watchDone := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(watchDone)
+			Expect(watcher.Watch(ctx, cluster, version, func(event *models.WatchEvent) error {
+				received <- event
+				return nil
+			})).ShouldNot(HaveOccurred())
+		}()
+		done = watchDone
+		return received
+	}
+
+	watch := func(version int64) <-chan *models.WatchEvent {
+		return watchCluster(NewWatcher(logrus.New(), db, cfg), clusterID, version)
+	}
+
+	expectChange := func(received <-chan *models.WatchEvent, version int64, toStatus string) {
+		var event *models.WatchEvent
+		Eventually(received).Should(Receive(&event))
+		Expect(swag.StringValue(event.Type)).Should(Equal(models.WatchEventTypeChange))
+		Expect(swag.Int64Value(event.ResourceVersion)).Should(Equal(version))
+		Expect(swag.StringValue(event.Event.ToStatus)).Should(Equal(toStatus))
+	}
+
+	It("resource_version", func() {
+		version, err := ResourceVersion(db, cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(version).Should(Equal(int64(0)))
+
+		addEvent(1, clusterID, "insufficient", time.Now())
+		addEvent(2, clusterID, "ready", time.Now())
+		version, err = ResourceVersion(db, cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(version).Should(Equal(int64(2)))
+	})
+
+	It("resource_version_with_gaps", func() {
+		// event 2 was rolled back, or deleted, long ago
+		addEvent(1, clusterID, "insufficient", time.Now().Add(-time.Hour))
+		addEvent(3, clusterID, "ready", time.Now().Add(-time.Hour))
+		// event 4 may still be committed
+		addEvent(5, clusterID, "installing", time.Now())
+		version, err := ResourceVersion(db, cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(version).Should(Equal(int64(3)))
+
+		cfg.CommitGrace = 0
+		version, err = ResourceVersion(db, cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(version).Should(Equal(int64(5)))
+	})
+
+	It("streams_changes", func() {
+		addEvent(1, clusterID, "insufficient", time.Now())
+		received := watch(1)
+
+		var event *models.WatchEvent
+		Eventually(received).Should(Receive(&event))
+		Expect(swag.StringValue(event.Type)).Should(Equal(models.WatchEventTypeBookmark))
+		Expect(swag.Int64Value(event.ResourceVersion)).Should(Equal(int64(1)))
+
+		addEvent(2, clusterID, "ready", time.Now())
+		addEvent(3, strfmt.UUID(uuid.New().String()), "ready", time.Now())
+		addEvent(4, clusterID, "installing", time.Now())
+		expectChange(received, 2, "ready")
+		expectChange(received, 4, "installing")
+		Consistently(received, 50*time.Millisecond).ShouldNot(Receive())
+
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("resumes_from_resource_version", func() {
+		addEvent(1, clusterID, "insufficient", time.Now())
+		addEvent(2, clusterID, "ready", time.Now())
+		addEvent(3, clusterID, "installing", time.Now())
+		received := watch(1)
+
+		Eventually(received).Should(Receive())
+		expectChange(received, 2, "ready")
+		expectChange(received, 3, "installing")
+		cancel()
+	})
+
+	It("waits_for_uncommitted_events", func() {
+		addEvent(1, clusterID, "insufficient", time.Now())
+		addEvent(3, clusterID, "installing", time.Now())
+		received := watch(1)
+
+		Eventually(received).Should(Receive())
+		Consistently(received, 50*time.Millisecond).ShouldNot(Receive())
+		addEvent(2, clusterID, "ready", time.Now())
+		expectChange(received, 2, "ready")
+		expectChange(received, 3, "installing")
+		cancel()
+	})
+
+	It("skips_expired_gaps", func() {
+		addEvent(1, clusterID, "insufficient", time.Now())
+		addEvent(3, clusterID, "installing", time.Now().Add(-2*time.Minute))
+		received := watch(1)
+
+		Eventually(received).Should(Receive())
+		expectChange(received, 3, "installing")
+		cancel()
+	})
+
+	It("bookmarks", func() {
+		cfg.BookmarkInterval = 20 * time.Millisecond
+		addEvent(1, strfmt.UUID(uuid.New().String()), "ready", time.Now())
+		received := watch(0)
+
+		var event *models.WatchEvent
+		Eventually(received).Should(Receive(&event))
+		Expect(swag.Int64Value(event.ResourceVersion)).Should(Equal(int64(0)))
+		Eventually(received).Should(Receive(&event))
+		Expect(swag.StringValue(event.Type)).Should(Equal(models.WatchEventTypeBookmark))
+		Expect(swag.Int64Value(event.ResourceVersion)).Should(Equal(int64(1)))
+		cancel()
+	})
+
+	It("send_failure", func() {
+		watcher := NewWatcher(logrus.New(), db, cfg)
+		err := watcher.Watch(ctx, clusterID, 0, func(event *models.WatchEvent) error {
+			return errors.Errorf("client disconnected")
+		})
+		Expect(err).Should(HaveOccurred())
+		Expect(watcher.stop).Should(BeNil())
+	})
+
+	It("shared_poller", func() {
+		otherCluster := strfmt.UUID(uuid.New().String())
+		addEvent(1, clusterID, "insufficient", time.Now())
+		watcher := NewWatcher(logrus.New(), db, cfg)
+		received := watchCluster(watcher, clusterID, 1)
+		firstDone := done
+		otherCtx, otherCancel := context.WithCancel(ctx)
+		otherReceived := make(chan *models.WatchEvent, 100)
+		otherDone := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(otherDone)
+			Expect(watcher.Watch(otherCtx, otherCluster, 0, func(event *models.WatchEvent) error {
+				otherReceived <- event
+				return nil
+			})).ShouldNot(HaveOccurred())
+		}()
+		Eventually(received).Should(Receive())
+		Eventually(otherReceived).Should(Receive())
+		Eventually(func() int {
+			watcher.mu.Lock()
+			defer watcher.mu.Unlock()
+			return len(watcher.watches)
+		}).Should(Equal(2))
+
+		addEvent(2, otherCluster, "ready", time.Now())
+		addEvent(3, clusterID, "ready", time.Now())
+		expectChange(received, 3, "ready")
+		expectChange(otherReceived, 2, "ready")
+		Consistently(otherReceived, 50*time.Millisecond).ShouldNot(Receive())
+
+		// the poller stops with the last watch
+		otherCancel()
+		Eventually(otherDone).Should(BeClosed())
+		cancel()
+		Eventually(firstDone).Should(BeClosed())
+		watcher.mu.Lock()
+		defer watcher.mu.Unlock()
+		Expect(watcher.stop).Should(BeNil())
+	})
+
+	AfterEach(func() {
+		cancel()
+		if done != nil {
+			Eventually(done).Should(BeClosed())
+		}
+		db.Close()
+	})
+})
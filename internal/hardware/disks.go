@@ -0,0 +1,173 @@
+package hardware
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/pkg/errors"
+)
+
+// DiskPolicy is the order in which the valid disks are considered for installation
+type DiskPolicy string
+
+const (
+	DiskPolicySmallest DiskPolicy = "smallest"
+	DiskPolicyLargest  DiskPolicy = "largest"
+)
+
+// Decode implements envconfig.Decoder
+func (p *DiskPolicy) Decode(value string) error {
+	switch DiskPolicy(value) {
+	case DiskPolicySmallest, DiskPolicyLargest:
+		*p = DiskPolicy(value)
+		return nil
+	}
+	return errors.Errorf("invalid installation disk policy %s, expected %s or %s",
+		value, DiskPolicySmallest, DiskPolicyLargest)
+}
+
+// DiskPatterns is a comma separated list of regular expressions of disk names
+type DiskPatterns []*regexp.Regexp
+
+// Decode implements envconfig.Decoder
+func (p *DiskPatterns) Decode(value string) error {
+	var patterns DiskPatterns
+	for _, pattern := range strings.Split(value, ",") {
+		if pattern == "" {
+			continue
+		}
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return errors.Wrapf(err, "invalid disk name pattern %s", pattern)
+		}
+		patterns = append(patterns, re)
+	}
+	*p = patterns
+	return nil
+}
+
+// DiskSelectionCfg is the default policy used to pick the installation disk of hosts for which the user did not
+// choose a disk. The disks matching the exclude patterns are not valid installation disks, neither for the default
+// policy nor for the disks chosen by the users.
+type DiskSelectionCfg struct {
+	Policy          DiskPolicy   `envconfig:"INSTALLATION_DISK_POLICY" default:"smallest"`
+	PreferSSD       bool         `envconfig:"INSTALLATION_DISK_PREFER_SSD" default:"false"`
+	ExcludePatterns DiskPatterns `envconfig:"INSTALLATION_DISK_EXCLUDE_PATTERNS"`
+}
+
+// getInventoryDisks returns the disks reported in the host inventory, the inventory is optional
+func getInventoryDisks(host *models.Host) ([]*models.Disk, error) {
+	if host.Inventory == "" {
+		return nil, nil
+	}
+	var inventory models.Inventory
+	if err := json.Unmarshal([]byte(host.Inventory), &inventory); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode inventory of host %s", host.ID)
+	}
+	return inventory.Disks, nil
+}
+
+// findInventoryDisk returns the inventory disk identified by its name, by-path, WWN or serial number
+func findInventoryDisk(disks []*models.Disk, diskID string) *models.Disk {
+	for _, disk := range disks {
+		if disk == nil {
+			continue
+		}
+		for _, id := range []string{disk.Name, disk.ByPath, disk.Wwn, disk.Serial} {
+			if id != "" && id == diskID {
+				return disk
+			}
+		}
+	}
+	return nil
+}
+
+func findBlockDevice(disks []*models.BlockDevice, name string) *models.BlockDevice {
+	for _, disk := range disks {
+		if disk.Name == name {
+			return disk
+		}
+	}
+	return nil
+}
+
+func (v *validator) ValidateInstallationDisk(host *models.Host, profileName, diskID string) error {
+	_, err := v.findInstallationDisk(host, profileName, diskID)
+	return err
+}
+
+// findInstallationDisk returns the inventory disk identified by diskID, if it is a valid installation disk
+func (v *validator) findInstallationDisk(host *models.Host, profileName, diskID string) (*models.Disk, error) {
+	inventoryDisks, err := getInventoryDisks(host)
+	if err != nil {
+		return nil, err
+	}
+	disk := findInventoryDisk(inventoryDisks, diskID)
+	if disk == nil {
+		return nil, errors.Errorf("disk %s was not found in the inventory of host %s", diskID, host.ID)
+	}
+	if matchesAny(v.DiskSelection.ExcludePatterns, disk.Name) {
+		return nil, errors.Errorf("disk %s of host %s is excluded by the installation disk exclude patterns",
+			diskID, host.ID)
+	}
+	validDisks, err := v.GetHostValidDisks(host, profileName)
+	if err != nil {
+		return nil, err
+	}
+	if findBlockDevice(validDisks, disk.Name) == nil {
+		return nil, errors.Errorf("disk %s of host %s is not a valid installation disk", diskID, host.ID)
+	}
+	return disk, nil
+}
+
+func (v *validator) GetInstallationDisk(host *models.Host, profileName string) (string, error) {
+	if host.InstallationDisk != "" {
+		disk, err := v.findInstallationDisk(host, profileName, host.InstallationDisk)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("/dev/%s", disk.Name), nil
+	}
+
+	validDisks, err := v.GetHostValidDisks(host, profileName)
+	if err != nil {
+		return "", err
+	}
+	inventoryDisks, err := getInventoryDisks(host)
+	if err != nil {
+		return "", err
+	}
+	disk := v.selectDisk(validDisks, inventoryDisks)
+	if disk == nil {
+		return "", errors.Errorf("host %s doesn't have disks allowed by the installation disk selection policy", host.ID)
+	}
+	return fmt.Sprintf("/dev/%s", disk.Name), nil
+}
+
+// selectDisk picks the installation disk out of the valid disks according to the default selection policy
+func (v *validator) selectDisk(validDisks []*models.BlockDevice, inventoryDisks []*models.Disk) *models.BlockDevice {
+	candidates := append([]*models.BlockDevice{}, validDisks...)
+	isSSD := func(disk *models.BlockDevice) bool {
+		inventoryDisk := findInventoryDisk(inventoryDisks, disk.Name)
+		return inventoryDisk != nil && inventoryDisk.DriveType == "SSD"
+	}
+	// valid disks are already sorted by increasing size
+	if v.DiskSelection.Policy == DiskPolicyLargest {
+		for i, j := 0, len(candidates)-1; i < j; i, j = i+1, j-1 {
+			candidates[i], candidates[j] = candidates[j], candidates[i]
+		}
+	}
+	if v.DiskSelection.PreferSSD {
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return isSSD(candidates[i]) && !isSSD(candidates[j])
+		})
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return candidates[0]
+}
@@ -0,0 +1,196 @@
+package hardware
+
+import (
+	"encoding/json"
+
+	"github.com/alecthomas/units"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("installation_disk", func() {
+	var (
+		cfg  ValidatorCfg
+		host *models.Host
+	)
+
+	newValidator := func() Validator {
+		return NewValidator(cfg)
+	}
+
+	BeforeEach(func() {
+		cfg = ValidatorCfg{}
+		Expect(envconfig.Process("myapp", &cfg)).ShouldNot(HaveOccurred())
+		id := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id, ClusterID: strfmt.UUID(uuid.New().String())}
+		hwInfo := &models.Introspection{
+			CPU:    &models.CPUDetails{Cpus: 16},
+			Memory: []*models.MemoryDetails{{Name: "Mem", Total: int64(32 * units.GiB)}},
+			BlockDevices: []*models.BlockDevice{
+				{DeviceType: "disk", Name: "sdc", Size: int64(300 * units.GB)},
+				{DeviceType: "disk", Name: "sda", Size: int64(150 * units.GB)},
+				{DeviceType: "disk", Name: "sdb", Size: int64(200 * units.GB)},
+				{DeviceType: "disk", Name: "sdd", Size: int64(10 * units.GB)},
+			},
+		}
+		hw, err := json.Marshal(hwInfo)
+		Expect(err).NotTo(HaveOccurred())
+		host.HardwareInfo = string(hw)
+		inventory := &models.Inventory{
+			Disks: []*models.Disk{
+				{Name: "sda", ByPath: "/dev/disk/by-path/pci-0000:00:06.0", DriveType: "HDD", Wwn: "0x5000c500a0b1c2d3", Serial: "SERIAL-A"},
+				{Name: "sdb", ByPath: "/dev/disk/by-path/pci-0000:00:07.0", DriveType: "SSD", Serial: "SERIAL-B"},
+				{Name: "sdc", ByPath: "/dev/disk/by-path/pci-0000:00:08.0", DriveType: "HDD", Serial: "SERIAL-C"},
+				{Name: "sdd", DriveType: "SSD"},
+			},
+		}
+		inv, err := json.Marshal(inventory)
+		Expect(err).NotTo(HaveOccurred())
+		host.Inventory = string(inv)
+	})
+
+	Context("default policy", func() {
+		It("smallest", func() {
+			disk, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk).To(Equal("/dev/sda"))
+		})
+
+		It("largest", func() {
+			cfg.DiskSelection.Policy = DiskPolicyLargest
+			disk, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk).To(Equal("/dev/sdc"))
+		})
+
+		It("prefer_ssd", func() {
+			cfg.DiskSelection.PreferSSD = true
+			disk, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk).To(Equal("/dev/sdb"))
+		})
+
+		It("prefer_ssd_without_inventory", func() {
+			cfg.DiskSelection.PreferSSD = true
+			host.Inventory = ""
+			disk, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk).To(Equal("/dev/sda"))
+		})
+
+		It("exclude_patterns", func() {
+			Expect(cfg.DiskSelection.ExcludePatterns.Decode("^sda$,sdb")).NotTo(HaveOccurred())
+			disk, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk).To(Equal("/dev/sdc"))
+		})
+
+		It("all_disks_excluded", func() {
+			Expect(cfg.DiskSelection.ExcludePatterns.Decode("^sd")).NotTo(HaveOccurred())
+			_, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("user selection", func() {
+		for _, id := range []string{"sdc", "/dev/disk/by-path/pci-0000:00:08.0", "SERIAL-C"} {
+			diskID := id
+			It("by "+diskID, func() {
+				v := newValidator()
+				Expect(v.ValidateInstallationDisk(host, "", diskID)).NotTo(HaveOccurred())
+				host.InstallationDisk = diskID
+				disk, err := v.GetInstallationDisk(host, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(disk).To(Equal("/dev/sdc"))
+			})
+		}
+
+		It("by wwn", func() {
+			host.InstallationDisk = "0x5000c500a0b1c2d3"
+			disk, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk).To(Equal("/dev/sda"))
+		})
+
+		It("overrides the default policy", func() {
+			cfg.DiskSelection.Policy = DiskPolicyLargest
+			host.InstallationDisk = "sdb"
+			disk, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(disk).To(Equal("/dev/sdb"))
+		})
+
+		It("unknown disk", func() {
+			Expect(newValidator().ValidateInstallationDisk(host, "", "sdz")).To(HaveOccurred())
+		})
+
+		It("too small disk", func() {
+			Expect(newValidator().ValidateInstallationDisk(host, "", "sdd")).To(HaveOccurred())
+			host.InstallationDisk = "sdd"
+			_, err := newValidator().GetInstallationDisk(host, "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("excluded disk", func() {
+			Expect(cfg.DiskSelection.ExcludePatterns.Decode("^sdc$")).NotTo(HaveOccurred())
+			err := newValidator().ValidateInstallationDisk(host, "", "SERIAL-C")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("excluded by the installation disk exclude patterns"))
+		})
+
+		It("missing inventory", func() {
+			host.Inventory = ""
+			Expect(newValidator().ValidateInstallationDisk(host, "", "sda")).To(HaveOccurred())
+		})
+	})
+
+	Context("validation", func() {
+		diskValidation := func(reply *IsSufficientReply) *models.ValidationResult {
+			for _, result := range reply.Validations {
+				if swag.StringValue(result.ID) == ValidationInstallationDisk {
+					return result
+				}
+			}
+			return nil
+		}
+
+		It("excluded disks are reported", func() {
+			Expect(cfg.DiskSelection.ExcludePatterns.Decode("^sd[ab]$")).NotTo(HaveOccurred())
+			reply, err := newValidator().IsSufficient(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.IsSufficient).To(BeTrue())
+			Expect(diskValidation(reply).Actual).To(Equal(
+				"1 valid disks, excluded by the installation disk exclude patterns: sda, sdb"))
+		})
+
+		It("all disks excluded", func() {
+			Expect(cfg.DiskSelection.ExcludePatterns.Decode("^sd")).NotTo(HaveOccurred())
+			reply, err := newValidator().IsSufficient(host, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reply.IsSufficient).To(BeFalse())
+			Expect(reply.Reason).To(ContainSubstring("disks <sdc, sda, sdb> are excluded"))
+			Expect(swag.StringValue(diskValidation(reply).Status)).To(Equal(models.ValidationResultStatusFailure))
+		})
+	})
+
+	Context("configuration", func() {
+		It("invalid policy", func() {
+			var policy DiskPolicy
+			Expect(policy.Decode("fastest")).To(HaveOccurred())
+			Expect(policy.Decode("largest")).NotTo(HaveOccurred())
+			Expect(policy).To(Equal(DiskPolicyLargest))
+		})
+
+		It("invalid exclude pattern", func() {
+			var patterns DiskPatterns
+			Expect(patterns.Decode("sd[")).To(HaveOccurred())
+			Expect(patterns.Decode("sda,,nvme")).NotTo(HaveOccurred())
+			Expect(patterns).To(HaveLen(2))
+		})
+	})
+})
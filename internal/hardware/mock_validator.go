@@ -34,31 +34,89 @@ func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
 }
 
 // IsSufficient mocks base method.
-func (m *MockValidator) IsSufficient(host *models.Host) (*IsSufficientReply, error) {
+func (m *MockValidator) IsSufficient(host *models.Host, profileName string) (*IsSufficientReply, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsSufficient", host)
+	ret := m.ctrl.Call(m, "IsSufficient", host, profileName)
 	ret0, _ := ret[0].(*IsSufficientReply)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IsSufficient indicates an expected call of IsSufficient.
-func (mr *MockValidatorMockRecorder) IsSufficient(host interface{}) *gomock.Call {
+func (mr *MockValidatorMockRecorder) IsSufficient(host, profileName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSufficient", reflect.TypeOf((*MockValidator)(nil).IsSufficient), host)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSufficient", reflect.TypeOf((*MockValidator)(nil).IsSufficient), host, profileName)
 }
 
 // GetHostValidDisks mocks base method.
-func (m *MockValidator) GetHostValidDisks(host *models.Host) ([]*models.BlockDevice, error) {
+func (m *MockValidator) GetHostValidDisks(host *models.Host, profileName string) ([]*models.BlockDevice, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetHostValidDisks", host)
+	ret := m.ctrl.Call(m, "GetHostValidDisks", host, profileName)
 	ret0, _ := ret[0].([]*models.BlockDevice)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetHostValidDisks indicates an expected call of GetHostValidDisks.
-func (mr *MockValidatorMockRecorder) GetHostValidDisks(host interface{}) *gomock.Call {
+func (mr *MockValidatorMockRecorder) GetHostValidDisks(host, profileName interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostValidDisks", reflect.TypeOf((*MockValidator)(nil).GetHostValidDisks), host)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostValidDisks", reflect.TypeOf((*MockValidator)(nil).GetHostValidDisks), host, profileName)
+}
+
+// ValidateInstallationDisk mocks base method.
+func (m *MockValidator) ValidateInstallationDisk(host *models.Host, profileName, diskID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateInstallationDisk", host, profileName, diskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateInstallationDisk indicates an expected call of ValidateInstallationDisk.
+func (mr *MockValidatorMockRecorder) ValidateInstallationDisk(host, profileName, diskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateInstallationDisk", reflect.TypeOf((*MockValidator)(nil).ValidateInstallationDisk), host, profileName, diskID)
+}
+
+// GetInstallationDisk mocks base method.
+func (m *MockValidator) GetInstallationDisk(host *models.Host, profileName string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInstallationDisk", host, profileName)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInstallationDisk indicates an expected call of GetInstallationDisk.
+func (mr *MockValidatorMockRecorder) GetInstallationDisk(host, profileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstallationDisk", reflect.TypeOf((*MockValidator)(nil).GetInstallationDisk), host, profileName)
+}
+
+// ListProfiles mocks base method.
+func (m *MockValidator) ListProfiles() []*models.HardwareProfile {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProfiles")
+	ret0, _ := ret[0].([]*models.HardwareProfile)
+	return ret0
+}
+
+// ListProfiles indicates an expected call of ListProfiles.
+func (mr *MockValidatorMockRecorder) ListProfiles() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProfiles", reflect.TypeOf((*MockValidator)(nil).ListProfiles))
+}
+
+// GetProfile mocks base method.
+func (m *MockValidator) GetProfile(profileName string) (*models.HardwareProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfile", profileName)
+	ret0, _ := ret[0].(*models.HardwareProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfile indicates an expected call of GetProfile.
+func (mr *MockValidatorMockRecorder) GetProfile(profileName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfile", reflect.TypeOf((*MockValidator)(nil).GetProfile), profileName)
 }
@@ -0,0 +1,92 @@
+package hardware
+
+import (
+	"encoding/json"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+)
+
+// DefaultProfileName is the profile used by clusters that did not select a hardware profile.
+// Unless overridden, it is built from the HW_VALIDATOR_MIN_* configuration.
+const DefaultProfileName = "default"
+
+// Profiles is a list of hardware requirement profiles, configured as a JSON list of hardware-profile objects.
+// Requirements missing from a profile are taken from the default profile.
+type Profiles []*models.HardwareProfile
+
+// Decode implements envconfig.Decoder
+func (p *Profiles) Decode(value string) error {
+	var profiles Profiles
+	if err := json.Unmarshal([]byte(value), &profiles); err != nil {
+		return errors.Wrapf(err, "failed to decode hardware profiles")
+	}
+	for _, profile := range profiles {
+		if profile == nil || swag.StringValue(profile.Name) == "" {
+			return errors.Errorf("hardware profile name is missing")
+		}
+	}
+	*p = profiles
+	return nil
+}
+
+func (cfg *ValidatorCfg) defaultProfile() *models.HardwareProfile {
+	return &models.HardwareProfile{
+		Name:        swag.String(DefaultProfileName),
+		Description: "Default hardware requirements",
+		Master: &models.HardwareRequirements{
+			CPUCores:    cfg.MinCPUCoresMaster,
+			RAMGib:      cfg.MinRamGibMaster,
+			DiskSizeGib: cfg.MinDiskSizeGib,
+		},
+		Worker: &models.HardwareRequirements{
+			CPUCores:    cfg.MinCPUCoresWorker,
+			RAMGib:      cfg.MinRamGibWorker,
+			DiskSizeGib: cfg.MinDiskSizeGib,
+		},
+		Unassigned: &models.HardwareRequirements{
+			CPUCores:    cfg.MinCPUCores,
+			RAMGib:      cfg.MinRamGib,
+			DiskSizeGib: cfg.MinDiskSizeGib,
+		},
+	}
+}
+
+// completeProfile fills the requirements missing from the profile with the ones of the base profile
+func completeProfile(profile, base *models.HardwareProfile) *models.HardwareProfile {
+	p := *profile
+	if p.Master == nil {
+		p.Master = base.Master
+	}
+	if p.Worker == nil {
+		p.Worker = base.Worker
+	}
+	if p.Unassigned == nil {
+		p.Unassigned = base.Unassigned
+	}
+	return &p
+}
+
+func getRoleRequirements(profile *models.HardwareProfile, role string) *models.HardwareRequirements {
+	switch role {
+	case "master":
+		return profile.Master
+	case "worker":
+		return profile.Worker
+	default:
+		return profile.Unassigned
+	}
+}
+
+func isArchitectureAllowed(profile *models.HardwareProfile, architecture string) bool {
+	if len(profile.Architectures) == 0 {
+		return true
+	}
+	for _, arch := range profile.Architectures {
+		if arch == architecture {
+			return true
+		}
+	}
+	return false
+}
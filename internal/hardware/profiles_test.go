@@ -0,0 +1,119 @@
+package hardware
+
+import (
+	"encoding/json"
+
+	"github.com/alecthomas/units"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("hardware_profiles", func() {
+	var (
+		cfg           ValidatorCfg
+		hwvalidator   Validator
+		host          *models.Host
+		hwInfo        *models.Introspection
+		validDiskSize = int64(128849018880)
+	)
+
+	BeforeEach(func() {
+		Expect(envconfig.Process("myapp", &cfg)).ShouldNot(HaveOccurred())
+		Expect(cfg.Profiles.Decode(`[
+			{"name": "edge", "master": {"cpu_cores": 2, "ram_gib": 8, "disk_size_gib": 100},
+			 "architectures": ["aarch64"]},
+			{"name": "lab"}
+		]`)).ShouldNot(HaveOccurred())
+		hwvalidator = NewValidator(cfg)
+		id := strfmt.UUID(uuid.New().String())
+		host = &models.Host{ID: &id, ClusterID: strfmt.UUID(uuid.New().String()), Role: "master"}
+		hwInfo = &models.Introspection{
+			CPU:    &models.CPUDetails{Cpus: 2, Architecture: "aarch64"},
+			Memory: []*models.MemoryDetails{{Name: "Mem", Total: int64(8 * units.GiB)}},
+			BlockDevices: []*models.BlockDevice{
+				{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sda", Size: validDiskSize},
+				{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdb", Size: validDiskSize},
+				{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "nvme0n1", Size: validDiskSize},
+			},
+		}
+	})
+
+	setHwInfo := func() {
+		hw, err := json.Marshal(&hwInfo)
+		Expect(err).NotTo(HaveOccurred())
+		host.HardwareInfo = string(hw)
+	}
+
+	It("list_profiles", func() {
+		profiles := hwvalidator.ListProfiles()
+		Expect(profiles).To(HaveLen(3))
+		Expect(swag.StringValue(profiles[0].Name)).To(Equal(DefaultProfileName))
+		Expect(swag.StringValue(profiles[1].Name)).To(Equal("edge"))
+		Expect(swag.StringValue(profiles[2].Name)).To(Equal("lab"))
+	})
+
+	It("get_profile", func() {
+		profile, err := hwvalidator.GetProfile("")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swag.StringValue(profile.Name)).To(Equal(DefaultProfileName))
+		Expect(profile.Master.CPUCores).To(Equal(cfg.MinCPUCoresMaster))
+
+		_, err = hwvalidator.GetProfile("unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("missing_requirements_taken_from_default", func() {
+		profile, err := hwvalidator.GetProfile("edge")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.Worker.RAMGib).To(Equal(cfg.MinRamGibWorker))
+		lab, err := hwvalidator.GetProfile("lab")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lab.Master.RAMGib).To(Equal(cfg.MinRamGibMaster))
+	})
+
+	It("evaluated_against_profile", func() {
+		setHwInfo()
+		insufficient(hwvalidator.IsSufficient(host, DefaultProfileName))
+		sufficient(hwvalidator.IsSufficient(host, "edge"))
+
+		_, err := hwvalidator.IsSufficient(host, "unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("architecture_not_allowed", func() {
+		hwInfo.CPU.Architecture = "x86_64"
+		setHwInfo()
+		reply, err := hwvalidator.IsSufficient(host, "edge")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(reply.IsSufficient).To(BeFalse())
+		Expect(reply.Reason).To(ContainSubstring("unsupported CPU architecture"))
+	})
+
+	It("nvme_disks_are_valid", func() {
+		setHwInfo()
+		disks, err := hwvalidator.GetHostValidDisks(host, "edge")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(disks).To(HaveLen(3))
+		Expect(isBlockDeviceNameInlist(disks, "nvme0n1")).To(BeTrue())
+	})
+
+	It("invalid_profiles", func() {
+		var profiles Profiles
+		Expect(profiles.Decode("not a json")).To(HaveOccurred())
+		Expect(profiles.Decode(`[{"description": "no name"}]`)).To(HaveOccurred())
+	})
+
+	It("override_default_profile", func() {
+		Expect(cfg.Profiles.Decode(`[{"name": "default", "architectures": ["x86_64"]}]`)).ShouldNot(HaveOccurred())
+		hwvalidator = NewValidator(cfg)
+		profiles := hwvalidator.ListProfiles()
+		Expect(profiles).To(HaveLen(1))
+		Expect(profiles[0].Architectures).To(Equal([]string{"x86_64"}))
+		Expect(profiles[0].Master.CPUCores).To(Equal(cfg.MinCPUCoresMaster))
+	})
+})
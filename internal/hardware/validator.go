@@ -5,77 +5,170 @@ import (
 	"fmt"
 	"regexp"
 	"sort"
+	"strings"
 
 	"github.com/alecthomas/units"
+	"github.com/filanov/bm-inventory/internal/validations"
 	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
 )
 
 type IsSufficientReply struct {
 	IsSufficient bool
 	Reason       string
+	Validations  []*models.ValidationResult
 }
 
-const diskNameFilterRegex = "nvme"
+// IDs of the hardware validations
+const (
+	ValidationCPUCores         = "cpu-cores"
+	ValidationCPUArchitecture  = "cpu-architecture"
+	ValidationMemory           = "memory"
+	ValidationInstallationDisk = "installation-disk"
+)
 
 //go:generate mockgen -source=validator.go -package=hardware -destination=mock_validator.go
 type Validator interface {
-	IsSufficient(host *models.Host) (*IsSufficientReply, error)
-	GetHostValidDisks(host *models.Host) ([]*models.BlockDevice, error)
+	// IsSufficient validates the host hardware against the requirements of the given profile,
+	// an empty profile name stands for the default profile
+	IsSufficient(host *models.Host, profileName string) (*IsSufficientReply, error)
+	GetHostValidDisks(host *models.Host, profileName string) ([]*models.BlockDevice, error)
+	// ValidateInstallationDisk checks that the disk, identified by its name, by-path, WWN or serial number
+	// in the host inventory, is a valid installation disk
+	ValidateInstallationDisk(host *models.Host, profileName, diskID string) error
+	// GetInstallationDisk returns the device path of the disk the host will be installed on, either
+	// the disk chosen by the user or the one picked by the default selection policy
+	GetInstallationDisk(host *models.Host, profileName string) (string, error)
+	ListProfiles() []*models.HardwareProfile
+	GetProfile(profileName string) (*models.HardwareProfile, error)
 }
 
 func NewValidator(cfg ValidatorCfg) Validator {
-	return &validator{ValidatorCfg: cfg}
+	v := &validator{
+		ValidatorCfg: cfg,
+		profiles:     make(map[string]*models.HardwareProfile),
+	}
+	defaultProfile := cfg.defaultProfile()
+	for _, profile := range cfg.Profiles {
+		if swag.StringValue(profile.Name) == DefaultProfileName {
+			defaultProfile = completeProfile(profile, defaultProfile)
+		}
+	}
+	v.addProfile(defaultProfile)
+	for _, profile := range cfg.Profiles {
+		if swag.StringValue(profile.Name) != DefaultProfileName {
+			v.addProfile(completeProfile(profile, defaultProfile))
+		}
+	}
+	return v
 }
 
 type ValidatorCfg struct {
-	MinCPUCores       int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES" default:"2"`
-	MinCPUCoresWorker int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_WORKER" default:"2"`
-	MinCPUCoresMaster int64 `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_MASTER" default:"4"`
-	MinRamGib         int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB" default:"8"`
-	MinRamGibWorker   int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_WORKER" default:"8"`
-	MinRamGibMaster   int64 `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_MASTER" default:"16"`
-	MinDiskSizeGib    int64 `envconfig:"HW_VALIDATOR_MIN_DISK_SIZE_GIB" default:"120"`
+	MinCPUCores       int64    `envconfig:"HW_VALIDATOR_MIN_CPU_CORES" default:"2"`
+	MinCPUCoresWorker int64    `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_WORKER" default:"2"`
+	MinCPUCoresMaster int64    `envconfig:"HW_VALIDATOR_MIN_CPU_CORES_MASTER" default:"4"`
+	MinRamGib         int64    `envconfig:"HW_VALIDATOR_MIN_RAM_GIB" default:"8"`
+	MinRamGibWorker   int64    `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_WORKER" default:"8"`
+	MinRamGibMaster   int64    `envconfig:"HW_VALIDATOR_MIN_RAM_GIB_MASTER" default:"16"`
+	MinDiskSizeGib    int64    `envconfig:"HW_VALIDATOR_MIN_DISK_SIZE_GIB" default:"120"`
+	Profiles          Profiles `envconfig:"HW_VALIDATOR_PROFILES"`
+	DiskSelection     DiskSelectionCfg
 }
 
 type validator struct {
 	ValidatorCfg
+	profiles     map[string]*models.HardwareProfile
+	profileNames []string
+}
+
+func (v *validator) addProfile(profile *models.HardwareProfile) {
+	name := swag.StringValue(profile.Name)
+	if _, ok := v.profiles[name]; !ok {
+		v.profileNames = append(v.profileNames, name)
+	}
+	v.profiles[name] = profile
 }
 
-func (v *validator) IsSufficient(host *models.Host) (*IsSufficientReply, error) {
+func (v *validator) ListProfiles() []*models.HardwareProfile {
+	profiles := make([]*models.HardwareProfile, 0, len(v.profileNames))
+	for _, name := range v.profileNames {
+		profiles = append(profiles, v.profiles[name])
+	}
+	return profiles
+}
+
+func (v *validator) GetProfile(profileName string) (*models.HardwareProfile, error) {
+	if profileName == "" {
+		profileName = DefaultProfileName
+	}
+	profile, ok := v.profiles[profileName]
+	if !ok {
+		return nil, fmt.Errorf("hardware profile %s not found", profileName)
+	}
+	return profile, nil
+}
+
+func (v *validator) IsSufficient(host *models.Host, profileName string) (*IsSufficientReply, error) {
 	var err error
 	var reason string
 	var isSufficient bool
 	var hwInfo models.Introspection
 
+	profile, err := v.GetProfile(profileName)
+	if err != nil {
+		return nil, err
+	}
+
 	if err = json.Unmarshal([]byte(host.HardwareInfo), &hwInfo); err != nil {
 		return nil, err
 	}
 
-	var minCpuCoresRequired int64 = v.MinCPUCores
-	var minRamRequired int64 = gibToBytes(v.MinRamGib)
-	var minDiskSizeRequired int64 = gibToBytes(v.MinDiskSizeGib)
+	requirements := getRoleRequirements(profile, host.Role)
+	var minCpuCoresRequired int64 = requirements.CPUCores
+	var minRamRequired int64 = gibToBytes(requirements.RAMGib)
+	var minDiskSizeRequired int64 = gibToBytes(requirements.DiskSizeGib)
 
-	switch host.Role {
-	case "master":
-		minCpuCoresRequired = v.MinCPUCoresMaster
-		minRamRequired = gibToBytes(v.MinRamGibMaster)
-	case "worker":
-		minCpuCoresRequired = v.MinCPUCoresWorker
-		minRamRequired = gibToBytes(v.MinRamGibWorker)
-	}
+	var results []*models.ValidationResult
 
-	if hwInfo.CPU.Cpus < minCpuCoresRequired {
+	cpuCoresOk := hwInfo.CPU.Cpus >= minCpuCoresRequired
+	results = append(results, validations.New(ValidationCPUCores, models.ValidationResultCategoryCPU, cpuCoresOk,
+		fmt.Sprintf("%d", minCpuCoresRequired), fmt.Sprintf("%d", hwInfo.CPU.Cpus)))
+	if !cpuCoresOk {
 		reason += fmt.Sprintf(", insufficient CPU cores, expected: <%d> got <%d>", minCpuCoresRequired, hwInfo.CPU.Cpus)
 	}
 
-	if total := getTotalMemory(hwInfo); total < minRamRequired {
+	architectureOk := isArchitectureAllowed(profile, hwInfo.CPU.Architecture)
+	results = append(results, validations.New(ValidationCPUArchitecture, models.ValidationResultCategoryCPU, architectureOk,
+		strings.Join(profile.Architectures, ", "), hwInfo.CPU.Architecture))
+	if !architectureOk {
+		reason += fmt.Sprintf(", unsupported CPU architecture, expected one of: <%s> got <%s>",
+			strings.Join(profile.Architectures, ", "), hwInfo.CPU.Architecture)
+	}
+
+	total := getTotalMemory(hwInfo)
+	memoryOk := total >= minRamRequired
+	results = append(results, validations.New(ValidationMemory, models.ValidationResultCategoryMemory, memoryOk,
+		units.Base2Bytes(minRamRequired).String(), units.Base2Bytes(total).String()))
+	if !memoryOk {
 		reason += fmt.Sprintf(", insufficient RAM requirements, expected: <%s> got <%s>",
 			units.Base2Bytes(minRamRequired), units.Base2Bytes(total))
 	}
 
-	if disks := listValidDisks(hwInfo, minDiskSizeRequired); len(disks) < 1 {
+	disks, excluded := listValidDisks(hwInfo, minDiskSizeRequired, v.DiskSelection.ExcludePatterns)
+	disksOk := len(disks) >= 1
+	disksActual := fmt.Sprintf("%d valid disks", len(disks))
+	if len(excluded) > 0 {
+		disksActual += fmt.Sprintf(", excluded by the installation disk exclude patterns: %s", diskNames(excluded))
+	}
+	results = append(results, validations.New(ValidationInstallationDisk, models.ValidationResultCategoryDisk, disksOk,
+		fmt.Sprintf("1 disk of at least %s", units.Base2Bytes(minDiskSizeRequired)), disksActual))
+	if !disksOk {
 		reason += fmt.Sprintf(", insufficient number of disks with required size, "+
 			"expected at least 1 not removable, not readonly disk of size more than <%d>", minDiskSizeRequired)
+		if len(excluded) > 0 {
+			reason += fmt.Sprintf(", disks <%s> are excluded by the installation disk exclude patterns",
+				diskNames(excluded))
+		}
 	}
 
 	if len(reason) == 0 {
@@ -90,15 +183,21 @@ func (v *validator) IsSufficient(host *models.Host) (*IsSufficientReply, error)
 	return &IsSufficientReply{
 		IsSufficient: isSufficient,
 		Reason:       reason,
+		Validations:  results,
 	}, nil
 }
 
-func (v *validator) GetHostValidDisks(host *models.Host) ([]*models.BlockDevice, error) {
+func (v *validator) GetHostValidDisks(host *models.Host, profileName string) ([]*models.BlockDevice, error) {
+	profile, err := v.GetProfile(profileName)
+	if err != nil {
+		return nil, err
+	}
 	var hwInfo models.Introspection
 	if err := json.Unmarshal([]byte(host.HardwareInfo), &hwInfo); err != nil {
 		return nil, err
 	}
-	disks := listValidDisks(hwInfo, gibToBytes(v.MinDiskSizeGib))
+	requirements := getRoleRequirements(profile, host.Role)
+	disks, _ := listValidDisks(hwInfo, gibToBytes(requirements.DiskSizeGib), v.DiskSelection.ExcludePatterns)
 	if len(disks) == 0 {
 		return nil, fmt.Errorf("host %s doesn't have valid disks", host.ID)
 	}
@@ -118,15 +217,21 @@ func gibToBytes(gib int64) int64 {
 	return gib * int64(units.GiB)
 }
 
-func listValidDisks(hwInfo models.Introspection, minSizeRequiredInBytes int64) []*models.BlockDevice {
-	var disks []*models.BlockDevice
-	filter, _ := regexp.Compile(diskNameFilterRegex)
+// listValidDisks returns the valid installation disks sorted by increasing size, and the disks that would be valid but
+// are excluded by the exclude patterns
+func listValidDisks(hwInfo models.Introspection, minSizeRequiredInBytes int64,
+	excludePatterns DiskPatterns) ([]*models.BlockDevice, []*models.BlockDevice) {
+
+	var disks, excluded []*models.BlockDevice
 	for _, blockDevice := range hwInfo.BlockDevices {
 		// Valid disk: type=disk, not removable, not readonly and size bigger than minimum required
-		// and name is not matched by filter
-		if blockDevice.DeviceType == "disk" && blockDevice.RemovableDevice == 0 &&
-			!blockDevice.ReadOnly && blockDevice.Size >= minSizeRequiredInBytes && !filter.MatchString(blockDevice.Name) {
-
+		if blockDevice.DeviceType != "disk" || blockDevice.RemovableDevice != 0 ||
+			blockDevice.ReadOnly || blockDevice.Size < minSizeRequiredInBytes {
+			continue
+		}
+		if matchesAny(excludePatterns, blockDevice.Name) {
+			excluded = append(excluded, blockDevice)
+		} else {
 			disks = append(disks, blockDevice)
 		}
 	}
@@ -134,5 +239,22 @@ func listValidDisks(hwInfo models.Introspection, minSizeRequiredInBytes int64) [
 	sort.Slice(disks, func(i, j int) bool {
 		return disks[i].Size < disks[j].Size
 	})
-	return disks
+	return disks, excluded
+}
+
+func diskNames(disks []*models.BlockDevice) string {
+	names := make([]string, 0, len(disks))
+	for _, disk := range disks {
+		names = append(names, disk.Name)
+	}
+	return strings.Join(names, ", ")
+}
+
+func matchesAny(filters []*regexp.Regexp, name string) bool {
+	for _, filter := range filters {
+		if filter.MatchString(name) {
+			return true
+		}
+	}
+	return false
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/alecthomas/units"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
 	. "github.com/onsi/ginkgo"
@@ -49,7 +50,7 @@ var _ = Describe("hardware_validator", func() {
 		roles := []string{"", "master", "worker"}
 		for _, role := range roles {
 			host.Role = role
-			sufficient(hwvalidator.IsSufficient(host))
+			sufficient(hwvalidator.IsSufficient(host, ""))
 		}
 	})
 
@@ -63,7 +64,7 @@ var _ = Describe("hardware_validator", func() {
 		roles := []string{"", "master", "worker"}
 		for _, role := range roles {
 			host.Role = role
-			insufficient(hwvalidator.IsSufficient(host))
+			insufficient(hwvalidator.IsSufficient(host, ""))
 		}
 	})
 
@@ -74,9 +75,34 @@ var _ = Describe("hardware_validator", func() {
 		Expect(err).NotTo(HaveOccurred())
 		host.HardwareInfo = string(hw)
 		host.Role = "master"
-		insufficient(hwvalidator.IsSufficient(host))
+		insufficient(hwvalidator.IsSufficient(host, ""))
 		host.Role = "worker"
-		sufficient(hwvalidator.IsSufficient(host))
+		sufficient(hwvalidator.IsSufficient(host, ""))
+	})
+
+	It("validation_results", func() {
+		hwInfo.Memory = []*models.MemoryDetails{{Name: "Mem", Total: int64(8 * units.GiB)}}
+		hw, err := json.Marshal(&hwInfo)
+		Expect(err).NotTo(HaveOccurred())
+		host.HardwareInfo = string(hw)
+		host.Role = "master"
+		reply, err := hwvalidator.IsSufficient(host, "")
+		Expect(err).NotTo(HaveOccurred())
+		statuses := make(map[string]string)
+		for _, result := range reply.Validations {
+			statuses[swag.StringValue(result.ID)] = swag.StringValue(result.Status)
+			if swag.StringValue(result.ID) == ValidationMemory {
+				Expect(swag.StringValue(result.Category)).To(Equal(models.ValidationResultCategoryMemory))
+				Expect(result.Expected).To(Equal("16GiB"))
+				Expect(result.Actual).To(Equal("8GiB"))
+			}
+		}
+		Expect(statuses).To(Equal(map[string]string{
+			ValidationCPUCores:         models.ValidationResultStatusSuccess,
+			ValidationCPUArchitecture:  models.ValidationResultStatusSuccess,
+			ValidationMemory:           models.ValidationResultStatusFailure,
+			ValidationInstallationDisk: models.ValidationResultStatusSuccess,
+		}))
 	})
 
 	It("insufficient_number_of_valid_disks", func() {
@@ -89,16 +115,20 @@ var _ = Describe("hardware_validator", func() {
 			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sda", RemovableDevice: 1, Size: validDiskSize},
 			// Read-only
 			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdh", ReadOnly: true, Size: validDiskSize},
-			// Filtered Name
+			// Excluded name
 			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "nvme01fs", Size: validDiskSize},
 		}
 		hw, err := json.Marshal(&hwInfo)
 		Expect(err).NotTo(HaveOccurred())
+		var cfg ValidatorCfg
+		Expect(envconfig.Process("myapp", &cfg)).ShouldNot(HaveOccurred())
+		Expect(cfg.DiskSelection.ExcludePatterns.Decode("^nvme")).ShouldNot(HaveOccurred())
+		hwvalidator = NewValidator(cfg)
 
 		host.HardwareInfo = string(hw)
-		insufficient(hwvalidator.IsSufficient(host))
+		insufficient(hwvalidator.IsSufficient(host, ""))
 
-		disks, err := hwvalidator.GetHostValidDisks(host)
+		disks, err := hwvalidator.GetHostValidDisks(host, "")
 		Expect(err).To(HaveOccurred())
 		Expect(disks).To(BeNil())
 	})
@@ -111,16 +141,17 @@ var _ = Describe("hardware_validator", func() {
 			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdb", Size: validDiskSize + 1},
 			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sda", Size: validDiskSize + 100},
 			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdh", Size: validDiskSize},
-			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: nvmename, Size: validDiskSize},
+			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: nvmename, Size: validDiskSize + 50},
 		}
 		hw, err := json.Marshal(&hwInfo)
 		Expect(err).NotTo(HaveOccurred())
 		host.HardwareInfo = string(hw)
-		disks, err := hwvalidator.GetHostValidDisks(host)
+		disks, err := hwvalidator.GetHostValidDisks(host, "")
 		Expect(err).NotTo(HaveOccurred())
 		Expect(disks[0].Name).Should(Equal("sdh"))
-		Expect(len(disks)).Should(Equal(3))
-		Expect(isBlockDeviceNameInlist(disks, nvmename)).Should(Equal(false))
+		Expect(len(disks)).Should(Equal(4))
+		// NVMe disks are valid installation disks unless they are excluded by the configuration
+		Expect(disks[2].Name).Should(Equal(nvmename))
 	})
 
 	It("invalid_hw_info", func() {
@@ -128,11 +159,11 @@ var _ = Describe("hardware_validator", func() {
 		roles := []string{"", "master", "worker"}
 		for _, role := range roles {
 			host.Role = role
-			reply, err := hwvalidator.IsSufficient(host)
+			reply, err := hwvalidator.IsSufficient(host, "")
 			Expect(err).To(HaveOccurred())
 			Expect(reply).To(BeNil())
 		}
-		disks, err := hwvalidator.GetHostValidDisks(host)
+		disks, err := hwvalidator.GetHostValidDisks(host, "")
 		Expect(err).To(HaveOccurred())
 		Expect(disks).To(BeNil())
 	})
@@ -0,0 +1,106 @@
+package host
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/models"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// ErrNoBootstrapCandidate is returned when the cluster has no master eligible to bootstrap the installation
+var ErrNoBootstrapCandidate = errors.New("no eligible bootstrap host")
+
+// bootstrapCandidate is what the masters are ranked by in the bootstrap election, in order of precedence
+type bootstrapCandidate struct {
+	*hostCapability
+	reachable int
+}
+
+func (c *bootstrapCandidate) isBetter(other *bootstrapCandidate) bool {
+	if c.reachable != other.reachable {
+		return c.reachable > other.reachable
+	}
+	return c.isMoreCapable(other.hostCapability)
+}
+
+func isBootstrapEligible(h *models.Host) bool {
+	return h.Role == RoleMaster && swag.StringValue(h.Status) == HostStatusKnown
+}
+
+// countReachableHosts returns how many of the given hosts were reached by h, according to its last connectivity report
+func countReachableHosts(h *models.Host, hosts []*models.Host) int {
+	reachable := network.ReachableHosts(h)
+	count := 0
+	for _, other := range hosts {
+		if other.ID.String() != h.ID.String() && reachable[*other.ID] {
+			count++
+		}
+	}
+	return count
+}
+
+// SelectBootstrap returns the master that will bootstrap the installation and the reason it was chosen.
+// A host pinned by the user is used as long as it is a known master. Otherwise the known master that reaches
+// the largest number of known cluster hosts is elected, with ties broken by CPU cores, RAM, disk size and host ID.
+func (m *Manager) SelectBootstrap(ctx context.Context, hosts []*models.Host, pinnedHostID strfmt.UUID,
+	db *gorm.DB) (*models.Host, string, error) {
+	log := logutil.FromContext(ctx, m.log)
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+
+	if pinnedHostID != "" {
+		for _, h := range hosts {
+			if h.ID.String() != pinnedHostID.String() {
+				continue
+			}
+			if !isBootstrapEligible(h) {
+				return nil, "", errors.Wrapf(ErrNoBootstrapCandidate,
+					"pinned bootstrap host %s is not a known master, role: <%s> status: <%s>",
+					h.ID.String(), h.Role, swag.StringValue(h.Status))
+			}
+			return h, "pinned by the user", nil
+		}
+		return nil, "", errors.Wrapf(ErrNoBootstrapCandidate, "pinned bootstrap host %s not found", pinnedHostID)
+	}
+
+	var known []*models.Host
+	for _, h := range hosts {
+		if swag.StringValue(h.Status) == HostStatusKnown {
+			known = append(known, h)
+		}
+	}
+	var candidates []*bootstrapCandidate
+	for _, h := range known {
+		if !isBootstrapEligible(h) {
+			continue
+		}
+		capability, err := m.getHostCapability(h, cdb)
+		if err != nil {
+			return nil, "", err
+		}
+		candidates = append(candidates, &bootstrapCandidate{
+			hostCapability: capability,
+			reachable:      countReachableHosts(h, known),
+		})
+	}
+	if len(candidates) == 0 {
+		return nil, "", errors.Wrapf(ErrNoBootstrapCandidate, "cluster has no known master hosts")
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].isBetter(candidates[j])
+	})
+	elected := candidates[0]
+	reason := fmt.Sprintf("elected out of %d masters, reaches %d of %d known hosts (%s)",
+		len(candidates), elected.reachable, len(known)-1, elected.hostCapability)
+	log.Infof("host %s was elected as bootstrap: %s", elected.host.ID.String(), reason)
+	return elected.host, reason, nil
+}
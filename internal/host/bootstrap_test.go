@@ -0,0 +1,139 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/alecthomas/units"
+	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+)
+
+var _ = Describe("SelectBootstrap", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		state     API
+		clusterId strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		var cfg hardware.ValidatorCfg
+		Expect(envconfig.Process("myapp", &cfg)).ShouldNot(HaveOccurred())
+		state = NewManager(getTestLog(), db, hardware.NewValidator(cfg), nil, nil)
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	newHost := func(role, status string, cpus int64) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		h := getTestHost(id, clusterId, status)
+		h.Role = role
+		hwInfo, err := json.Marshal(&models.Introspection{
+			CPU:          &models.CPUDetails{Cpus: cpus},
+			Memory:       []*models.MemoryDetails{{Name: "Mem", Total: int64(32 * units.GiB)}},
+			BlockDevices: []*models.BlockDevice{{DeviceType: "disk", Name: "sda", Size: int64(200 * units.GB)}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		h.HardwareInfo = string(hwInfo)
+		return &h
+	}
+
+	setConnectivity := func(h *models.Host, remotes ...*models.Host) {
+		var report models.ConnectivityReport
+		for _, remote := range remotes {
+			report.RemoteHosts = append(report.RemoteHosts, &models.ConnectivityRemoteHost{
+				HostID:         *remote.ID,
+				L2Connectivity: []*models.L2Connectivity{{Successful: true}},
+			})
+		}
+		h.Connectivity = &report
+	}
+
+	It("elects_most_capable_master", func() {
+		hosts := []*models.Host{
+			newHost(RoleMaster, HostStatusKnown, 8),
+			newHost(RoleMaster, HostStatusKnown, 16),
+			newHost(RoleMaster, HostStatusKnown, 4),
+			newHost(RoleWorker, HostStatusKnown, 32),
+		}
+		bootstrap, reason, err := state.SelectBootstrap(ctx, hosts, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bootstrap.ID.String()).Should(Equal(hosts[1].ID.String()))
+		Expect(reason).Should(HavePrefix("elected out of 3 masters"))
+	})
+
+	It("connectivity_takes_precedence", func() {
+		hosts := []*models.Host{
+			newHost(RoleMaster, HostStatusKnown, 8),
+			newHost(RoleMaster, HostStatusKnown, 16),
+			newHost(RoleMaster, HostStatusKnown, 4),
+		}
+		setConnectivity(hosts[0], hosts[1], hosts[2])
+		setConnectivity(hosts[1], hosts[0])
+		setConnectivity(hosts[2], hosts[0], hosts[1])
+		bootstrap, reason, err := state.SelectBootstrap(ctx, hosts, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bootstrap.ID.String()).Should(Equal(hosts[0].ID.String()))
+		Expect(reason).Should(ContainSubstring("reaches 2 of 2 known hosts"))
+	})
+
+	It("deterministic_on_identical_masters", func() {
+		hosts := []*models.Host{
+			newHost(RoleMaster, HostStatusKnown, 8),
+			newHost(RoleMaster, HostStatusKnown, 8),
+			newHost(RoleMaster, HostStatusKnown, 8),
+		}
+		first, _, err := state.SelectBootstrap(ctx, hosts, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		reversed := []*models.Host{hosts[2], hosts[1], hosts[0]}
+		second, _, err := state.SelectBootstrap(ctx, reversed, "", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(first.ID.String()).Should(Equal(second.ID.String()))
+	})
+
+	It("pinned_master", func() {
+		hosts := []*models.Host{
+			newHost(RoleMaster, HostStatusKnown, 16),
+			newHost(RoleMaster, HostStatusKnown, 4),
+		}
+		bootstrap, reason, err := state.SelectBootstrap(ctx, hosts, *hosts[1].ID, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(bootstrap.ID.String()).Should(Equal(hosts[1].ID.String()))
+		Expect(reason).Should(Equal("pinned by the user"))
+	})
+
+	It("pinned_host_not_eligible", func() {
+		hosts := []*models.Host{
+			newHost(RoleMaster, HostStatusKnown, 16),
+			newHost(RoleWorker, HostStatusKnown, 4),
+			newHost(RoleMaster, HostStatusDisconnected, 4),
+		}
+		for _, pinned := range []strfmt.UUID{*hosts[1].ID, *hosts[2].ID, strfmt.UUID(uuid.New().String())} {
+			_, _, err := state.SelectBootstrap(ctx, hosts, pinned, nil)
+			Expect(errors.Cause(err)).Should(Equal(ErrNoBootstrapCandidate))
+		}
+	})
+
+	It("no_known_masters", func() {
+		hosts := []*models.Host{
+			newHost(RoleMaster, HostStatusDisconnected, 16),
+			newHost(RoleWorker, HostStatusKnown, 4),
+		}
+		_, _, err := state.SelectBootstrap(ctx, hosts, "", nil)
+		Expect(errors.Cause(err)).Should(Equal(ErrNoBootstrapCandidate))
+		_, _, err = state.SelectBootstrap(ctx, nil, "", nil)
+		Expect(errors.Cause(err)).Should(Equal(ErrNoBootstrapCandidate))
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
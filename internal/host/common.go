@@ -1,9 +1,13 @@
 package host
 
 import (
+	"context"
 	"time"
 
+	"github.com/filanov/bm-inventory/internal/events"
 	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/internal/validations"
+	"github.com/filanov/bm-inventory/internal/webhooks"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
@@ -18,6 +22,8 @@ const (
 	statusInfoInstalling   = "Installation in progress"
 )
 
+const keepAliveTimeout = 3 * time.Minute
+
 type UpdateReply struct {
 	State     string
 	IsChanged bool
@@ -28,13 +34,18 @@ type baseState struct {
 	db  *gorm.DB
 }
 
-func updateState(log logrus.FieldLogger, state, stateInfo string, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
-	return updateStateWithParams(log, state, stateInfo, h, db)
+func updateState(ctx context.Context, log logrus.FieldLogger, state, stateInfo string, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
+	return updateStateWithParams(ctx, log, state, stateInfo, h, db)
 }
 
-func updateByKeepAlive(log logrus.FieldLogger, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
-	if time.Since(time.Time(h.UpdatedAt)) > 3*time.Minute {
-		return updateState(log, HostStatusDisconnected, statusInfoDisconnected, h, db)
+func updateByKeepAlive(ctx context.Context, log logrus.FieldLogger, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
+	lastSeen := time.Time(h.CheckedInAt)
+	if lastSeen.IsZero() {
+		// host didn't check in since it was registered
+		lastSeen = time.Time(h.UpdatedAt)
+	}
+	if time.Since(lastSeen) > keepAliveTimeout {
+		return updateState(ctx, log, HostStatusDisconnected, statusInfoDisconnected, h, db)
 	}
 	return &UpdateReply{
 		State:     swag.StringValue(h.Status),
@@ -42,7 +53,10 @@ func updateByKeepAlive(log logrus.FieldLogger, h *models.Host, db *gorm.DB) (*Up
 	}, nil
 }
 
-func updateStateWithParams(log logrus.FieldLogger, status, statusInfo string, h *models.Host, db *gorm.DB, extra ...interface{}) (*UpdateReply, error) {
+// updateStateWithParams moves the host to the given state together with the given extra fields, and records
+// the transition as an event in the same transaction
+func updateStateWithParams(ctx context.Context, log logrus.FieldLogger, status, statusInfo string, h *models.Host,
+	db *gorm.DB, extra ...interface{}) (*UpdateReply, error) {
 	updates := map[string]interface{}{"status": status, "status_info": statusInfo}
 	if len(extra)%2 != 0 {
 		return nil, errors.Errorf("invalid update extra parameters %+v", extra)
@@ -50,16 +64,25 @@ func updateStateWithParams(log logrus.FieldLogger, status, statusInfo string, h
 	for i := 0; i < len(extra); i += 2 {
 		updates[extra[i].(string)] = extra[i+1]
 	}
-	dbReply := db.Model(&models.Host{}).Where("id = ? and cluster_id = ? and status = ?",
-		h.ID.String(), h.ClusterID.String(), swag.StringValue(h.Status)).
-		Updates(updates)
-	if dbReply.Error != nil {
-		return nil, errors.Wrapf(dbReply.Error, "failed to update host %s from cluster %s state from %s to %s",
-			h.ID.String(), h.ClusterID, swag.StringValue(h.Status), status)
-	}
-	if dbReply.RowsAffected == 0 {
-		return nil, errors.Errorf("failed to update host %s from cluster %s state from %s to %s, nothing have changed",
-			h.ID.String(), h.ClusterID, swag.StringValue(h.Status), status)
+	err := events.Transaction(db, func(tx *gorm.DB) error {
+		dbReply := tx.Model(&models.Host{}).Where("id = ? and cluster_id = ? and status = ?",
+			h.ID.String(), h.ClusterID.String(), swag.StringValue(h.Status)).
+			Updates(updates)
+		if dbReply.Error != nil {
+			return errors.Wrapf(dbReply.Error, "failed to update host %s from cluster %s state from %s to %s",
+				h.ID.String(), h.ClusterID, swag.StringValue(h.Status), status)
+		}
+		if dbReply.RowsAffected == 0 {
+			return errors.Errorf("failed to update host %s from cluster %s state from %s to %s, nothing have changed",
+				h.ID.String(), h.ClusterID, swag.StringValue(h.Status), status)
+		}
+		if status == swag.StringValue(h.Status) && statusInfo == swag.StringValue(h.StatusInfo) {
+			return nil
+		}
+		return addStateTransition(ctx, tx, h, swag.StringValue(h.Status), status, statusInfo)
+	})
+	if err != nil {
+		return nil, err
 	}
 	log.Infof("Updated host <%s> status from <%s> to <%s> with fields: %s",
 		h.ID.String(), swag.StringValue(h.Status), status, updates)
@@ -69,14 +92,73 @@ func updateStateWithParams(log logrus.FieldLogger, status, statusInfo string, h
 	}, nil
 }
 
-func updateHwInfo(log logrus.FieldLogger, hwValidator hardware.Validator, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
-	reply, err := hwValidator.IsSufficient(h)
+// addStateTransition records the host state transition and notifies the webhooks subscribed to it,
+// in the transaction of the transition
+func addStateTransition(ctx context.Context, tx *gorm.DB, h *models.Host,
+	fromStatus, toStatus, statusInfo string) error {
+	event, err := events.AddStateTransition(ctx, tx, h.ClusterID, *h.ID, fromStatus, toStatus, statusInfo)
+	if err != nil {
+		return err
+	}
+	return webhooks.Enqueue(tx, event)
+}
+
+func updateHwInfo(ctx context.Context, log logrus.FieldLogger, hwValidator hardware.Validator, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
+	return updateByValidation(ctx, log, hwValidator, h, db, "hardware_info", h.HardwareInfo)
+}
+
+// updateByValidation validates the host hardware and moves it to known or insufficient state accordingly,
+// together with the validation results and the given extra fields
+func updateByValidation(ctx context.Context, log logrus.FieldLogger, hwValidator hardware.Validator, h *models.Host, db *gorm.DB,
+	extra ...interface{}) (*UpdateReply, error) {
+	reply, err := isSufficient(hwValidator, h, db)
 	if err != nil {
 		return nil, err
 	}
+	validationsInfo, err := validations.ToString(reply.Validations)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to encode validations of host %s", h.ID.String())
+	}
+	extra = append(extra, "validations_info", validationsInfo)
 	if !reply.IsSufficient {
-		return updateStateWithParams(log, HostStatusInsufficient, reply.Reason, h, db,
-			"hardware_info", h.HardwareInfo)
+		return updateStateWithParams(ctx, log, HostStatusInsufficient, reply.Reason, h, db, extra...)
+	}
+	return updateStateWithParams(ctx, log, HostStatusKnown, "", h, db, extra...)
+}
+
+// getHardwareProfile returns the name of the hardware requirements profile selected for the host's cluster
+func getHardwareProfile(h *models.Host, db *gorm.DB) (string, error) {
+	var clusters []*models.Cluster
+	if err := db.Select("hardware_profile").Where("id = ?", h.ClusterID.String()).
+		Find(&clusters).Error; err != nil {
+		return "", errors.Wrapf(err, "failed to get hardware profile of cluster %s", h.ClusterID)
+	}
+	if len(clusters) == 0 {
+		return "", nil
+	}
+	return clusters[0].HardwareProfile, nil
+}
+
+func isSufficient(hwValidator hardware.Validator, h *models.Host, db *gorm.DB) (*hardware.IsSufficientReply, error) {
+	profile, err := getHardwareProfile(h, db)
+	if err != nil {
+		return nil, err
+	}
+	return hwValidator.IsSufficient(h, profile)
+}
+
+func getHostValidDisks(hwValidator hardware.Validator, h *models.Host, db *gorm.DB) ([]*models.BlockDevice, error) {
+	profile, err := getHardwareProfile(h, db)
+	if err != nil {
+		return nil, err
+	}
+	return hwValidator.GetHostValidDisks(h, profile)
+}
+
+func getInstallationDisk(hwValidator hardware.Validator, h *models.Host, db *gorm.DB) (string, error) {
+	profile, err := getHardwareProfile(h, db)
+	if err != nil {
+		return "", err
 	}
-	return updateStateWithParams(log, HostStatusKnown, "", h, db, "hardware_info", h.HardwareInfo)
+	return hwValidator.GetInstallationDisk(h, profile)
 }
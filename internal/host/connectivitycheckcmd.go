@@ -2,10 +2,12 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 	"strings"
 
 	"github.com/sirupsen/logrus"
 
+	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 
 	"github.com/filanov/bm-inventory/models"
@@ -24,20 +26,57 @@ func NewConnectivityCheckCmd(log logrus.FieldLogger, db *gorm.DB) *connectivityC
 }
 
 func (c *connectivityCheckCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
-	step := &models.Step{}
-	step.StepType = models.StepTypeConnectivityCheck
-	step.Command = "podman"
-
 	var hosts []*models.Host
-	if err := c.db.Find(&hosts, "cluster_id = ?", host.ClusterID).Error; err != nil {
+	if err := c.db.Find(&hosts, "cluster_id = ? and id != ? and status != ?",
+		host.ClusterID, host.ID.String(), HostStatusDisabled).Error; err != nil {
 		c.log.WithError(err).Errorf("failed to get list of hosts for cluster %s", host.ClusterID)
 		return nil, err
 	}
-	//TODO need to verify with Ori what is the extra args for connectivity check
-	// for i := range hosts {
 
-	// }
+	checkParams := getConnectivityCheckParams(c.log, hosts)
+	if len(checkParams) == 0 {
+		// no other hosts to check connectivity with
+		return nil, nil
+	}
+	params, err := json.Marshal(checkParams)
+	if err != nil {
+		return nil, err
+	}
 
+	step := &models.Step{}
+	step.StepType = models.StepTypeConnectivityCheck
+	step.Command = "podman"
 	step.Args = strings.Split("run,--rm,--privileged,--quiet,--net=host,-v,/var/log:/var/log,quay.io/oamizur/connectivity_check,/usr/bin/connectivity_check", ",")
+	step.Args = append(step.Args, string(params))
 	return step, nil
 }
+
+// getConnectivityCheckParams returns the NICs of the given hosts, taken from their hardware info
+func getConnectivityCheckParams(log logrus.FieldLogger, hosts []*models.Host) models.ConnectivityCheckParams {
+	var checkParams models.ConnectivityCheckParams
+	for _, h := range hosts {
+		var hwInfo models.Introspection
+		if err := json.Unmarshal([]byte(h.HardwareInfo), &hwInfo); err != nil {
+			// host hardware info is not available yet
+			log.Debugf("skipping host %s without valid hardware info in connectivity check, status %s",
+				h.ID, swag.StringValue(h.Status))
+			continue
+		}
+		checkHost := &models.ConnectivityCheckHost{HostID: *h.ID}
+		for _, nic := range hwInfo.Nics {
+			checkNic := &models.ConnectivityCheckNic{
+				Name:        nic.Name,
+				Mac:         nic.Mac,
+				IPAddresses: make([]string, 0, len(nic.Cidrs)),
+			}
+			for _, cidr := range nic.Cidrs {
+				checkNic.IPAddresses = append(checkNic.IPAddresses, cidr.IPAddress)
+			}
+			checkHost.Nics = append(checkHost.Nics, checkNic)
+		}
+		if len(checkHost.Nics) > 0 {
+			checkParams = append(checkParams, checkHost)
+		}
+	}
+	return checkParams
+}
@@ -2,6 +2,7 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
@@ -30,17 +31,40 @@ var _ = Describe("connectivitycheckcmd", func() {
 		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
 	})
 
-	It("get_step", func() {
+	It("get_step_single_host", func() {
 		stepReply, stepErr = connectivityCheckCmd.GetStep(ctx, &host)
-		Expect(stepReply.StepType).To(Equal(models.StepTypeConnectivityCheck))
+		Expect(stepReply).To(BeNil())
 		Expect(stepErr).ShouldNot(HaveOccurred())
 	})
 
 	It("get_step_unknow_cluster_id", func() {
 		host.ClusterID = strfmt.UUID(uuid.New().String())
 		stepReply, stepErr = connectivityCheckCmd.GetStep(ctx, &host)
-		Expect(stepReply.StepType).To(Equal(models.StepTypeConnectivityCheck))
+		Expect(stepReply).To(BeNil())
+		Expect(stepErr).ShouldNot(HaveOccurred())
+	})
+
+	It("get_step", func() {
+		peerId := strfmt.UUID(uuid.New().String())
+		peer := getTestHostWithNic(peerId, clusterId, HostStatusKnown, "eth0", "1.2.3.4")
+		Expect(db.Create(&peer).Error).ShouldNot(HaveOccurred())
+		disabledId := strfmt.UUID(uuid.New().String())
+		disabled := getTestHostWithNic(disabledId, clusterId, HostStatusDisabled, "eth0", "1.2.3.5")
+		Expect(db.Create(&disabled).Error).ShouldNot(HaveOccurred())
+		noHwInfoId := strfmt.UUID(uuid.New().String())
+		noHwInfo := getTestHost(noHwInfoId, clusterId, HostStatusDiscovering)
+		Expect(db.Create(&noHwInfo).Error).ShouldNot(HaveOccurred())
+
+		stepReply, stepErr = connectivityCheckCmd.GetStep(ctx, &host)
 		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply.StepType).To(Equal(models.StepTypeConnectivityCheck))
+		var params models.ConnectivityCheckParams
+		Expect(json.Unmarshal([]byte(stepReply.Args[len(stepReply.Args)-1]), &params)).ShouldNot(HaveOccurred())
+		Expect(params).To(HaveLen(1))
+		Expect(params[0].HostID).To(Equal(peerId))
+		Expect(params[0].Nics).To(HaveLen(1))
+		Expect(params[0].Nics[0].Name).To(Equal("eth0"))
+		Expect(params[0].Nics[0].IPAddresses).To(Equal([]string{"1.2.3.4"}))
 	})
 
 	AfterEach(func() {
@@ -49,3 +73,13 @@ var _ = Describe("connectivitycheckcmd", func() {
 		stepErr = nil
 	})
 })
+
+func getTestHostWithNic(hostID, clusterID strfmt.UUID, state, nicName, ip string) models.Host {
+	h := getTestHost(hostID, clusterID, state)
+	hwInfo, err := json.Marshal(&models.Introspection{
+		Nics: []*models.Nic{{Name: nicName, Mac: "52:54:00:00:00:01", Cidrs: []*models.Cidr{{IPAddress: ip, Mask: 24}}}},
+	})
+	Expect(err).ShouldNot(HaveOccurred())
+	h.HardwareInfo = string(hwInfo)
+	return h
+}
@@ -35,7 +35,7 @@ func (d *disabledState) UpdateRole(ctx context.Context, h *models.Host, role str
 	if db != nil {
 		cdb = db
 	}
-	return updateStateWithParams(logutil.FromContext(ctx, d.log), HostStatusDisabled, statusInfoDisabled, h, cdb,
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, d.log), HostStatusDisabled, statusInfoDisabled, h, cdb,
 		"role", role)
 }
 
@@ -53,7 +53,7 @@ func (d *disabledState) Install(ctx context.Context, h *models.Host, db *gorm.DB
 }
 
 func (d *disabledState) EnableHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateStateWithParams(logutil.FromContext(ctx, d.log), HostStatusDiscovering, "", h, d.db,
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, d.log), HostStatusDiscovering, "", h, d.db,
 		"hardware_info", "")
 }
 
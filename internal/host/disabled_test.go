@@ -4,8 +4,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/filanov/bm-inventory/internal/events"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
 	. "github.com/onsi/ginkgo"
@@ -25,7 +27,7 @@ var _ = Describe("disabled_state", func() {
 
 	BeforeEach(func() {
 		db = prepareDB()
-		state = &Manager{disabled: NewDisabledState(getTestLog(), db)}
+		state = &Manager{db: db, disabled: NewDisabledState(getTestLog(), db)}
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
@@ -54,6 +56,11 @@ var _ = Describe("disabled_state", func() {
 			expectedReply.postCheck = func() {
 				h := getHost(id, clusterId, db)
 				Expect(h.Role).Should(Equal("master"))
+				// the status info of the test host is replaced by the status info of the disabled state
+				hostEvents := getHostEvents(id, clusterId, db)
+				Expect(hostEvents).Should(HaveLen(2))
+				Expect(hostEvents[1].Change).Should(Equal(events.ChangeRole))
+				Expect(swag.StringValue(hostEvents[1].ToStatus)).Should(Equal(HostStatusDisabled))
 			}
 		})
 		It("master_with_tx", func() {
@@ -64,6 +71,7 @@ var _ = Describe("disabled_state", func() {
 			expectedReply.postCheck = func() {
 				h := getHost(id, clusterId, db)
 				Expect(h.Role).Should(Equal(""))
+				Expect(getHostEvents(id, clusterId, db)).Should(BeEmpty())
 			}
 		})
 	})
@@ -90,6 +98,10 @@ var _ = Describe("disabled_state", func() {
 			h := getHost(id, clusterId, db)
 			Expect(h.HardwareInfo).Should(Equal(""))
 			Expect(*h.StatusInfo).Should(Equal(""))
+			hostEvents := getHostEvents(id, clusterId, db)
+			Expect(hostEvents).Should(HaveLen(1))
+			Expect(hostEvents[0].FromStatus).Should(Equal(HostStatusDisabled))
+			Expect(swag.StringValue(hostEvents[0].ToStatus)).Should(Equal(HostStatusDiscovering))
 		}
 	})
 
@@ -28,13 +28,13 @@ type disconnectedState struct {
 }
 
 func (d *disconnectedState) RegisterHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateStateWithParams(logutil.FromContext(ctx, d.log), HostStatusDiscovering, statusInfoDiscovering, h, d.db,
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, d.log), HostStatusDiscovering, statusInfoDiscovering, h, d.db,
 		"hardware_info", "")
 }
 
 func (d *disconnectedState) UpdateHwInfo(ctx context.Context, h *models.Host, hwInfo string) (*UpdateReply, error) {
 	h.HardwareInfo = hwInfo
-	return updateHwInfo(logutil.FromContext(ctx, d.log), d.hwValidator, h, d.db)
+	return updateHwInfo(ctx, logutil.FromContext(ctx, d.log), d.hwValidator, h, d.db)
 }
 
 func (d *disconnectedState) UpdateRole(ctx context.Context, h *models.Host, role string, db *gorm.DB) (*UpdateReply, error) {
@@ -42,7 +42,7 @@ func (d *disconnectedState) UpdateRole(ctx context.Context, h *models.Host, role
 	if db != nil {
 		cdb = db
 	}
-	return updateStateWithParams(logutil.FromContext(ctx, d.log), HostStatusDisconnected,
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, d.log), HostStatusDisconnected,
 		swag.StringValue(h.StatusInfo), h, cdb, "role", role)
 }
 
@@ -68,5 +68,5 @@ func (d *disconnectedState) EnableHost(ctx context.Context, h *models.Host) (*Up
 }
 
 func (d *disconnectedState) DisableHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateState(logutil.FromContext(ctx, d.log), HostStatusDisabled, statusInfoDisabled, h, d.db)
+	return updateState(ctx, logutil.FromContext(ctx, d.log), HostStatusDisabled, statusInfoDisabled, h, d.db)
 }
@@ -34,7 +34,7 @@ var _ = Describe("disconnected_state", func() {
 		db = prepareDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockValidator = hardware.NewMockValidator(ctrl)
-		state = &Manager{disconnected: NewDisconnectedState(getTestLog(), db, mockValidator)}
+		state = &Manager{db: db, disconnected: NewDisconnectedState(getTestLog(), db, mockValidator)}
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
@@ -54,7 +54,7 @@ var _ = Describe("disconnected_state", func() {
 
 	Context("update_hw_info", func() {
 		It("sufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: true}, nil).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectedState = HostStatusKnown
@@ -64,7 +64,7 @@ var _ = Describe("disconnected_state", func() {
 			}
 		})
 		It("insufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: false, Reason: "because"}, nil).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectedState = HostStatusInsufficient
@@ -75,7 +75,7 @@ var _ = Describe("disconnected_state", func() {
 			}
 		})
 		It("hw_validation_error", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(nil, errors.New("error")).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectError = true
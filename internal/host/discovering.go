@@ -3,6 +3,7 @@ package host
 import (
 	"context"
 
+	"github.com/filanov/bm-inventory/internal/events"
 	"github.com/filanov/bm-inventory/internal/hardware"
 	"github.com/filanov/bm-inventory/models"
 	logutil "github.com/filanov/bm-inventory/pkg/log"
@@ -33,13 +34,19 @@ func (d *discoveringState) RegisterHost(ctx context.Context, h *models.Host) (*U
 
 	// if already exists, reset role and hw info
 	if err := d.db.First(&host, "id = ? and cluster_id = ?", h.ID, h.ClusterID).Error; err == nil {
-		return updateStateWithParams(log, HostStatusDiscovering, statusInfoDiscovering, &host, d.db,
+		return updateStateWithParams(ctx, log, HostStatusDiscovering, statusInfoDiscovering, &host, d.db,
 			"hardware_info", "", "role", "")
 	}
 
 	// new host
 	h.Status = swag.String(HostStatusDiscovering)
-	if err := d.db.Create(h).Error; err != nil {
+	err := events.Transaction(d.db, func(tx *gorm.DB) error {
+		if err := tx.Create(h).Error; err != nil {
+			return err
+		}
+		return addStateTransition(ctx, tx, h, "", HostStatusDiscovering, swag.StringValue(h.StatusInfo))
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &UpdateReply{
@@ -50,7 +57,7 @@ func (d *discoveringState) RegisterHost(ctx context.Context, h *models.Host) (*U
 
 func (d *discoveringState) UpdateHwInfo(ctx context.Context, h *models.Host, hwInfo string) (*UpdateReply, error) {
 	h.HardwareInfo = hwInfo
-	return updateHwInfo(logutil.FromContext(ctx, d.log), d.hwValidator, h, d.db)
+	return updateHwInfo(ctx, logutil.FromContext(ctx, d.log), d.hwValidator, h, d.db)
 }
 
 func (d *discoveringState) UpdateRole(ctx context.Context, h *models.Host, role string, db *gorm.DB) (*UpdateReply, error) {
@@ -58,11 +65,11 @@ func (d *discoveringState) UpdateRole(ctx context.Context, h *models.Host, role
 	if db != nil {
 		cdb = db
 	}
-	return updateStateWithParams(logutil.FromContext(ctx, d.log), HostStatusDiscovering, statusInfoDiscovering, h, cdb, "role", role)
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, d.log), HostStatusDiscovering, statusInfoDiscovering, h, cdb, "role", role)
 }
 
 func (d *discoveringState) RefreshStatus(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateByKeepAlive(logutil.FromContext(ctx, d.log), h, d.db)
+	return updateByKeepAlive(ctx, logutil.FromContext(ctx, d.log), h, d.db)
 }
 
 func (d *discoveringState) Install(ctx context.Context, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
@@ -79,5 +86,5 @@ func (d *discoveringState) EnableHost(ctx context.Context, h *models.Host) (*Upd
 }
 
 func (d *discoveringState) DisableHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateState(logutil.FromContext(ctx, d.log), HostStatusDisabled, statusInfoDisabled, h, d.db)
+	return updateState(ctx, logutil.FromContext(ctx, d.log), HostStatusDisabled, statusInfoDisabled, h, d.db)
 }
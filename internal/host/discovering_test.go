@@ -5,8 +5,10 @@ import (
 	"time"
 
 	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/internal/webhooks"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/jinzhu/gorm"
@@ -34,7 +36,7 @@ var _ = Describe("discovering_state", func() {
 		db = prepareDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockValidator = hardware.NewMockValidator(ctrl)
-		state = &Manager{discovering: NewDiscoveringState(getTestLog(), db, mockValidator)}
+		state = &Manager{db: db, discovering: NewDiscoveringState(getTestLog(), db, mockValidator)}
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
@@ -58,13 +60,17 @@ var _ = Describe("discovering_state", func() {
 			expectedReply.postCheck = func() {
 				h := getHost(id, clusterId, db)
 				Expect(h.HardwareInfo).Should(Equal(""))
+				hostEvents := getHostEvents(id, clusterId, db)
+				Expect(len(hostEvents)).Should(Equal(1))
+				Expect(hostEvents[0].FromStatus).Should(Equal(""))
+				Expect(*hostEvents[0].ToStatus).Should(Equal(HostStatusDiscovering))
 			}
 		})
 	})
 
 	Context("update_hw_info", func() {
 		It("sufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: true}, nil).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectedState = HostStatusKnown
@@ -74,7 +80,13 @@ var _ = Describe("discovering_state", func() {
 			}
 		})
 		It("insufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			webhook, err := webhooks.Register(db, &models.WebhookCreateParams{
+				URL:        swag.String("https://example.com/hook"),
+				Secret:     swag.String("0123456789abcdef"),
+				EventTypes: []models.WebhookEventType{models.WebhookEventTypeHostInsufficient},
+			})
+			Expect(err).ShouldNot(HaveOccurred())
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: false, Reason: "because"}, nil).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectedState = HostStatusInsufficient
@@ -82,16 +94,26 @@ var _ = Describe("discovering_state", func() {
 				h := getHost(id, clusterId, db)
 				Expect(h.HardwareInfo).Should(Equal("some hw info"))
 				Expect(*h.StatusInfo).Should(Equal("because"))
+				hostEvents := getHostEvents(id, clusterId, db)
+				Expect(len(hostEvents)).Should(Equal(1))
+				Expect(hostEvents[0].FromStatus).Should(Equal(HostStatusDiscovering))
+				Expect(*hostEvents[0].ToStatus).Should(Equal(HostStatusInsufficient))
+				Expect(hostEvents[0].StatusInfo).Should(Equal("because"))
+				deliveries, err := webhooks.ListDeliveries(db, *webhook.ID)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(deliveries).Should(HaveLen(1))
+				Expect(deliveries[0].EventID).Should(Equal(hostEvents[0].ID))
 			}
 		})
 		It("hw_validation_error", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(nil, errors.New("error")).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectError = true
 			expectedReply.postCheck = func() {
 				h := getHost(id, clusterId, db)
 				Expect(h.HardwareInfo).Should(Equal(defaultHwInfo))
+				Expect(getHostEvents(id, clusterId, db)).Should(BeEmpty())
 			}
 		})
 	})
@@ -25,7 +25,7 @@ var _ = Describe("error_state", func() {
 
 	BeforeEach(func() {
 		db = prepareDB()
-		state = &Manager{error: NewErrorState(getTestLog(), db)}
+		state = &Manager{db: db, error: NewErrorState(getTestLog(), db)}
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
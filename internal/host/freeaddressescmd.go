@@ -0,0 +1,70 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+
+	"github.com/filanov/bm-inventory/internal/network"
+	"github.com/filanov/bm-inventory/models"
+)
+
+type freeAddressesCmd struct {
+	baseCmd
+	db       *gorm.DB
+	interval time.Duration
+}
+
+func NewFreeAddressesCmd(log logrus.FieldLogger, db *gorm.DB, interval time.Duration) *freeAddressesCmd {
+	return &freeAddressesCmd{
+		baseCmd:  baseCmd{log: log},
+		db:       db,
+		interval: interval,
+	}
+}
+
+// GetStep returns a scan of the machine networks of the cluster for free addresses. A single host of the cluster
+// scans the networks, the known or insufficient host with the lowest ID, at most once every interval.
+func (f *freeAddressesCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	if time.Since(time.Time(host.FreeAddressesUpdatedAt)) < f.interval {
+		return nil, nil
+	}
+	var hosts []*models.Host
+	if err := f.db.Order("id").Find(&hosts, "cluster_id = ? and status != ?",
+		host.ClusterID, HostStatusDisabled).Error; err != nil {
+		f.log.WithError(err).Errorf("failed to get list of hosts for cluster %s", host.ClusterID)
+		return nil, err
+	}
+	for _, h := range hosts {
+		if status := swag.StringValue(h.Status); status == HostStatusKnown || status == HostStatusInsufficient {
+			if h.ID.String() != host.ID.String() {
+				// another host scans the networks
+				return nil, nil
+			}
+			break
+		}
+	}
+
+	request := models.FreeAddressesRequest(network.MachineNetworksStrings(hosts))
+	if len(request) == 0 {
+		// the hosts share no network yet
+		return nil, nil
+	}
+	params, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	step := &models.Step{}
+	step.StepType = models.StepTypeFreeNetworkAddresses
+	step.Command = "podman"
+	step.Args = strings.Split("run,--rm,--privileged,--quiet,--net=host,-v,/var/log:/var/log,quay.io/ocpmetal/free_addresses:latest,/usr/bin/free_addresses", ",")
+	step.Args = append(step.Args, string(params))
+	return step, nil
+}
@@ -0,0 +1,83 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("freeaddressescmd", func() {
+	ctx := context.Background()
+	var db *gorm.DB
+	var freeAddressesCmd *freeAddressesCmd
+	var clusterId strfmt.UUID
+	var hosts []models.Host
+
+	BeforeEach(func() {
+		db = prepareDB()
+		freeAddressesCmd = NewFreeAddressesCmd(getTestLog(), db, time.Minute)
+
+		clusterId = strfmt.UUID(uuid.New().String())
+		var ids []string
+		for i := 0; i < 3; i++ {
+			ids = append(ids, uuid.New().String())
+		}
+		sort.Strings(ids)
+		hosts = []models.Host{
+			getTestHostWithNic(strfmt.UUID(ids[0]), clusterId, HostStatusDisabled, "eth0", "1.2.3.4"),
+			getTestHostWithNic(strfmt.UUID(ids[1]), clusterId, HostStatusKnown, "eth0", "1.2.3.5"),
+			getTestHostWithNic(strfmt.UUID(ids[2]), clusterId, HostStatusInsufficient, "eth0", "1.2.3.6"),
+		}
+		for i := range hosts {
+			Expect(db.Create(&hosts[i]).Error).ShouldNot(HaveOccurred())
+		}
+	})
+
+	It("get_step", func() {
+		stepReply, stepErr := freeAddressesCmd.GetStep(ctx, &hosts[1])
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply.StepType).To(Equal(models.StepTypeFreeNetworkAddresses))
+		var request models.FreeAddressesRequest
+		Expect(json.Unmarshal([]byte(stepReply.Args[len(stepReply.Args)-1]), &request)).ShouldNot(HaveOccurred())
+		Expect(request).To(Equal(models.FreeAddressesRequest{"1.2.3.0/24"}))
+	})
+
+	It("single_scanning_host", func() {
+		stepReply, stepErr := freeAddressesCmd.GetStep(ctx, &hosts[2])
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	It("scanned_recently", func() {
+		hosts[1].FreeAddressesUpdatedAt = strfmt.DateTime(time.Now().Add(-30 * time.Second))
+		stepReply, stepErr := freeAddressesCmd.GetStep(ctx, &hosts[1])
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+
+		hosts[1].FreeAddressesUpdatedAt = strfmt.DateTime(time.Now().Add(-2 * time.Minute))
+		stepReply, stepErr = freeAddressesCmd.GetStep(ctx, &hosts[1])
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).NotTo(BeNil())
+	})
+
+	It("no_shared_network", func() {
+		other := getTestHostWithNic("ffffffff-ffff-4fff-bfff-ffffffffffff", clusterId, HostStatusKnown,
+			"eth0", "1.2.4.6")
+		Expect(db.Create(&other).Error).ShouldNot(HaveOccurred())
+		stepReply, stepErr := freeAddressesCmd.GetStep(ctx, &hosts[1])
+		Expect(stepErr).ShouldNot(HaveOccurred())
+		Expect(stepReply).To(BeNil())
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
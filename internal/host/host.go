@@ -3,13 +3,18 @@ package host
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
+	"github.com/filanov/bm-inventory/internal/events"
 	"github.com/filanov/bm-inventory/internal/hardware"
 	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/leader"
 	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/jinzhu/gorm"
 	"github.com/sirupsen/logrus"
@@ -53,6 +58,8 @@ const (
 	RoleMaster    = "master"
 	RoleBootstrap = "bootstrap"
 	RoleWorker    = "worker"
+	// RoleAutoAssign hosts get the master or worker role when the cluster installation starts
+	RoleAutoAssign = "auto-assign"
 )
 
 const (
@@ -65,9 +72,25 @@ type API interface {
 	InstructionApi
 	SpecificHardwareParams
 	UpdateInstallProgress(ctx context.Context, h *models.Host, progress string) error
-	SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool) error
+	// Mark or unmark the host as the bootstrap of the installation - db is optional, for transactions
+	SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool, db *gorm.DB) error
+	UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport *models.ConnectivityReport) error
+	UpdateInventory(ctx context.Context, h *models.Host, inventory string) error
+	// Set the free addresses of the machine networks found by the last scan of the host
+	UpdateFreeAddresses(ctx context.Context, h *models.Host, freeAddresses string) error
+	// Set the disk the host will be installed on, an empty diskID restores the default disk selection
+	SetInstallationDisk(ctx context.Context, h *models.Host, diskID string) error
+	// Assign the master or worker role to the known hosts with the auto-assign role - db is optional, for transactions
+	AutoAssignRoles(ctx context.Context, hosts []*models.Host, db *gorm.DB) error
+	// Choose the master that will bootstrap the installation, either the pinned one or by election - db is optional, for transactions
+	SelectBootstrap(ctx context.Context, hosts []*models.Host, pinnedHostID strfmt.UUID, db *gorm.DB) (*models.Host, string, error)
+	// Refresh the status of all the monitored hosts, should be called periodically
+	HostMonitoring()
 }
 
+// ErrInstallationStarted is returned for changes that are not allowed once the host installation started
+var ErrInstallationStarted = errors.New("host installation already started")
+
 type Manager struct {
 	log            logrus.FieldLogger
 	db             *gorm.DB
@@ -81,9 +104,11 @@ type Manager struct {
 	error          StateAPI
 	instructionApi InstructionApi
 	hwValidator    hardware.Validator
+	leaderElector  leader.ElectorInterface
 }
 
-func NewManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, instructionApi InstructionApi) *Manager {
+func NewManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, instructionApi InstructionApi,
+	leaderElector leader.ElectorInterface) *Manager {
 	return &Manager{
 		log:            log,
 		db:             db,
@@ -97,6 +122,7 @@ func NewManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Valida
 		error:          NewErrorState(log, db),
 		instructionApi: instructionApi,
 		hwValidator:    hwValidator,
+		leaderElector:  leaderElector,
 	}
 }
 
@@ -131,12 +157,25 @@ func (m *Manager) RegisterHost(ctx context.Context, h *models.Host) (*UpdateRepl
 	return state.RegisterHost(ctx, h)
 }
 
+// UpdateHwInfo records the change of the hardware info after the update of the host, since the states update
+// the host in their own transaction
 func (m *Manager) UpdateHwInfo(ctx context.Context, h *models.Host, hwInfo string) (*UpdateReply, error) {
 	state, err := m.getCurrentState(swag.StringValue(h.Status))
 	if err != nil {
 		return nil, err
 	}
-	return state.UpdateHwInfo(ctx, h, hwInfo)
+	changed := h.HardwareInfo != hwInfo
+	reply, err := state.UpdateHwInfo(ctx, h, hwInfo)
+	if err != nil {
+		return nil, err
+	}
+	// a state transition is already streamed by its own event
+	if changed && !reply.IsChanged {
+		if err := events.AddChange(ctx, m.db, h.ClusterID, *h.ID, reply.State, events.ChangeHardwareInfo); err != nil {
+			return nil, err
+		}
+	}
+	return reply, nil
 }
 
 func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role string, db *gorm.DB) (*UpdateReply, error) {
@@ -144,7 +183,25 @@ func (m *Manager) UpdateRole(ctx context.Context, h *models.Host, role string, d
 	if err != nil {
 		return nil, err
 	}
-	return state.UpdateRole(ctx, h, role, db)
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	var reply *UpdateReply
+	changed := h.Role != role
+	err = events.Transaction(cdb, func(tx *gorm.DB) error {
+		if reply, err = state.UpdateRole(ctx, h, role, tx); err != nil {
+			return err
+		}
+		if !changed || reply.IsChanged {
+			return nil
+		}
+		return events.AddChange(ctx, tx, h.ClusterID, *h.ID, reply.State, events.ChangeRole)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reply, nil
 }
 
 func (m *Manager) RefreshStatus(ctx context.Context, h *models.Host) (*UpdateReply, error) {
@@ -184,7 +241,7 @@ func (m *Manager) GetNextSteps(ctx context.Context, host *models.Host) (models.S
 }
 
 func (m *Manager) GetHostValidDisks(host *models.Host) ([]*models.BlockDevice, error) {
-	return m.hwValidator.GetHostValidDisks(host)
+	return getHostValidDisks(m.hwValidator, host, m.db)
 }
 
 func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, progress string) error {
@@ -194,29 +251,95 @@ func (m *Manager) UpdateInstallProgress(ctx context.Context, h *models.Host, pro
 
 	// installation done
 	if progress == progressDone {
-		_, err := updateStateWithParams(logutil.FromContext(ctx, m.log),
+		_, err := updateStateWithParams(ctx, logutil.FromContext(ctx, m.log),
 			HostStatusInstalled, HostStatusInstalled, h, m.db)
 		return err
 	}
 
 	// installation failed
 	if strings.HasPrefix(progress, progressFailed) {
-		_, err := updateStateWithParams(logutil.FromContext(ctx, m.log),
+		_, err := updateStateWithParams(ctx, logutil.FromContext(ctx, m.log),
 			HostStatusError, progress, h, m.db)
 		return err
 	}
 
-	_, err := updateStateWithParams(logutil.FromContext(ctx, m.log),
+	_, err := updateStateWithParams(ctx, logutil.FromContext(ctx, m.log),
 		HostStatusInstallingInProgress, progress, h, m.db)
 	return err
 }
 
-func (m *Manager) SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool) error {
-	if h.Bootstrap != isbootstrap {
-		err := m.db.Model(h).Update("bootstrap", isbootstrap).Error
-		if err != nil {
+func (m *Manager) SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool, db *gorm.DB) error {
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+	if h.Bootstrap == isbootstrap {
+		return nil
+	}
+	return events.Transaction(cdb, func(tx *gorm.DB) error {
+		if err := tx.Model(h).Update("bootstrap", isbootstrap).Error; err != nil {
 			return errors.Wrapf(err, "failed to set bootstrap to host %s", h.ID.String())
 		}
+		return events.AddChange(ctx, tx, h.ClusterID, *h.ID, swag.StringValue(h.Status), events.ChangeBootstrap)
+	})
+}
+
+func (m *Manager) UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport *models.ConnectivityReport) error {
+	if reflect.DeepEqual(h.Connectivity, connectivityReport) {
+		return nil
+	}
+	return events.Transaction(m.db, func(tx *gorm.DB) error {
+		if err := tx.Model(h).Update("connectivity", connectivityReport).Error; err != nil {
+			return errors.Wrapf(err, "failed to set connectivity to host %s", h.ID.String())
+		}
+		return events.AddChange(ctx, tx, h.ClusterID, *h.ID, swag.StringValue(h.Status), events.ChangeConnectivity)
+	})
+}
+
+func (m *Manager) UpdateInventory(ctx context.Context, h *models.Host, inventory string) error {
+	if h.Inventory == inventory {
+		return nil
+	}
+	return events.Transaction(m.db, func(tx *gorm.DB) error {
+		if err := tx.Model(h).Update("inventory", inventory).Error; err != nil {
+			return errors.Wrapf(err, "failed to set inventory to host %s", h.ID.String())
+		}
+		return events.AddChange(ctx, tx, h.ClusterID, *h.ID, swag.StringValue(h.Status), events.ChangeInventory)
+	})
+}
+
+func (m *Manager) UpdateFreeAddresses(ctx context.Context, h *models.Host, freeAddresses string) error {
+	if err := m.db.Model(h).Updates(map[string]interface{}{
+		"free_addresses":            freeAddresses,
+		"free_addresses_updated_at": time.Now(),
+	}).Error; err != nil {
+		return errors.Wrapf(err, "failed to set free addresses to host %s", h.ID.String())
 	}
 	return nil
 }
+
+func (m *Manager) SetInstallationDisk(ctx context.Context, h *models.Host, diskID string) error {
+	switch swag.StringValue(h.Status) {
+	case HostStatusInstalling, HostStatusInstallingInProgress, HostStatusInstalled, HostStatusError:
+		return errors.Wrapf(ErrInstallationStarted, "can't set installation disk of host %s in status %s",
+			h.ID.String(), swag.StringValue(h.Status))
+	}
+	if diskID != "" {
+		profile, err := getHardwareProfile(h, m.db)
+		if err != nil {
+			return err
+		}
+		if err := m.hwValidator.ValidateInstallationDisk(h, profile, diskID); err != nil {
+			return err
+		}
+	}
+	if h.InstallationDisk == diskID {
+		return nil
+	}
+	return events.Transaction(m.db, func(tx *gorm.DB) error {
+		if err := tx.Model(h).Update("installation_disk", diskID).Error; err != nil {
+			return errors.Wrapf(err, "failed to set installation disk to host %s", h.ID.String())
+		}
+		return events.AddChange(ctx, tx, h.ClusterID, *h.ID, swag.StringValue(h.Status), events.ChangeInstallationDisk)
+	})
+}
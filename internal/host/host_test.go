@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"testing"
+	"time"
 
+	"github.com/filanov/bm-inventory/internal/events"
 	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/internal/webhooks"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -16,6 +19,7 @@ import (
 	_ "github.com/jinzhu/gorm/dialects/sqlite"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 )
 
@@ -37,7 +41,7 @@ var _ = Describe("statemachine", func() {
 		db = prepareDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockValidator = hardware.NewMockValidator(ctrl)
-		state = NewManager(getTestLog(), db, mockValidator, nil)
+		state = NewManager(getTestLog(), db, mockValidator, nil, nil)
 		id := strfmt.UUID(uuid.New().String())
 		clusterId := strfmt.UUID(uuid.New().String())
 		host = getTestHost(id, clusterId, "unknown invalid state")
@@ -94,7 +98,7 @@ var _ = Describe("update_progress", func() {
 
 	BeforeEach(func() {
 		db = prepareDB()
-		state = NewManager(getTestLog(), db, nil, nil)
+		state = NewManager(getTestLog(), db, nil, nil, nil)
 		id := strfmt.UUID(uuid.New().String())
 		clusterId := strfmt.UUID(uuid.New().String())
 		host = getTestHost(id, clusterId, "")
@@ -132,6 +136,124 @@ var _ = Describe("update_progress", func() {
 	})
 })
 
+var _ = Describe("installation_disk", func() {
+	var (
+		ctx           = context.Background()
+		db            *gorm.DB
+		ctrl          *gomock.Controller
+		mockValidator *hardware.MockValidator
+		state         API
+		host          models.Host
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockValidator = hardware.NewMockValidator(ctrl)
+		state = NewManager(getTestLog(), db, mockValidator, nil, nil)
+		id := strfmt.UUID(uuid.New().String())
+		clusterId := strfmt.UUID(uuid.New().String())
+		host = getTestHost(id, clusterId, HostStatusKnown)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+	})
+
+	// expectChanges expects the events of the host to be changes of the given fields
+	expectChanges := func(changes ...string) {
+		hostEvents := getHostEvents(*host.ID, host.ClusterID, db)
+		Expect(hostEvents).Should(HaveLen(len(changes)))
+		for i, event := range hostEvents {
+			Expect(event.Change).Should(Equal(changes[i]))
+			Expect(event.FromStatus).Should(Equal(HostStatusKnown))
+			Expect(swag.StringValue(event.ToStatus)).Should(Equal(HostStatusKnown))
+		}
+	}
+
+	It("update_inventory", func() {
+		Expect(state.UpdateInventory(ctx, &host, "some inventory")).ShouldNot(HaveOccurred())
+		h := getHost(*host.ID, host.ClusterID, db)
+		Expect(h.Inventory).Should(Equal("some inventory"))
+		Expect(state.UpdateInventory(ctx, h, "some inventory")).ShouldNot(HaveOccurred())
+		expectChanges(events.ChangeInventory)
+	})
+
+	It("update_connectivity_report", func() {
+		report := &models.ConnectivityReport{RemoteHosts: []*models.ConnectivityRemoteHost{{
+			HostID:         strfmt.UUID(uuid.New().String()),
+			L2Connectivity: []*models.L2Connectivity{{OutgoingNic: "eth0", RemoteMac: "52:54:00:00:00:01", Successful: true}},
+		}}}
+		Expect(state.UpdateConnectivityReport(ctx, &host, report)).ShouldNot(HaveOccurred())
+		h := getHost(*host.ID, host.ClusterID, db)
+		Expect(h.Connectivity).Should(Equal(report))
+		Expect(state.UpdateConnectivityReport(ctx, h, report)).ShouldNot(HaveOccurred())
+		expectChanges(events.ChangeConnectivity)
+	})
+
+	It("update_role", func() {
+		mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
+			Return(&hardware.IsSufficientReply{IsSufficient: true}, nil).Times(1)
+		_, err := state.UpdateRole(ctx, &host, "master", nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(getHost(*host.ID, host.ClusterID, db).Role).Should(Equal("master"))
+		expectChanges(events.ChangeRole)
+	})
+
+	It("update_free_addresses", func() {
+		Expect(state.UpdateFreeAddresses(ctx, &host, "some free addresses")).ShouldNot(HaveOccurred())
+		h := getHost(*host.ID, host.ClusterID, db)
+		Expect(h.FreeAddresses).Should(Equal("some free addresses"))
+		Expect(time.Time(h.FreeAddressesUpdatedAt)).Should(BeTemporally("~", time.Now(), time.Minute))
+	})
+
+	It("set_bootstrap_in_transaction", func() {
+		tx := db.Begin()
+		Expect(state.SetBootstrap(ctx, &host, true, tx)).ShouldNot(HaveOccurred())
+		Expect(tx.Rollback().Error).ShouldNot(HaveOccurred())
+		h := getHost(*host.ID, host.ClusterID, db)
+		Expect(h.Bootstrap).Should(BeFalse())
+
+		Expect(state.SetBootstrap(ctx, h, true, nil)).ShouldNot(HaveOccurred())
+		Expect(getHost(*host.ID, host.ClusterID, db).Bootstrap).Should(BeTrue())
+		expectChanges(events.ChangeBootstrap)
+	})
+
+	It("set_valid_disk", func() {
+		mockValidator.EXPECT().ValidateInstallationDisk(gomock.Any(), "", "sdb").Return(nil).Times(1)
+		Expect(state.SetInstallationDisk(ctx, &host, "sdb")).ShouldNot(HaveOccurred())
+		h := getHost(*host.ID, host.ClusterID, db)
+		Expect(h.InstallationDisk).Should(Equal("sdb"))
+		expectChanges(events.ChangeInstallationDisk)
+	})
+
+	It("set_invalid_disk", func() {
+		mockValidator.EXPECT().ValidateInstallationDisk(gomock.Any(), "", "sdb").
+			Return(fmt.Errorf("invalid disk")).Times(1)
+		Expect(state.SetInstallationDisk(ctx, &host, "sdb")).Should(HaveOccurred())
+		h := getHost(*host.ID, host.ClusterID, db)
+		Expect(h.InstallationDisk).Should(Equal(""))
+	})
+
+	It("reset_disk", func() {
+		Expect(db.Model(&host).Update("installation_disk", "sdb").Error).ShouldNot(HaveOccurred())
+		Expect(state.SetInstallationDisk(ctx, &host, "")).ShouldNot(HaveOccurred())
+		h := getHost(*host.ID, host.ClusterID, db)
+		Expect(h.InstallationDisk).Should(Equal(""))
+	})
+
+	It("installation_started", func() {
+		for _, status := range []string{HostStatusInstalling, HostStatusInstallingInProgress,
+			HostStatusInstalled, HostStatusError} {
+			host.Status = swag.String(status)
+			err := state.SetInstallationDisk(ctx, &host, "sdb")
+			Expect(errors.Cause(err)).Should(Equal(ErrInstallationStarted))
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		db.Close()
+	})
+})
+
 func TestSubsystem(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "host state machine tests")
@@ -143,11 +265,18 @@ func getHost(hostId, clusterId strfmt.UUID, db *gorm.DB) *models.Host {
 	return &host
 }
 
+func getHostEvents(hostId, clusterId strfmt.UUID, db *gorm.DB) models.EventList {
+	hostEvents, err := events.List(db, clusterId, events.Filter{HostID: &hostId})
+	Expect(err).ShouldNot(HaveOccurred())
+	return hostEvents
+}
+
 func prepareDB() *gorm.DB {
 	db, err := gorm.Open("sqlite3", ":memory:")
 	Expect(err).ShouldNot(HaveOccurred())
 	//db = db.Debug()
-	db.AutoMigrate(&models.Host{}, &models.Cluster{})
+	db.AutoMigrate(&models.Host{}, &models.Cluster{}, &models.Event{},
+		&webhooks.Subscription{}, &models.WebhookDelivery{})
 	return db
 }
 
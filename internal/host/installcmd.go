@@ -63,7 +63,7 @@ func (i *installCmd) GetStep(ctx context.Context, host *models.Host) (*models.St
 		"BOOT_DEVICE":       "",
 		"OPENSHIFT_VERSION": cluster.OpenshiftVersion,
 	}
-	bootdevice, err := getBootDevice(i.log, i.hwValidator, *host)
+	bootdevice, err := getBootDevice(i.log, i.hwValidator, *host, i.db)
 	if err != nil {
 		return nil, err
 	}
@@ -77,12 +77,11 @@ func (i *installCmd) GetStep(ctx context.Context, host *models.Host) (*models.St
 	return step, nil
 }
 
-func getBootDevice(log logrus.FieldLogger, hwValidator hardware.Validator, host models.Host) (string, error) {
-	disks, err := hwValidator.GetHostValidDisks(&host)
-	if err != nil || len(disks) == 0 {
-		err := fmt.Errorf("Failed to get valid disks on host with id %s", host.ID)
-		log.Errorf("Failed to get valid disks on host with id %s", host.ID)
-		return "", err
+func getBootDevice(log logrus.FieldLogger, hwValidator hardware.Validator, host models.Host, db *gorm.DB) (string, error) {
+	bootDevice, err := getInstallationDisk(hwValidator, &host, db)
+	if err != nil {
+		log.WithError(err).Errorf("Failed to get installation disk of host with id %s", host.ID)
+		return "", fmt.Errorf("Failed to get installation disk of host with id %s", host.ID)
 	}
-	return fmt.Sprintf("/dev/%s", disks[0].Name), nil
+	return bootDevice, nil
 }
@@ -29,7 +29,6 @@ var _ = Describe("installcmd", func() {
 		ctrl              *gomock.Controller
 		mockValidator     *hardware.MockValidator
 		instructionConfig InstructionConfig
-		disks             []*models.BlockDevice
 	)
 
 	BeforeEach(func() {
@@ -45,39 +44,26 @@ var _ = Describe("installcmd", func() {
 		cluster = createClusterInDb(db)
 		clusterId = *cluster.ID
 		host = createHostInDb(db, clusterId, RoleMaster, false)
-		validDiskSize := int64(128849018880)
-		disks = []*models.BlockDevice{
-			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdb", Size: validDiskSize},
-			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sda", Size: validDiskSize},
-			{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdh", Size: validDiskSize},
-		}
-
 	})
 
 	It("get_step_one_master", func() {
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(nil, errors.New("error")).Times(1)
-		stepReply, stepErr = installCmd.GetStep(ctx, &host)
-		postvalidation(true, true, stepReply, stepErr, "")
-	})
-
-	It("get_step_one_master_no_disks", func() {
-		var emptydisks []*models.BlockDevice
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(emptydisks, nil).Times(1)
+		mockValidator.EXPECT().GetInstallationDisk(gomock.Any(), gomock.Any()).Return("", errors.New("error")).Times(1)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(true, true, stepReply, stepErr, "")
 	})
 
 	It("get_step_one_master_success", func() {
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).Times(1)
+		mockValidator.EXPECT().GetInstallationDisk(gomock.Any(), gomock.Any()).Return("/dev/sdb", nil).Times(1)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(false, false, stepReply, stepErr, RoleMaster)
+		Expect(stepReply.Args[1]).To(ContainSubstring("--boot-device /dev/sdb"))
 	})
 
 	It("get_step_three_master_success", func() {
 
 		host2 := createHostInDb(db, clusterId, RoleMaster, false)
 		host3 := createHostInDb(db, clusterId, RoleMaster, true)
-		mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).Times(3)
+		mockValidator.EXPECT().GetInstallationDisk(gomock.Any(), gomock.Any()).Return("/dev/sdb", nil).Times(3)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host)
 		postvalidation(false, false, stepReply, stepErr, RoleMaster)
 		stepReply, stepErr = installCmd.GetStep(ctx, &host2)
@@ -25,7 +25,7 @@ var _ = Describe("installed_state", func() {
 
 	BeforeEach(func() {
 		db = prepareDB()
-		state = &Manager{installed: NewInstalledState(getTestLog(), db)}
+		state = &Manager{db: db, installed: NewInstalledState(getTestLog(), db)}
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
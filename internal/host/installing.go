@@ -21,7 +21,7 @@ func NewInstallingState(log logrus.FieldLogger, db *gorm.DB) *installingState {
 type installingState baseState
 
 func (i *installingState) RegisterHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateStateWithParams(logutil.FromContext(ctx, i.log), HostStatusError,
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, i.log), HostStatusError,
 		"host rebooted during installation process", h, i.db)
 }
 
@@ -25,7 +25,7 @@ var _ = Describe("installing_state", func() {
 
 	BeforeEach(func() {
 		db = prepareDB()
-		state = &Manager{installing: NewInstallingState(getTestLog(), db)}
+		state = &Manager{db: db, installing: NewInstallingState(getTestLog(), db)}
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
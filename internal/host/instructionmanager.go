@@ -3,6 +3,7 @@ package host
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/jinzhu/gorm"
 
@@ -31,21 +32,25 @@ type InstructionConfig struct {
 	InventoryURL   string `envconfig:"INVENTORY_URL" default:"10.35.59.36"`
 	InventoryPort  string `envconfig:"INVENTORY_PORT" default:"30485"`
 	InstallerImage string `envconfig:"INSTALLER_IMAGE" default:"quay.io/ocpmetal/assisted-installer:stable"`
+	// how often the machine networks of a cluster are scanned for free addresses
+	FreeAddressesInterval time.Duration `envconfig:"FREE_ADDRESSES_INTERVAL" default:"5m"`
 }
 
 func NewInstructionManager(log logrus.FieldLogger, db *gorm.DB, hwValidator hardware.Validator, instructionConfig InstructionConfig) *InstructionManager {
 	connectivityCmd := NewConnectivityCheckCmd(log, db)
 	installCmd := NewInstallCmd(log, db, hwValidator, instructionConfig)
 	hwCmd := NewHwInfoCmd(log)
+	inventoryCmd := NewInventoryCmd(log)
+	freeAddressesCmd := NewFreeAddressesCmd(log, db, instructionConfig.FreeAddressesInterval)
 
 	return &InstructionManager{
 		log: log,
 		db:  db,
 		stateToSteps: stateToStepsMap{
-			HostStatusKnown:        {connectivityCmd},
-			HostStatusInsufficient: {connectivityCmd},
-			HostStatusDisconnected: {hwCmd, connectivityCmd},
-			HostStatusDiscovering:  {hwCmd, connectivityCmd},
+			HostStatusKnown:        {connectivityCmd, freeAddressesCmd},
+			HostStatusInsufficient: {connectivityCmd, freeAddressesCmd},
+			HostStatusDisconnected: {hwCmd, inventoryCmd, connectivityCmd},
+			HostStatusDiscovering:  {hwCmd, inventoryCmd, connectivityCmd},
 			HostStatusInstalling:   {installCmd},
 		},
 	}
@@ -70,6 +75,10 @@ func (i *InstructionManager) GetNextSteps(ctx context.Context, host *models.Host
 			if err != nil {
 				return returnSteps, err
 			}
+			if step == nil {
+				// command has nothing to do for the host at the moment
+				continue
+			}
 			step.StepID = createStepID(step.StepType)
 			returnSteps = append(returnSteps, step)
 		}
@@ -40,6 +40,10 @@ var _ = Describe("instructionmanager", func() {
 		host = getTestHost(hostId, clusterId, "unknown invalid state")
 		host.Role = RoleMaster
 		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		// a peer host is needed for the connectivity check step, its ID is greater so that the host scans the
+		// machine networks for free addresses
+		peer := getTestHostWithNic("ffffffff-ffff-4fff-bfff-ffffffffffff", clusterId, HostStatusKnown, "eth0", "1.2.3.4")
+		Expect(db.Create(&peer).Error).ShouldNot(HaveOccurred())
 	})
 
 	Context("get_next_steps", func() {
@@ -50,19 +54,19 @@ var _ = Describe("instructionmanager", func() {
 		})
 		It("discovering", func() {
 			checkStepsByState(HostStatusDiscovering, &host, db, instMng, mockValidator, ctx,
-				[]models.StepType{models.StepTypeHardwareInfo, models.StepTypeConnectivityCheck})
+				[]models.StepType{models.StepTypeHardwareInfo, models.StepTypeInventory, models.StepTypeConnectivityCheck})
 		})
 		It("known", func() {
 			checkStepsByState(HostStatusKnown, &host, db, instMng, mockValidator, ctx,
-				[]models.StepType{models.StepTypeConnectivityCheck})
+				[]models.StepType{models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses})
 		})
 		It("disconnected", func() {
 			checkStepsByState(HostStatusDisconnected, &host, db, instMng, mockValidator, ctx,
-				[]models.StepType{models.StepTypeHardwareInfo, models.StepTypeConnectivityCheck})
+				[]models.StepType{models.StepTypeHardwareInfo, models.StepTypeInventory, models.StepTypeConnectivityCheck})
 		})
 		It("insufficient", func() {
 			checkStepsByState(HostStatusInsufficient, &host, db, instMng, mockValidator, ctx,
-				[]models.StepType{models.StepTypeConnectivityCheck})
+				[]models.StepType{models.StepTypeConnectivityCheck, models.StepTypeFreeNetworkAddresses})
 		})
 		It("error", func() {
 			checkStepsByState(HostStatusError, &host, db, instMng, mockValidator, ctx,
@@ -87,18 +91,12 @@ var _ = Describe("instructionmanager", func() {
 
 func checkStepsByState(state string, host *models.Host, db *gorm.DB, instMng *InstructionManager, mockValidator *hardware.MockValidator, ctx context.Context,
 	expectedStepTypes []models.StepType) {
-	updateReply, updateErr := updateState(getTestLog(), state, "", host, db)
+	updateReply, updateErr := updateState(ctx, getTestLog(), state, "", host, db)
 	Expect(updateErr).ShouldNot(HaveOccurred())
 	Expect(updateReply.IsChanged).Should(BeTrue())
 	h := getHost(*host.ID, host.ClusterID, db)
 	Expect(swag.StringValue(h.Status)).Should(Equal(state))
-	validDiskSize := int64(128849018880)
-	var disks = []*models.BlockDevice{
-		{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdb", Size: validDiskSize},
-		{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sda", Size: validDiskSize},
-		{DeviceType: "disk", Fstype: "iso9660", MajorDeviceNumber: 11, Mountpoint: "/test", Name: "sdh", Size: validDiskSize},
-	}
-	mockValidator.EXPECT().GetHostValidDisks(gomock.Any()).Return(disks, nil).AnyTimes()
+	mockValidator.EXPECT().GetInstallationDisk(gomock.Any(), gomock.Any()).Return("/dev/sda", nil).AnyTimes()
 	stepsReply, stepsErr := instMng.GetNextSteps(ctx, h)
 	Expect(stepsReply).To(HaveLen(len(expectedStepTypes)))
 	for i, step := range stepsReply {
@@ -28,13 +28,13 @@ type insufficientState struct {
 }
 
 func (i *insufficientState) RegisterHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateStateWithParams(logutil.FromContext(ctx, i.log), HostStatusDiscovering, HostStatusDiscovering, h, i.db,
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, i.log), HostStatusDiscovering, HostStatusDiscovering, h, i.db,
 		"hardware_info", "")
 }
 
 func (i *insufficientState) UpdateHwInfo(ctx context.Context, h *models.Host, hwInfo string) (*UpdateReply, error) {
 	h.HardwareInfo = hwInfo
-	return updateHwInfo(logutil.FromContext(ctx, i.log), i.hwValidator, h, i.db)
+	return updateHwInfo(ctx, logutil.FromContext(ctx, i.log), i.hwValidator, h, i.db)
 }
 
 func (i *insufficientState) UpdateRole(ctx context.Context, h *models.Host, role string, db *gorm.DB) (*UpdateReply, error) {
@@ -43,19 +43,11 @@ func (i *insufficientState) UpdateRole(ctx context.Context, h *models.Host, role
 	if db != nil {
 		cdb = db
 	}
-	reply, err := i.hwValidator.IsSufficient(h)
-	if err != nil {
-		return nil, err
-	}
-	if !reply.IsSufficient {
-		return updateStateWithParams(log, HostStatusInsufficient, reply.Reason, h, cdb,
-			"role", role)
-	}
-	return updateStateWithParams(log, HostStatusKnown, "", h, cdb, "role", role)
+	return updateByValidation(ctx, log, i.hwValidator, h, cdb, "role", role)
 }
 
 func (i *insufficientState) RefreshStatus(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateByKeepAlive(logutil.FromContext(ctx, i.log), h, i.db)
+	return updateByKeepAlive(ctx, logutil.FromContext(ctx, i.log), h, i.db)
 }
 
 func (i *insufficientState) Install(ctx context.Context, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
@@ -72,5 +64,5 @@ func (i *insufficientState) EnableHost(ctx context.Context, h *models.Host) (*Up
 }
 
 func (i *insufficientState) DisableHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateState(logutil.FromContext(ctx, i.log), HostStatusDisabled, statusInfoDisabled, h, i.db)
+	return updateState(ctx, logutil.FromContext(ctx, i.log), HostStatusDisabled, statusInfoDisabled, h, i.db)
 }
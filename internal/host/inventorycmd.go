@@ -0,0 +1,26 @@
+package host
+
+import (
+	"context"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/filanov/bm-inventory/models"
+)
+
+type inventoryCmd baseCmd
+
+func NewInventoryCmd(log logrus.FieldLogger) *inventoryCmd {
+	return &inventoryCmd{
+		log: log,
+	}
+}
+
+func (i *inventoryCmd) GetStep(ctx context.Context, host *models.Host) (*models.Step, error) {
+	step := &models.Step{}
+	step.StepType = models.StepTypeInventory
+	step.Command = "podman"
+	step.Args = strings.Split("run,--rm,--privileged,--quiet,--net=host,-v,/var/log:/var/log,-v,/run/udev:/run/udev,-v,/dev/disk:/dev/disk,quay.io/ocpmetal/inventory:latest,/usr/bin/inventory", ",")
+	return step, nil
+}
@@ -27,13 +27,13 @@ type knownState struct {
 }
 
 func (k *knownState) RegisterHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateStateWithParams(logutil.FromContext(ctx, k.log), HostStatusDiscovering, HostStatusDiscovering, h, k.db,
+	return updateStateWithParams(ctx, logutil.FromContext(ctx, k.log), HostStatusDiscovering, HostStatusDiscovering, h, k.db,
 		"hardware_info", "")
 }
 
 func (k *knownState) UpdateHwInfo(ctx context.Context, h *models.Host, hwInfo string) (*UpdateReply, error) {
 	h.HardwareInfo = hwInfo
-	return updateHwInfo(logutil.FromContext(ctx, k.log), k.hwValidator, h, k.db)
+	return updateHwInfo(ctx, logutil.FromContext(ctx, k.log), k.hwValidator, h, k.db)
 }
 
 func (k *knownState) UpdateRole(ctx context.Context, h *models.Host, role string, db *gorm.DB) (*UpdateReply, error) {
@@ -43,29 +43,22 @@ func (k *knownState) UpdateRole(ctx context.Context, h *models.Host, role string
 		cdb = db
 	}
 	h.Role = role
-	reply, err := k.hwValidator.IsSufficient(h)
-	if err != nil {
-		return nil, err
-	}
-	if !reply.IsSufficient {
-		return updateStateWithParams(log, HostStatusInsufficient, reply.Reason, h, cdb, "role", role)
-	}
-	return updateStateWithParams(log, HostStatusKnown, "", h, cdb, "role", role)
+	return updateByValidation(ctx, log, k.hwValidator, h, cdb, "role", role)
 }
 
 func (k *knownState) RefreshStatus(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateByKeepAlive(logutil.FromContext(ctx, k.log), h, k.db)
+	return updateByKeepAlive(ctx, logutil.FromContext(ctx, k.log), h, k.db)
 }
 
 func (k *knownState) Install(ctx context.Context, h *models.Host, db *gorm.DB) (*UpdateReply, error) {
-	if h.Role == "" {
+	if h.Role == "" || h.Role == RoleAutoAssign {
 		return nil, errors.Errorf("unable to install host <%s> without a role", h.ID)
 	}
 	cdb := k.db
 	if db != nil {
 		cdb = db
 	}
-	return updateState(logutil.FromContext(ctx, k.log), HostStatusInstalling, statusInfoInstalling, h, cdb)
+	return updateState(ctx, logutil.FromContext(ctx, k.log), HostStatusInstalling, statusInfoInstalling, h, cdb)
 }
 
 func (k *knownState) EnableHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
@@ -77,5 +70,5 @@ func (k *knownState) EnableHost(ctx context.Context, h *models.Host) (*UpdateRep
 }
 
 func (k *knownState) DisableHost(ctx context.Context, h *models.Host) (*UpdateReply, error) {
-	return updateState(logutil.FromContext(ctx, k.log), HostStatusDisabled, statusInfoDisabled, h, k.db)
+	return updateState(ctx, logutil.FromContext(ctx, k.log), HostStatusDisabled, statusInfoDisabled, h, k.db)
 }
@@ -2,9 +2,11 @@ package host
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/internal/validations"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -35,7 +37,7 @@ var _ = Describe("known_state", func() {
 		db = prepareDB()
 		ctrl = gomock.NewController(GinkgoT())
 		mockValidator = hardware.NewMockValidator(ctrl)
-		state = &Manager{known: NewKnownState(getTestLog(), db, mockValidator)}
+		state = &Manager{db: db, known: NewKnownState(getTestLog(), db, mockValidator)}
 
 		id = strfmt.UUID(uuid.New().String())
 		clusterId = strfmt.UUID(uuid.New().String())
@@ -55,7 +57,7 @@ var _ = Describe("known_state", func() {
 
 	Context("update_hw_info", func() {
 		It("sufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: true}, nil).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.postCheck = func() {
@@ -63,19 +65,30 @@ var _ = Describe("known_state", func() {
 				Expect(h.HardwareInfo).Should(Equal("some hw info"))
 			}
 		})
+		It("cluster_hardware_profile", func() {
+			Expect(db.Create(&models.Cluster{ID: &clusterId, HardwareProfile: "edge"}).Error).ShouldNot(HaveOccurred())
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), "edge").
+				Return(&hardware.IsSufficientReply{IsSufficient: true}, nil).Times(1)
+			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
+		})
 		It("insufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
-				Return(&hardware.IsSufficientReply{IsSufficient: false, Reason: "because"}, nil).Times(1)
+			validation := validations.New(hardware.ValidationMemory, models.ValidationResultCategoryMemory, false, "16GiB", "8GiB")
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
+				Return(&hardware.IsSufficientReply{IsSufficient: false, Reason: "because",
+					Validations: []*models.ValidationResult{validation}}, nil).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectedState = HostStatusInsufficient
 			expectedReply.postCheck = func() {
 				h := getHost(id, clusterId, db)
 				Expect(h.HardwareInfo).Should(Equal("some hw info"))
 				Expect(*h.StatusInfo).Should(Equal("because"))
+				var results models.ValidationResults
+				Expect(json.Unmarshal([]byte(h.ValidationsInfo), &results)).ShouldNot(HaveOccurred())
+				Expect(results).Should(Equal(models.ValidationResults{validation}))
 			}
 		})
 		It("hw_validation_error", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(nil, errors.New("error")).Times(1)
 			updateReply, updateErr = state.UpdateHwInfo(ctx, &host, "some hw info")
 			expectedReply.expectError = true
@@ -88,7 +101,7 @@ var _ = Describe("known_state", func() {
 
 	Context("update_role", func() {
 		It("sufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: true}, nil).Times(1)
 			updateReply, updateErr = state.UpdateRole(ctx, &host, "master", nil)
 			expectedReply.postCheck = func() {
@@ -97,7 +110,7 @@ var _ = Describe("known_state", func() {
 			}
 		})
 		It("insufficient_hw", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: false, Reason: "because"}, nil).Times(1)
 			updateReply, updateErr = state.UpdateRole(ctx, &host, "master", nil)
 			expectedReply.expectedState = HostStatusInsufficient
@@ -108,7 +121,7 @@ var _ = Describe("known_state", func() {
 			}
 		})
 		It("hw_validation_error", func() {
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(nil, errors.New("error")).Times(1)
 			updateReply, updateErr = state.UpdateRole(ctx, &host, "master", nil)
 			expectedReply.expectError = true
@@ -120,7 +133,7 @@ var _ = Describe("known_state", func() {
 		It("master_with_tx", func() {
 			tx := db.Begin()
 			Expect(tx.Error).ShouldNot(HaveOccurred())
-			mockValidator.EXPECT().IsSufficient(gomock.Any()).
+			mockValidator.EXPECT().IsSufficient(gomock.Any(), gomock.Any()).
 				Return(&hardware.IsSufficientReply{IsSufficient: true}, nil).Times(1)
 			updateReply, updateErr = state.UpdateRole(ctx, &host, "master", tx)
 			Expect(tx.Rollback().Error).ShouldNot(HaveOccurred())
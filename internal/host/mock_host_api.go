@@ -7,6 +7,7 @@ package host
 import (
 	context "context"
 	models "github.com/filanov/bm-inventory/models"
+	strfmt "github.com/go-openapi/strfmt"
 	gomock "github.com/golang/mock/gomock"
 	gorm "github.com/jinzhu/gorm"
 	reflect "reflect"
@@ -351,15 +352,113 @@ func (mr *MockAPIMockRecorder) UpdateInstallProgress(ctx, h, progress interface{
 }
 
 // SetBootstrap mocks base method.
-func (m *MockAPI) SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool) error {
+func (m *MockAPI) SetBootstrap(ctx context.Context, h *models.Host, isbootstrap bool, db *gorm.DB) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetBootstrap", ctx, h, isbootstrap)
+	ret := m.ctrl.Call(m, "SetBootstrap", ctx, h, isbootstrap, db)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetBootstrap indicates an expected call of SetBootstrap.
-func (mr *MockAPIMockRecorder) SetBootstrap(ctx, h, isbootstrap interface{}) *gomock.Call {
+func (mr *MockAPIMockRecorder) SetBootstrap(ctx, h, isbootstrap, db interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBootstrap", reflect.TypeOf((*MockAPI)(nil).SetBootstrap), ctx, h, isbootstrap)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBootstrap", reflect.TypeOf((*MockAPI)(nil).SetBootstrap), ctx, h, isbootstrap, db)
+}
+
+// UpdateConnectivityReport mocks base method.
+func (m *MockAPI) UpdateConnectivityReport(ctx context.Context, h *models.Host, connectivityReport *models.ConnectivityReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConnectivityReport", ctx, h, connectivityReport)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateConnectivityReport indicates an expected call of UpdateConnectivityReport.
+func (mr *MockAPIMockRecorder) UpdateConnectivityReport(ctx, h, connectivityReport interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConnectivityReport", reflect.TypeOf((*MockAPI)(nil).UpdateConnectivityReport), ctx, h, connectivityReport)
+}
+
+// UpdateInventory mocks base method.
+func (m *MockAPI) UpdateInventory(ctx context.Context, h *models.Host, inventory string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInventory", ctx, h, inventory)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateInventory indicates an expected call of UpdateInventory.
+func (mr *MockAPIMockRecorder) UpdateInventory(ctx, h, inventory interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInventory", reflect.TypeOf((*MockAPI)(nil).UpdateInventory), ctx, h, inventory)
+}
+
+// UpdateFreeAddresses mocks base method.
+func (m *MockAPI) UpdateFreeAddresses(ctx context.Context, h *models.Host, freeAddresses string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFreeAddresses", ctx, h, freeAddresses)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFreeAddresses indicates an expected call of UpdateFreeAddresses.
+func (mr *MockAPIMockRecorder) UpdateFreeAddresses(ctx, h, freeAddresses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFreeAddresses", reflect.TypeOf((*MockAPI)(nil).UpdateFreeAddresses), ctx, h, freeAddresses)
+}
+
+// SetInstallationDisk mocks base method.
+func (m *MockAPI) SetInstallationDisk(ctx context.Context, h *models.Host, diskID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetInstallationDisk", ctx, h, diskID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetInstallationDisk indicates an expected call of SetInstallationDisk.
+func (mr *MockAPIMockRecorder) SetInstallationDisk(ctx, h, diskID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetInstallationDisk", reflect.TypeOf((*MockAPI)(nil).SetInstallationDisk), ctx, h, diskID)
+}
+
+// AutoAssignRoles mocks base method.
+func (m *MockAPI) AutoAssignRoles(ctx context.Context, hosts []*models.Host, db *gorm.DB) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AutoAssignRoles", ctx, hosts, db)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AutoAssignRoles indicates an expected call of AutoAssignRoles.
+func (mr *MockAPIMockRecorder) AutoAssignRoles(ctx, hosts, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AutoAssignRoles", reflect.TypeOf((*MockAPI)(nil).AutoAssignRoles), ctx, hosts, db)
+}
+
+// SelectBootstrap mocks base method.
+func (m *MockAPI) SelectBootstrap(ctx context.Context, hosts []*models.Host, pinnedHostID strfmt.UUID, db *gorm.DB) (*models.Host, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SelectBootstrap", ctx, hosts, pinnedHostID, db)
+	ret0, _ := ret[0].(*models.Host)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SelectBootstrap indicates an expected call of SelectBootstrap.
+func (mr *MockAPIMockRecorder) SelectBootstrap(ctx, hosts, pinnedHostID, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SelectBootstrap", reflect.TypeOf((*MockAPI)(nil).SelectBootstrap), ctx, hosts, pinnedHostID, db)
+}
+
+// HostMonitoring mocks base method.
+func (m *MockAPI) HostMonitoring() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "HostMonitoring")
+}
+
+// HostMonitoring indicates an expected call of HostMonitoring.
+func (mr *MockAPIMockRecorder) HostMonitoring() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HostMonitoring", reflect.TypeOf((*MockAPI)(nil).HostMonitoring))
 }
@@ -0,0 +1,46 @@
+package host
+
+import (
+	"context"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+)
+
+const monitorBatchSize = 100
+
+// hosts in these states are expected to keep sending keepalive messages
+var monitorStates = []string{HostStatusDiscovering, HostStatusKnown, HostStatusInsufficient}
+
+// HostMonitoring refreshes the status of all the hosts in a monitored state, in batches.
+// Only the leader instance is running the monitoring to avoid duplicate state transitions.
+func (m *Manager) HostMonitoring() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	// hosts are scanned by their primary key rather than by offset, since refreshed hosts may leave the
+	// monitored states and shift the offsets of the rest
+	var last models.Host
+	for {
+		var hosts []*models.Host
+		query := m.db.Where("status in (?)", monitorStates)
+		if last.ID != nil {
+			query = query.Where("cluster_id > ? or (cluster_id = ? and id > ?)",
+				last.ClusterID, last.ClusterID, last.ID.String())
+		}
+		if err := query.Order("cluster_id, id").Limit(monitorBatchSize).Find(&hosts).Error; err != nil {
+			m.log.WithError(err).Errorf("failed to get hosts for monitoring")
+			return
+		}
+		for _, host := range hosts {
+			if _, err := m.RefreshStatus(ctx, host); err != nil {
+				m.log.WithError(err).Errorf("failed to refresh host %s state", host.ID)
+			}
+		}
+		if len(hosts) < monitorBatchSize {
+			return
+		}
+		last = *hosts[len(hosts)-1]
+	}
+}
@@ -0,0 +1,73 @@
+package host
+
+import (
+	"time"
+
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("monitor", func() {
+	var (
+		db         *gorm.DB
+		ctrl       *gomock.Controller
+		mockLeader *leader.MockElectorInterface
+		state      *Manager
+		clusterId  strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockLeader = leader.NewMockElectorInterface(ctrl)
+		state = NewManager(getTestLog(), db, nil, nil, mockLeader)
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	addHost := func(status string, checkedInAt time.Time) strfmt.UUID {
+		id := strfmt.UUID(uuid.New().String())
+		host := getTestHost(id, clusterId, status)
+		host.CheckedInAt = strfmt.DateTime(checkedInAt)
+		Expect(db.Create(&host).Error).ShouldNot(HaveOccurred())
+		return id
+	}
+
+	It("keepalive_timeout", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		var timedOut, alive []strfmt.UUID
+		// more hosts than a single batch
+		for i := 0; i < monitorBatchSize+10; i++ {
+			timedOut = append(timedOut, addHost(HostStatusKnown, time.Now().Add(-time.Hour)))
+		}
+		alive = append(alive, addHost(HostStatusInsufficient, time.Now()))
+		alive = append(alive, addHost(HostStatusDiscovering, time.Now()))
+		installing := addHost(HostStatusInstalling, time.Now().Add(-time.Hour))
+
+		state.HostMonitoring()
+
+		for _, id := range timedOut {
+			Expect(swag.StringValue(getHost(id, clusterId, db).Status)).Should(Equal(HostStatusDisconnected))
+		}
+		Expect(swag.StringValue(getHost(alive[0], clusterId, db).Status)).Should(Equal(HostStatusInsufficient))
+		Expect(swag.StringValue(getHost(alive[1], clusterId, db).Status)).Should(Equal(HostStatusDiscovering))
+		Expect(swag.StringValue(getHost(installing, clusterId, db).Status)).Should(Equal(HostStatusInstalling))
+	})
+
+	It("not_leader", func() {
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+		id := addHost(HostStatusKnown, time.Now().Add(-time.Hour))
+		state.HostMonitoring()
+		Expect(swag.StringValue(getHost(id, clusterId, db).Status)).Should(Equal(HostStatusKnown))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		db.Close()
+	})
+})
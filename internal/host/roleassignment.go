@@ -0,0 +1,162 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/alecthomas/units"
+	"github.com/filanov/bm-inventory/models"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// number of masters the cluster gets when the roles are automatically assigned
+const autoAssignMastersCount = 3
+
+// hostCapability is what the auto-assigned hosts are ranked by, in order of precedence
+type hostCapability struct {
+	host     *models.Host
+	cpuCores int64
+	ram      int64
+	disk     int64
+}
+
+func (c *hostCapability) String() string {
+	return fmt.Sprintf("%d CPU cores, %s RAM, %s disk", c.cpuCores, units.Base2Bytes(c.ram), units.MetricBytes(c.disk))
+}
+
+func (c *hostCapability) isMoreCapable(other *hostCapability) bool {
+	if c.cpuCores != other.cpuCores {
+		return c.cpuCores > other.cpuCores
+	}
+	if c.ram != other.ram {
+		return c.ram > other.ram
+	}
+	if c.disk != other.disk {
+		return c.disk > other.disk
+	}
+	// keep the order deterministic for identical hosts
+	return c.host.ID.String() < other.host.ID.String()
+}
+
+func (m *Manager) getHostCapability(h *models.Host, db *gorm.DB) (*hostCapability, error) {
+	var hwInfo models.Introspection
+	if err := json.Unmarshal([]byte(h.HardwareInfo), &hwInfo); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode hardware info of host %s", h.ID.String())
+	}
+	capability := &hostCapability{host: h}
+	if hwInfo.CPU != nil {
+		capability.cpuCores = hwInfo.CPU.Cpus
+	}
+	for _, memory := range hwInfo.Memory {
+		if memory != nil && memory.Name == "Mem" {
+			capability.ram = memory.Total
+		}
+	}
+	disks, err := getHostValidDisks(m.hwValidator, h, db)
+	if err != nil {
+		return nil, err
+	}
+	for _, disk := range disks {
+		if disk.Size > capability.disk {
+			capability.disk = disk.Size
+		}
+	}
+	return capability, nil
+}
+
+// isSufficientForRole validates the host hardware against the requirements of the given role
+func (m *Manager) isSufficientForRole(h *models.Host, role string, db *gorm.DB) (bool, string, error) {
+	candidate := *h
+	candidate.Role = role
+	reply, err := isSufficient(m.hwValidator, &candidate, db)
+	if err != nil {
+		return false, "", err
+	}
+	return reply.IsSufficient, reply.Reason, nil
+}
+
+// AutoAssignRoles gives the known hosts with the auto-assign role the master or worker role.
+// The most capable hosts, by CPU cores, RAM and disk size, become masters until the cluster has
+// autoAssignMastersCount masters, and the rest become workers. Every host is validated against the
+// requirements of the chosen role and the reason for the choice is stored in its role info.
+func (m *Manager) AutoAssignRoles(ctx context.Context, hosts []*models.Host, db *gorm.DB) error {
+	log := logutil.FromContext(ctx, m.log)
+	cdb := m.db
+	if db != nil {
+		cdb = db
+	}
+
+	mastersCount := 0
+	var candidates []*hostCapability
+	for _, h := range hosts {
+		if swag.StringValue(h.Status) != HostStatusKnown {
+			continue
+		}
+		switch h.Role {
+		case RoleMaster:
+			mastersCount++
+		case RoleAutoAssign:
+			capability, err := m.getHostCapability(h, cdb)
+			if err != nil {
+				return err
+			}
+			candidates = append(candidates, capability)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].isMoreCapable(candidates[j])
+	})
+
+	var insufficient []string
+	for i, candidate := range candidates {
+		h := candidate.host
+		rank := fmt.Sprintf("ranked %d of %d auto-assigned hosts (%s)", i+1, len(candidates), candidate)
+		role := RoleWorker
+		var info string
+		if mastersCount < autoAssignMastersCount {
+			ok, reason, err := m.isSufficientForRole(h, RoleMaster, cdb)
+			if err != nil {
+				return err
+			}
+			if ok {
+				role = RoleMaster
+				mastersCount++
+				info = fmt.Sprintf("master: %s, selected as master %d of %d", rank, mastersCount, autoAssignMastersCount)
+			} else {
+				info = fmt.Sprintf("worker: %s, not selected as master because %s", rank, reason)
+			}
+		} else {
+			info = fmt.Sprintf("worker: %s, the cluster already has %d masters", rank, autoAssignMastersCount)
+		}
+
+		h.Role = role
+		reply, err := updateByValidation(ctx, log, m.hwValidator, h, cdb, "role", role, "role_info", info)
+		if err != nil {
+			return err
+		}
+		h.Status = swag.String(reply.State)
+		h.RoleInfo = info
+		if reply.State != HostStatusKnown {
+			insufficient = append(insufficient, fmt.Sprintf("host %s can't be a %s", h.ID.String(), role))
+		}
+		log.Infof("host %s role was automatically assigned to %s", h.ID.String(), info)
+	}
+
+	if mastersCount < autoAssignMastersCount {
+		return errors.Errorf("only %d hosts are sufficient to be masters, %d are required",
+			mastersCount, autoAssignMastersCount)
+	}
+	if len(insufficient) > 0 {
+		return errors.Errorf("failed to assign roles: %s", strings.Join(insufficient, ", "))
+	}
+	return nil
+}
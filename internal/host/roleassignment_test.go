@@ -0,0 +1,135 @@
+package host
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/alecthomas/units"
+	"github.com/filanov/bm-inventory/internal/hardware"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AutoAssignRoles", func() {
+	var (
+		ctx       = context.Background()
+		db        *gorm.DB
+		state     API
+		clusterId strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		var cfg hardware.ValidatorCfg
+		Expect(envconfig.Process("myapp", &cfg)).ShouldNot(HaveOccurred())
+		state = NewManager(getTestLog(), db, hardware.NewValidator(cfg), nil, nil)
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	addHost := func(role, status string, cpus, ramGib, diskGb int64) *models.Host {
+		id := strfmt.UUID(uuid.New().String())
+		h := getTestHost(id, clusterId, status)
+		h.Role = role
+		hwInfo, err := json.Marshal(&models.Introspection{
+			CPU:          &models.CPUDetails{Cpus: cpus},
+			Memory:       []*models.MemoryDetails{{Name: "Mem", Total: ramGib * int64(units.GiB)}},
+			BlockDevices: []*models.BlockDevice{{DeviceType: "disk", Name: "sda", Size: diskGb * int64(units.GB)}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		h.HardwareInfo = string(hwInfo)
+		Expect(db.Create(&h).Error).ShouldNot(HaveOccurred())
+		return &h
+	}
+
+	expectRole := func(h *models.Host, role string) {
+		dbHost := getHost(*h.ID, clusterId, db)
+		Expect(dbHost.Role).Should(Equal(role))
+		Expect(dbHost.RoleInfo).Should(HavePrefix(role + ":"))
+		Expect(swag.StringValue(dbHost.Status)).Should(Equal(HostStatusKnown))
+		Expect(h.Role).Should(Equal(role))
+	}
+
+	It("most_capable_hosts_become_masters", func() {
+		hosts := []*models.Host{
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 4, 16, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 16, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 500),
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 16, 500),
+		}
+		Expect(state.AutoAssignRoles(ctx, hosts, nil)).ShouldNot(HaveOccurred())
+		expectRole(hosts[0], RoleMaster)
+		expectRole(hosts[1], RoleWorker)
+		expectRole(hosts[2], RoleMaster)
+		expectRole(hosts[3], RoleMaster)
+		expectRole(hosts[4], RoleWorker)
+		Expect(getHost(*hosts[2].ID, clusterId, db).RoleInfo).Should(ContainSubstring("ranked 1 of 5"))
+	})
+
+	It("explicit_roles_are_kept", func() {
+		master := addHost(RoleMaster, HostStatusKnown, 4, 16, 200)
+		worker := addHost(RoleWorker, HostStatusKnown, 32, 64, 500)
+		hosts := []*models.Host{
+			master,
+			worker,
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 4, 16, 200),
+		}
+		Expect(state.AutoAssignRoles(ctx, hosts, nil)).ShouldNot(HaveOccurred())
+		Expect(getHost(*master.ID, clusterId, db).Role).Should(Equal(RoleMaster))
+		Expect(getHost(*worker.ID, clusterId, db).Role).Should(Equal(RoleWorker))
+		expectRole(hosts[2], RoleMaster)
+		expectRole(hosts[3], RoleMaster)
+		expectRole(hosts[4], RoleWorker)
+	})
+
+	It("hosts_insufficient_for_master_become_workers", func() {
+		hosts := []*models.Host{
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 16, 8, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 4, 16, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 4, 16, 200),
+		}
+		Expect(state.AutoAssignRoles(ctx, hosts, nil)).ShouldNot(HaveOccurred())
+		expectRole(hosts[0], RoleMaster)
+		expectRole(hosts[1], RoleWorker)
+		expectRole(hosts[2], RoleMaster)
+		expectRole(hosts[3], RoleMaster)
+		Expect(getHost(*hosts[1].ID, clusterId, db).RoleInfo).Should(ContainSubstring("not selected as master"))
+	})
+
+	It("not_enough_masters", func() {
+		hosts := []*models.Host{
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 2, 8, 200),
+		}
+		Expect(state.AutoAssignRoles(ctx, hosts, nil)).Should(HaveOccurred())
+	})
+
+	It("hosts_not_known_are_ignored", func() {
+		disabled := addHost(RoleAutoAssign, HostStatusDisabled, 32, 64, 500)
+		hosts := []*models.Host{
+			disabled,
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+			addHost(RoleAutoAssign, HostStatusKnown, 8, 32, 200),
+		}
+		Expect(state.AutoAssignRoles(ctx, hosts, nil)).ShouldNot(HaveOccurred())
+		Expect(getHost(*disabled.ID, clusterId, db).Role).Should(Equal(RoleAutoAssign))
+		for _, h := range hosts[1:] {
+			expectRole(h, RoleMaster)
+		}
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
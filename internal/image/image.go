@@ -0,0 +1,259 @@
+package image
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/job"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/filanov/bm-inventory/pkg/objectstore"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StatusQueued   = "queued"
+	StatusBuilding = "building"
+	StatusReady    = "ready"
+	StatusFailed   = "failed"
+)
+
+// the namespace the image generation jobs are created in
+const jobNamespace = "default"
+
+// the image is stored before its generation job is created, so a job that is not found
+// shortly after the image was created may still be created
+const jobCreationGracePeriod = time.Minute
+
+// images in these states are waiting for their generation job to finish
+var monitorStates = []string{StatusQueued, StatusBuilding}
+
+// ErrImageInProgress is returned when deleting an image that is being generated,
+// since its generation job will store it later on
+var ErrImageInProgress = errors.New("image is being generated")
+
+type API interface {
+	// Refresh the status of all the images that are being generated, should be called periodically
+	ImageMonitoring()
+}
+
+type Manager struct {
+	log           logrus.FieldLogger
+	db            *gorm.DB
+	job           job.API
+	objectStore   objectstore.API
+	leaderElector leader.ElectorInterface
+}
+
+func NewManager(log logrus.FieldLogger, db *gorm.DB, jobApi job.API, objectStore objectstore.API,
+	leaderElector leader.ElectorInterface) *Manager {
+	return &Manager{
+		log:           log,
+		db:            db,
+		job:           jobApi,
+		objectStore:   objectStore,
+		leaderElector: leaderElector,
+	}
+}
+
+// ObjectNamePrefix is the prefix of the names of all the image objects
+const ObjectNamePrefix = "discovery-image-"
+
+const checksumObjectSuffix = ".sha256"
+
+// the checksum object holds a hex checksum, optionally followed by the image file name
+const maxChecksumObjectSize = 1024
+
+// ObjectName returns the name of the object the image is stored as
+func ObjectName(clusterID, imageID strfmt.UUID) string {
+	return fmt.Sprintf("%s%s-%s", ObjectNamePrefix, clusterID.String(), imageID)
+}
+
+// ChecksumObjectName returns the name of the object the generation job stores the image SHA256 checksum as, in hex
+func ChecksumObjectName(clusterID, imageID strfmt.UUID) string {
+	return ObjectName(clusterID, imageID) + checksumObjectSuffix
+}
+
+// ObjectNames returns the names of all the objects stored for the image
+func ObjectNames(clusterID, imageID strfmt.UUID) []string {
+	return []string{ObjectName(clusterID, imageID), ChecksumObjectName(clusterID, imageID)}
+}
+
+// JobName returns the name of the k8s job that generates the image
+func JobName(clusterID, imageID strfmt.UUID) string {
+	// max job name is 63 chars
+	return fmt.Sprintf("create-image-%s-%s", clusterID, imageID)[:63]
+}
+
+func (m *Manager) ImageMonitoring() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	var images []*models.Image
+	if err := m.db.Where("status in (?)", monitorStates).Find(&images).Error; err != nil {
+		m.log.WithError(err).Errorf("failed to get images for monitoring")
+		return
+	}
+	for _, img := range images {
+		if err := m.refreshStatus(ctx, img); err != nil {
+			m.log.WithError(err).Errorf("failed to refresh image %s status", img.ID)
+		}
+	}
+
+	// the metadata is kept empty until it is read successfully, so it is retried on the next iteration
+	var readyImages []*models.Image
+	if err := m.db.Where("status = ? and size_bytes = ?", StatusReady, 0).Find(&readyImages).Error; err != nil {
+		m.log.WithError(err).Errorf("failed to get ready images for monitoring")
+		return
+	}
+	for _, img := range readyImages {
+		if err := m.updateMetadata(ctx, img); err != nil {
+			m.log.WithError(err).Errorf("failed to update image %s metadata", img.ID)
+		}
+	}
+}
+
+// updateMetadata stores the size and the checksum of a generated image. The checksum is the one stored by the
+// generation job, the image itself is not read. Images whose generation job didn't store it have no checksum.
+func (m *Manager) updateMetadata(ctx context.Context, img *models.Image) error {
+	log := logutil.FromContext(ctx, m.log)
+	info, err := m.objectStore.GetObjectInfo(ctx, ObjectName(*img.ClusterID, *img.ID))
+	if err != nil {
+		return err
+	}
+	checksum, err := m.readChecksum(ctx, img)
+	if err != nil {
+		return err
+	}
+	if err := m.db.Model(&models.Image{}).Where("id = ?", img.ID.String()).
+		Updates(map[string]interface{}{"size_bytes": info.Size, "checksum": checksum}).Error; err != nil {
+		return errors.Wrapf(err, "failed to update image %s metadata", img.ID)
+	}
+	log.Infof("image %s of cluster %s size is %d bytes, sha256 checksum <%s>", img.ID, img.ClusterID, info.Size, checksum)
+	return nil
+}
+
+// readChecksum returns the checksum stored by the generation job of the image, empty if it wasn't stored
+func (m *Manager) readChecksum(ctx context.Context, img *models.Image) (string, error) {
+	objectName := ChecksumObjectName(*img.ClusterID, *img.ID)
+	reader, _, err := m.objectStore.Download(ctx, objectName)
+	if err != nil {
+		if errors.Cause(err) == objectstore.ErrObjectNotFound {
+			return "", nil
+		}
+		return "", err
+	}
+	defer reader.Close()
+	// the object may hold the output of sha256sum, the checksum followed by the file name
+	content, err := ioutil.ReadAll(io.LimitReader(reader, maxChecksumObjectSize))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to read object %s", objectName)
+	}
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return "", errors.Errorf("object %s is empty", objectName)
+	}
+	checksum := strings.ToLower(fields[0])
+	if _, err := hex.DecodeString(checksum); err != nil || len(checksum) != sha256.Size*2 {
+		return "", errors.Errorf("object %s doesn't hold a sha256 checksum", objectName)
+	}
+	return checksum, nil
+}
+
+// refreshStatus updates the image status according to the state of its generation job
+func (m *Manager) refreshStatus(ctx context.Context, img *models.Image) error {
+	log := logutil.FromContext(ctx, m.log)
+	state, reason, err := m.job.Status(ctx, JobName(*img.ClusterID, *img.ID), jobNamespace)
+	if err != nil {
+		return err
+	}
+	var status string
+	switch state {
+	case job.StatePending:
+		status = StatusQueued
+	case job.StateRunning:
+		status = StatusBuilding
+	case job.StateSucceeded:
+		status = StatusReady
+		exists, err := m.objectStore.DoesObjectExist(ctx, ObjectName(*img.ClusterID, *img.ID))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			status = StatusFailed
+			reason = "image generation job finished without storing the image"
+		}
+	case job.StateFailed:
+		status = StatusFailed
+	case job.StateNotFound:
+		if time.Since(time.Time(img.CreatedAt)) < jobCreationGracePeriod {
+			return nil
+		}
+		status = StatusFailed
+		reason = "image generation job not found"
+	default:
+		return errors.Errorf("unknown job state %s", state)
+	}
+	if status == swag.StringValue(img.Status) {
+		return nil
+	}
+	if err := updateStatus(log, img, status, reason, m.db); err != nil {
+		return err
+	}
+	// a succeeded job is deleted only once its result is recorded, so that a failure to record it is retried
+	if state == job.StateSucceeded {
+		if err := m.job.Delete(ctx, JobName(*img.ClusterID, *img.ID), jobNamespace); err != nil {
+			log.WithError(err).Errorf("failed to delete the generation job of image %s", img.ID)
+		}
+	}
+	return nil
+}
+
+func updateStatus(log logrus.FieldLogger, img *models.Image, status, statusInfo string, db *gorm.DB) error {
+	dbReply := db.Model(&models.Image{}).Where("id = ? and status = ?", img.ID.String(), swag.StringValue(img.Status)).
+		Updates(map[string]interface{}{"status": status, "status_info": statusInfo})
+	if dbReply.Error != nil {
+		return errors.Wrapf(dbReply.Error, "failed to update image %s status from %s to %s",
+			img.ID, swag.StringValue(img.Status), status)
+	}
+	if dbReply.RowsAffected == 0 {
+		return errors.Errorf("failed to update image %s status from %s to %s, nothing have changed",
+			img.ID, swag.StringValue(img.Status), status)
+	}
+	log.Infof("updated image %s of cluster %s status from <%s> to <%s> %s",
+		img.ID, img.ClusterID, swag.StringValue(img.Status), status, statusInfo)
+	return nil
+}
+
+// Delete deletes the image record together with the image object
+func Delete(ctx context.Context, log logrus.FieldLogger, img *models.Image, db *gorm.DB, objectStore objectstore.API) error {
+	log = logutil.FromContext(ctx, log)
+	status := swag.StringValue(img.Status)
+	if status == StatusQueued || status == StatusBuilding {
+		return errors.Wrapf(ErrImageInProgress, "image %s status is %s", img.ID, status)
+	}
+	// the objects are deleted first so a failure leaves the image listed and the deletion can be retried
+	for _, objectName := range ObjectNames(*img.ClusterID, *img.ID) {
+		if err := objectStore.DeleteObject(ctx, objectName); err != nil {
+			return err
+		}
+	}
+	if err := db.Delete(&models.Image{}, "id = ?", img.ID.String()).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete image %s", img.ID)
+	}
+	log.Infof("deleted image %s of cluster %s", img.ID, img.ClusterID)
+	return nil
+}
@@ -0,0 +1,188 @@
+package image
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/job"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/filanov/bm-inventory/pkg/objectstore"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestImage(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "image tests")
+}
+
+func prepareDB() *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	Expect(err).ShouldNot(HaveOccurred())
+	db.AutoMigrate(&models.Image{})
+	return db
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+var _ = Describe("image_monitoring", func() {
+	var (
+		db         *gorm.DB
+		ctrl       *gomock.Controller
+		mockJob    *job.MockAPI
+		mockStore  *objectstore.MockAPI
+		mockLeader *leader.MockElectorInterface
+		manager    *Manager
+		clusterId  strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockJob = job.NewMockAPI(ctrl)
+		mockStore = objectstore.NewMockAPI(ctrl)
+		mockLeader = leader.NewMockElectorInterface(ctrl)
+		manager = NewManager(getTestLog(), db, mockJob, mockStore, mockLeader)
+		clusterId = strfmt.UUID(uuid.New().String())
+	})
+
+	addImage := func(status string) *models.Image {
+		id := strfmt.UUID(uuid.New().String())
+		img := &models.Image{ID: &id, ClusterID: &clusterId, Status: swag.String(status)}
+		Expect(db.Create(img).Error).ShouldNot(HaveOccurred())
+		return img
+	}
+
+	getImage := func(img *models.Image) *models.Image {
+		var dbImage models.Image
+		Expect(db.First(&dbImage, "id = ?", img.ID.String()).Error).ShouldNot(HaveOccurred())
+		return &dbImage
+	}
+
+	expectJobStatus := func(img *models.Image, state job.State, reason string, err error) {
+		mockJob.EXPECT().Status(gomock.Any(), JobName(clusterId, *img.ID), jobNamespace).
+			Return(state, reason, err).Times(1)
+	}
+
+	It("not_leader", func() {
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+		addImage(StatusQueued)
+		manager.ImageMonitoring()
+	})
+
+	It("job_states", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		pending := addImage(StatusQueued)
+		expectJobStatus(pending, job.StatePending, "", nil)
+		running := addImage(StatusQueued)
+		expectJobStatus(running, job.StateRunning, "", nil)
+		succeeded := addImage(StatusBuilding)
+		expectJobStatus(succeeded, job.StateSucceeded, "", nil)
+		mockStore.EXPECT().DoesObjectExist(gomock.Any(), ObjectName(clusterId, *succeeded.ID)).Return(true, nil).Times(1)
+		notStored := addImage(StatusBuilding)
+		expectJobStatus(notStored, job.StateSucceeded, "", nil)
+		mockStore.EXPECT().DoesObjectExist(gomock.Any(), ObjectName(clusterId, *notStored.ID)).Return(false, nil).Times(1)
+		failed := addImage(StatusBuilding)
+		expectJobStatus(failed, job.StateFailed, "job failed", nil)
+		missing := addImage(StatusBuilding)
+		Expect(db.Model(missing).Update("created_at", strfmt.DateTime(time.Now().Add(-time.Hour))).Error).
+			ShouldNot(HaveOccurred())
+		expectJobStatus(missing, job.StateNotFound, "", nil)
+		// images are created right before their jobs
+		justCreated := addImage(StatusQueued)
+		expectJobStatus(justCreated, job.StateNotFound, "", nil)
+		unreachable := addImage(StatusBuilding)
+		expectJobStatus(unreachable, "", "", fmt.Errorf("k8s api error"))
+		for _, img := range []*models.Image{succeeded, notStored} {
+			mockJob.EXPECT().Delete(gomock.Any(), JobName(clusterId, *img.ID), jobNamespace).Return(nil).Times(1)
+		}
+		ready := addImage(StatusReady)
+		for _, img := range []*models.Image{succeeded, ready} {
+			mockStore.EXPECT().GetObjectInfo(gomock.Any(), ObjectName(clusterId, *img.ID)).
+				Return(nil, objectstore.ErrObjectNotFound).Times(1)
+		}
+
+		manager.ImageMonitoring()
+
+		Expect(swag.StringValue(getImage(pending).Status)).Should(Equal(StatusQueued))
+		Expect(swag.StringValue(getImage(running).Status)).Should(Equal(StatusBuilding))
+		Expect(swag.StringValue(getImage(succeeded).Status)).Should(Equal(StatusReady))
+		Expect(swag.StringValue(getImage(notStored).Status)).Should(Equal(StatusFailed))
+		Expect(getImage(notStored).StatusInfo).Should(Equal("image generation job finished without storing the image"))
+		Expect(swag.StringValue(getImage(failed).Status)).Should(Equal(StatusFailed))
+		Expect(getImage(failed).StatusInfo).Should(Equal("job failed"))
+		Expect(swag.StringValue(getImage(missing).Status)).Should(Equal(StatusFailed))
+		Expect(getImage(missing).StatusInfo).Should(Equal("image generation job not found"))
+		Expect(swag.StringValue(getImage(justCreated).Status)).Should(Equal(StatusQueued))
+		Expect(swag.StringValue(getImage(unreachable).Status)).Should(Equal(StatusBuilding))
+		Expect(swag.StringValue(getImage(ready).Status)).Should(Equal(StatusReady))
+	})
+
+	It("ready_image_metadata", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(3)
+		img := addImage(StatusReady)
+		checksum := fmt.Sprintf("%x", sha256.Sum256([]byte("discovery image content")))
+		mockStore.EXPECT().GetObjectInfo(gomock.Any(), ObjectName(clusterId, *img.ID)).
+			Return(nil, fmt.Errorf("s3 error")).Times(1)
+		manager.ImageMonitoring()
+		Expect(getImage(img).SizeBytes).Should(BeZero())
+
+		// the image itself is not downloaded, only the checksum stored by its generation job
+		mockStore.EXPECT().GetObjectInfo(gomock.Any(), ObjectName(clusterId, *img.ID)).
+			Return(&objectstore.ObjectInfo{Size: 1024}, nil).Times(1)
+		mockStore.EXPECT().Download(gomock.Any(), ChecksumObjectName(clusterId, *img.ID)).
+			Return(ioutil.NopCloser(strings.NewReader(strings.ToUpper(checksum)+"  discovery.iso\n")), int64(0), nil).
+			Times(1)
+		manager.ImageMonitoring()
+		Expect(getImage(img).SizeBytes).Should(Equal(int64(1024)))
+		Expect(getImage(img).Checksum).Should(Equal(checksum))
+
+		// images with metadata are not read again
+		manager.ImageMonitoring()
+	})
+
+	It("checksum_not_stored", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(2)
+		img := addImage(StatusReady)
+		mockStore.EXPECT().GetObjectInfo(gomock.Any(), ObjectName(clusterId, *img.ID)).
+			Return(&objectstore.ObjectInfo{Size: 1024}, nil).Times(1)
+		mockStore.EXPECT().Download(gomock.Any(), ChecksumObjectName(clusterId, *img.ID)).
+			Return(nil, int64(0), objectstore.ErrObjectNotFound).Times(1)
+		manager.ImageMonitoring()
+		Expect(getImage(img).SizeBytes).Should(Equal(int64(1024)))
+		Expect(getImage(img).Checksum).Should(BeEmpty())
+		manager.ImageMonitoring()
+	})
+
+	It("invalid_checksum", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		img := addImage(StatusReady)
+		mockStore.EXPECT().GetObjectInfo(gomock.Any(), ObjectName(clusterId, *img.ID)).
+			Return(&objectstore.ObjectInfo{Size: 1024}, nil).Times(1)
+		mockStore.EXPECT().Download(gomock.Any(), ChecksumObjectName(clusterId, *img.ID)).
+			Return(ioutil.NopCloser(strings.NewReader("not a checksum")), int64(0), nil).Times(1)
+		manager.ImageMonitoring()
+		Expect(getImage(img).SizeBytes).Should(BeZero())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		db.Close()
+	})
+})
@@ -90,8 +90,8 @@ func GetInstallConfig(cluster *models.Cluster) ([]byte, error) {
 		Platform: struct {
 			None struct{} `yaml:"none"`
 		}{},
-		PullSecret: cluster.PullSecret,
-		SSHKey:     cluster.SSHPublicKey,
+		PullSecret: string(cluster.PullSecret),
+		SSHKey:     string(cluster.SSHPublicKey),
 	}
 
 	return yaml.Marshal(cfg)
@@ -0,0 +1,106 @@
+package keyrotation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/filanov/bm-inventory/pkg/leader"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/filanov/bm-inventory/pkg/secret"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+type Config struct {
+	BatchSize int `envconfig:"KEY_ROTATION_BATCH_SIZE" default:"100"`
+}
+
+type column struct {
+	table string
+	name  string
+}
+
+// secretColumns are the columns of the secret.String fields, the tables are expected to have an id column
+var secretColumns = []column{
+	{table: "clusters", name: "pull_secret"},
+	{table: "clusters", name: "ssh_public_key"},
+	{table: "images", name: "ssh_public_key"},
+	{table: "webhooks", name: "secret"},
+}
+
+// Manager encrypts the stored secrets with the current encryption key, the secrets that were encrypted with older
+// keys and the secrets that were stored before the encryption was configured
+type Manager struct {
+	log           logrus.FieldLogger
+	cfg           Config
+	db            *gorm.DB
+	leaderElector leader.ElectorInterface
+}
+
+func NewManager(log logrus.FieldLogger, cfg Config, db *gorm.DB, leaderElector leader.ElectorInterface) *Manager {
+	return &Manager{
+		log:           log,
+		cfg:           cfg,
+		db:            db,
+		leaderElector: leaderElector,
+	}
+}
+
+// RotateKeys should be called periodically, once the secrets are rotated the older keys can be removed
+func (m *Manager) RotateKeys() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := logutil.FromContext(ctx, m.log)
+	prefix := secret.CurrentPrefix()
+	if prefix == "" {
+		return
+	}
+	for _, c := range secretColumns {
+		rotated, err := m.rotate(c, prefix)
+		if err != nil {
+			log.WithError(err).Errorf("failed to rotate the secrets of %s.%s", c.table, c.name)
+		}
+		if rotated > 0 {
+			log.Infof("encrypted %d secrets of %s.%s with the current key", rotated, c.table, c.name)
+		}
+	}
+}
+
+// rotate encrypts the secrets of the column that are not encrypted with the current key, it returns their number
+func (m *Manager) rotate(c column, prefix string) (int, error) {
+	rotated := 0
+	for {
+		var rows []struct {
+			ID    string
+			Value string
+		}
+		if err := m.db.Table(c.table).Select(fmt.Sprintf("id, %s as value", c.name)).
+			Where(fmt.Sprintf("%s <> '' and %s not like ?", c.name, c.name), prefix+"%").
+			Limit(m.cfg.BatchSize).Scan(&rows).Error; err != nil {
+			return rotated, errors.Wrapf(err, "failed to get the secrets to rotate")
+		}
+		if len(rows) == 0 {
+			return rotated, nil
+		}
+		for _, row := range rows {
+			plain, err := secret.Decrypt(row.Value)
+			if err != nil {
+				return rotated, errors.Wrapf(err, "failed to decrypt the secret of %s", row.ID)
+			}
+			encrypted, err := secret.Encrypt(plain)
+			if err != nil {
+				return rotated, errors.Wrapf(err, "failed to encrypt the secret of %s", row.ID)
+			}
+			// the secrets that were changed since they were read are already encrypted with the current key
+			if err := m.db.Table(c.table).Where(fmt.Sprintf("id = ? and %s = ?", c.name), row.ID, row.Value).
+				UpdateColumn(c.name, encrypted).Error; err != nil {
+				return rotated, errors.Wrapf(err, "failed to update the secret of %s", row.ID)
+			}
+			rotated++
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package keyrotation
+
+import (
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	"github.com/filanov/bm-inventory/internal/webhooks"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/filanov/bm-inventory/pkg/secret"
+	"github.com/go-openapi/strfmt"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestKeyRotation(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "key rotation tests")
+}
+
+const (
+	key1 = "key1:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	key2 = "key2:ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+)
+
+func prepareDB() *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	Expect(err).ShouldNot(HaveOccurred())
+	db.AutoMigrate(&models.Cluster{}, &models.Image{}, &webhooks.Subscription{})
+	return db
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+var _ = Describe("RotateKeys", func() {
+	var (
+		db         *gorm.DB
+		ctrl       *gomock.Controller
+		mockLeader *leader.MockElectorInterface
+		manager    *Manager
+		clusterID  strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockLeader = leader.NewMockElectorInterface(ctrl)
+		manager = NewManager(getTestLog(), Config{BatchSize: 2}, db, mockLeader)
+		clusterID = strfmt.UUID(uuid.New().String())
+		Expect(db.Exec("insert into clusters (id, pull_secret, ssh_public_key) values (?, ?, ?)",
+			clusterID, "pull-secret", "").Error).ShouldNot(HaveOccurred())
+		for i := 0; i < 3; i++ {
+			Expect(db.Exec("insert into webhooks (id, secret) values (?, ?)", uuid.New().String(), "webhook-secret").
+				Error).ShouldNot(HaveOccurred())
+		}
+	})
+
+	stored := func(table, column string) []string {
+		var values []string
+		Expect(db.Table(table).Pluck(column, &values).Error).ShouldNot(HaveOccurred())
+		return values
+	}
+
+	expectRotated := func() {
+		for _, value := range append(stored("clusters", "pull_secret"), stored("webhooks", "secret")...) {
+			Expect(value).Should(HavePrefix(secret.CurrentPrefix()))
+		}
+		Expect(stored("clusters", "ssh_public_key")).Should(Equal([]string{""}))
+
+		var c models.Cluster
+		Expect(db.First(&c, "id = ?", clusterID).Error).ShouldNot(HaveOccurred())
+		Expect(string(c.PullSecret)).Should(Equal("pull-secret"))
+		var subscriptions []*webhooks.Subscription
+		Expect(db.Find(&subscriptions).Error).ShouldNot(HaveOccurred())
+		Expect(subscriptions).Should(HaveLen(3))
+		for _, s := range subscriptions {
+			Expect(string(s.Secret)).Should(Equal("webhook-secret"))
+		}
+	}
+
+	It("not_leader", func() {
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+		manager.RotateKeys()
+		Expect(stored("clusters", "pull_secret")).Should(Equal([]string{"pull-secret"}))
+	})
+
+	It("rotate", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(2)
+		// the secrets that were stored before the encryption was configured are encrypted
+		Expect(secret.Init(secret.Config{Keys: []string{key1}})).ShouldNot(HaveOccurred())
+		manager.RotateKeys()
+		expectRotated()
+
+		// the secrets that were encrypted with an older key are encrypted with the current key
+		Expect(secret.Init(secret.Config{Keys: []string{key2, key1}})).ShouldNot(HaveOccurred())
+		manager.RotateKeys()
+		expectRotated()
+		Expect(secret.Init(secret.Config{Keys: []string{key2}})).ShouldNot(HaveOccurred())
+		expectRotated()
+	})
+
+	It("unknown_key", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		Expect(secret.Init(secret.Config{Keys: []string{key1}})).ShouldNot(HaveOccurred())
+		encrypted, err := secret.Encrypt("other-secret")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.Exec("insert into clusters (id, pull_secret) values (?, ?)", uuid.New().String(), encrypted).
+			Error).ShouldNot(HaveOccurred())
+		Expect(secret.Init(secret.Config{Keys: []string{key2}})).ShouldNot(HaveOccurred())
+		// the secrets that can't be decrypted are kept, the rotation of the column stops
+		manager.RotateKeys()
+		Expect(stored("clusters", "pull_secret")).Should(ContainElement(encrypted))
+		for _, value := range stored("webhooks", "secret") {
+			Expect(value).Should(HavePrefix(secret.CurrentPrefix()))
+		}
+	})
+
+	It("all_secrets_are_rotated", func() {
+		for _, model := range []interface{}{&models.Cluster{}, &models.Image{}, &webhooks.Subscription{}} {
+			scope := db.NewScope(model)
+			for _, field := range scope.GetModelStruct().StructFields {
+				if field.Struct.Type != reflect.TypeOf(secret.String("")) {
+					continue
+				}
+				Expect(secretColumns).Should(ContainElement(column{table: scope.TableName(), name: field.DBName}))
+			}
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		db.Close()
+	})
+})
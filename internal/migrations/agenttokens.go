@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// createAgentTokens creates the table of the per cluster agent tokens
+func createAgentTokens(tx *gorm.DB) error {
+	type agentToken struct {
+		ClusterID string `gorm:"primary_key"`
+		ImageID   string
+		Digest    string
+	}
+	if err := tx.Table("agent_tokens").AutoMigrate(&agentToken{}).Error; err != nil {
+		return err
+	}
+	return addTimeColumns(tx, "agent_tokens", timeColumn{name: "created_at"})
+}
+
+// keyAgentTokensByImage replaces the per cluster agent tokens by per image tokens, so that the tokens of the previous
+// images of a cluster can stay valid when a new image is generated. The table is recreated since its primary key
+// changes, the new table is renamed only after the old one is dropped so that their constraint names don't collide.
+func keyAgentTokensByImage(tx *gorm.DB) error {
+	type agentToken struct {
+		ImageID   string `gorm:"primary_key"`
+		ClusterID string `gorm:"index"`
+		Digest    string
+	}
+	const newTable = "agent_tokens_by_image"
+	dialect := tx.Dialect()
+	if err := tx.Table(newTable).AutoMigrate(&agentToken{}).Error; err != nil {
+		return err
+	}
+	if err := addTimeColumns(tx, newTable, timeColumn{name: "created_at"}, timeColumn{name: "expires_at"}); err != nil {
+		return err
+	}
+	if err := tx.Exec(fmt.Sprintf("INSERT INTO %s (image_id, cluster_id, digest, created_at) "+
+		"SELECT image_id, cluster_id, digest, created_at FROM %s",
+		dialect.Quote(newTable), dialect.Quote("agent_tokens"))).Error; err != nil {
+		return errors.Wrapf(err, "failed to copy the agent tokens")
+	}
+	if err := tx.DropTable("agent_tokens").Error; err != nil {
+		return err
+	}
+	return tx.Exec(fmt.Sprintf("ALTER TABLE %s RENAME TO %s", dialect.Quote(newTable),
+		dialect.Quote("agent_tokens"))).Error
+}
@@ -0,0 +1,82 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// baseline creates the schema that was created by AutoMigrate before the versioned migrations were introduced,
+// DBs that were created by AutoMigrate already have it, so it only creates the missing tables and columns
+func baseline(tx *gorm.DB) error {
+	type host struct {
+		ID               string `gorm:"primary_key"`
+		ClusterID        string `gorm:"primary_key"`
+		Kind             *string
+		Href             *string
+		Status           *string
+		StatusInfo       *string
+		ValidationsInfo  string `gorm:"type:text"`
+		Connectivity     string `gorm:"type:text"`
+		HardwareInfo     string `gorm:"type:text"`
+		Inventory        string `gorm:"type:text"`
+		Role             string
+		RoleInfo         string
+		Bootstrap        bool
+		InstallationDisk string
+	}
+	type cluster struct {
+		ID                       string `gorm:"primary_key"`
+		Kind                     *string
+		Href                     *string
+		Name                     string
+		OpenshiftVersion         string
+		BaseDNSDomain            string
+		ClusterNetworkCidr       string
+		ClusterNetworkHostPrefix int64
+		ServiceNetworkCidr       string
+		APIVip                   string
+		DNSVip                   string
+		IngressVip               string
+		PullSecret               string `gorm:"type:varchar(4096)"`
+		SSHPublicKey             string `gorm:"type:varchar(1024)"`
+		HardwareProfile          string
+		BootstrapHostID          string
+		BootstrapInfo            string
+		Status                   *string
+		StatusInfo               *string
+		ValidationsInfo          string `gorm:"type:text"`
+	}
+	type image struct {
+		ID           string  `gorm:"primary_key"`
+		ClusterID    *string `gorm:"index"`
+		Kind         *string
+		Href         *string
+		Status       *string
+		StatusInfo   string
+		ParamsDigest string
+		ProxyURL     string
+		SSHPublicKey string `gorm:"type:text"`
+		SizeBytes    int64
+		Checksum     string
+	}
+	createdAt := timeColumn{name: "created_at"}
+	updatedAt := timeColumn{name: "updated_at"}
+
+	for _, table := range []struct {
+		name        string
+		model       interface{}
+		timeColumns []timeColumn
+	}{
+		{"hosts", &host{}, []timeColumn{{name: "checked_in_at"}, createdAt, updatedAt}},
+		{"clusters", &cluster{}, []timeColumn{{name: "install_started_at", zeroDefault: true},
+			{name: "install_completed_at", zeroDefault: true}, createdAt, updatedAt}},
+		{"images", &image{}, []timeColumn{{name: "expires_at"}, createdAt, updatedAt}},
+	} {
+		if err := tx.Table(table.name).AutoMigrate(table.model).Error; err != nil {
+			return err
+		}
+		if err := addTimeColumns(tx, table.name, table.timeColumns...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
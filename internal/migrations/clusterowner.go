@@ -0,0 +1,15 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// addClusterOwner adds the user that registered each cluster and the organization of the user,
+// clusters registered before have no owner and are accessed only by admins
+func addClusterOwner(tx *gorm.DB) error {
+	type cluster struct {
+		Owner        string `gorm:"index"`
+		Organization string `gorm:"index"`
+	}
+	return tx.Table("clusters").AutoMigrate(&cluster{}).Error
+}
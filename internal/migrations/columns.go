@@ -0,0 +1,50 @@
+package migrations
+
+import (
+	"fmt"
+
+	"github.com/filanov/bm-inventory/pkg/database"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+)
+
+// timeColumn is a time column of a table. The time columns are added by addTimeColumns instead of AutoMigrate,
+// since their SQL type depends on the dialect.
+type timeColumn struct {
+	name string
+	// columns with a zero default are read as the zero time, instead of NULL, when they are not set
+	zeroDefault bool
+}
+
+// timeColumnType returns the SQL type of a time column: datetime in MySQL, with the MySQL zero date as the zero
+// default, and timestamp with time zone in PostgreSQL, that doesn't have the datetime type, with the Go zero time
+// as the zero default, which is read the same as the MySQL zero date.
+func timeColumnType(dialect string, column timeColumn) string {
+	switch dialect {
+	case database.DialectPostgres:
+		if column.zeroDefault {
+			return "timestamp with time zone DEFAULT '0001-01-01 00:00:00+00'"
+		}
+		return "timestamp with time zone"
+	default:
+		if column.zeroDefault {
+			return "datetime DEFAULT 0"
+		}
+		return "datetime"
+	}
+}
+
+// addTimeColumns adds the time columns that the table doesn't have yet
+func addTimeColumns(tx *gorm.DB, table string, columns ...timeColumn) error {
+	dialect := tx.Dialect()
+	for _, column := range columns {
+		if dialect.HasColumn(table, column.name) {
+			continue
+		}
+		if err := tx.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", dialect.Quote(table),
+			dialect.Quote(column.name), timeColumnType(dialect.GetName(), column))).Error; err != nil {
+			return errors.Wrapf(err, "failed to add column %s to table %s", column.name, table)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// createEvents creates the table of the cluster and host state transitions
+func createEvents(tx *gorm.DB) error {
+	type event struct {
+		ID         int64  `gorm:"primary_key"`
+		ClusterID  string `gorm:"index"`
+		HostID     string
+		FromStatus string
+		ToStatus   string
+		StatusInfo string `gorm:"type:text"`
+		RequestID  string
+	}
+	if err := tx.Table("events").AutoMigrate(&event{}).Error; err != nil {
+		return err
+	}
+	return addTimeColumns(tx, "events", timeColumn{name: "event_time"})
+}
+
+// addEventChange adds the changes of the clusters and hosts that are recorded as events without a state transition
+func addEventChange(tx *gorm.DB) error {
+	type event struct {
+		Change string
+	}
+	return tx.Table("events").AutoMigrate(&event{}).Error
+}
@@ -0,0 +1,16 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// addHostFreeAddresses adds the free addresses of the machine networks reported by the hosts
+func addHostFreeAddresses(tx *gorm.DB) error {
+	type host struct {
+		FreeAddresses string `gorm:"type:text"`
+	}
+	if err := tx.Table("hosts").AutoMigrate(&host{}).Error; err != nil {
+		return err
+	}
+	return addTimeColumns(tx, "hosts", timeColumn{name: "free_addresses_updated_at"})
+}
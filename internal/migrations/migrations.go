@@ -0,0 +1,150 @@
+package migrations
+
+import (
+	"time"
+
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// the name of the lease that serializes the migrations of service replicas that start together
+const lockName = "schema-migrations"
+
+var lockPollInterval = time.Second
+
+type Config struct {
+	LockTimeout time.Duration `envconfig:"MIGRATION_LOCK_TIMEOUT" default:"10m"`
+}
+
+// Migration is a single schema change. Applied migrations must never be changed, schema changes are made
+// by appending new migrations, and the models must not be used by the migrations since they keep changing.
+type Migration struct {
+	Version     int
+	Description string
+	Up          func(tx *gorm.DB) error
+}
+
+// migrations are applied in order, the versions must be increasing
+var migrations = []Migration{
+	{Version: 1, Description: "baseline schema", Up: baseline},
+	{Version: 2, Description: "create events table", Up: createEvents},
+	{Version: 3, Description: "create webhooks tables", Up: createWebhooks},
+	{Version: 4, Description: "create agent tokens table", Up: createAgentTokens},
+	{Version: 5, Description: "add cluster owner and organization", Up: addClusterOwner},
+	{Version: 6, Description: "alter the secret columns to hold encrypted secrets", Up: alterSecretColumns},
+	{Version: 7, Description: "add host free addresses", Up: addHostFreeAddresses},
+	{Version: 8, Description: "add event change", Up: addEventChange},
+	{Version: 9, Description: "key the agent tokens by image", Up: keyAgentTokensByImage},
+}
+
+// schemaMigration records an applied migration
+type schemaMigration struct {
+	Version     int
+	Description string
+	AppliedAt   time.Time
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrate applies the migrations that were not applied to the DB yet, while holding a lock so that a single
+// service replica migrates the DB at a time. It fails when the DB schema is newer than the service.
+func Migrate(log logrus.FieldLogger, db *gorm.DB, cfg Config, leaderCfg leader.Config) error {
+	return migrate(log, db, cfg, leaderCfg, migrations)
+}
+
+func migrate(log logrus.FieldLogger, db *gorm.DB, cfg Config, leaderCfg leader.Config, migrations []Migration) error {
+	if err := createMigrationTables(db); err != nil {
+		return errors.Wrapf(err, "failed to create the migrations tables")
+	}
+
+	lock := leader.NewElector(log, db, leaderCfg, lockName)
+	lock.Start()
+	defer lock.Stop()
+	deadline := time.Now().Add(cfg.LockTimeout)
+	for !lock.IsLeader() {
+		if time.Now().After(deadline) {
+			return errors.Errorf("timed out waiting for lock %s after %s", lockName, cfg.LockTimeout)
+		}
+		time.Sleep(lockPollInterval)
+	}
+
+	version, err := currentVersion(db)
+	if err != nil {
+		return err
+	}
+	latest := migrations[len(migrations)-1].Version
+	if version > latest {
+		return errors.Errorf("DB schema version %d is newer than the latest version %d known to the service",
+			version, latest)
+	}
+	for _, m := range migrations {
+		if m.Version <= version {
+			continue
+		}
+		if err := apply(db, m); err != nil {
+			return err
+		}
+		log.Infof("applied DB migration %d: %s", m.Version, m.Description)
+	}
+	log.Infof("DB schema version is %d", latest)
+	return nil
+}
+
+// createMigrationTables creates the tables of the versions and of the lock,
+// which can't be created by the migrations since they run under the lock
+func createMigrationTables(db *gorm.DB) error {
+	type schemaMigration struct {
+		Version     int    `gorm:"primary_key;auto_increment:false"`
+		Description string `gorm:"type:varchar(255)"`
+	}
+	type lease struct {
+		Name   string `gorm:"primary_key"`
+		Holder string `gorm:"type:varchar(255)"`
+	}
+	if err := db.Table("schema_migrations").AutoMigrate(&schemaMigration{}).Error; err != nil {
+		return err
+	}
+	if err := addTimeColumns(db, "schema_migrations", timeColumn{name: "applied_at"}); err != nil {
+		return err
+	}
+	if err := db.Table("leases").AutoMigrate(&lease{}).Error; err != nil {
+		return err
+	}
+	return addTimeColumns(db, "leases", timeColumn{name: "renewed_at"})
+}
+
+func currentVersion(db *gorm.DB) (int, error) {
+	var applied []schemaMigration
+	if err := db.Order("version desc").Limit(1).Find(&applied).Error; err != nil {
+		return 0, errors.Wrapf(err, "failed to get the DB schema version")
+	}
+	if len(applied) == 0 {
+		return 0, nil
+	}
+	return applied[0].Version, nil
+}
+
+// apply runs the migration and records it in a single transaction. Note that MySQL commits DDL statements
+// implicitly, so a failed migration must be safe to rerun.
+func apply(db *gorm.DB, m Migration) error {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return errors.Wrapf(tx.Error, "failed to start transaction of migration %d", m.Version)
+	}
+	if err := m.Up(tx); err != nil {
+		tx.Rollback()
+		return errors.Wrapf(err, "failed to apply migration %d: %s", m.Version, m.Description)
+	}
+	if err := tx.Create(&schemaMigration{Version: m.Version, Description: m.Description, AppliedAt: time.Now()}).Error; err != nil {
+		tx.Rollback()
+		return errors.Wrapf(err, "failed to record migration %d", m.Version)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return errors.Wrapf(err, "failed to commit migration %d", m.Version)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package migrations
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/agenttoken"
+	"github.com/filanov/bm-inventory/internal/webhooks"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/database"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func TestMigrations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "migrations tests")
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+var _ = Describe("Migrate", func() {
+	var (
+		db        *gorm.DB
+		cfg       = Config{LockTimeout: time.Minute}
+		leaderCfg = leader.Config{LeaseDuration: time.Hour, RenewInterval: time.Hour}
+	)
+
+	BeforeEach(func() {
+		var err error
+		db, err = gorm.Open("sqlite3", ":memory:")
+		Expect(err).ShouldNot(HaveOccurred())
+		// every connection opens a different in memory DB
+		db.DB().SetMaxOpenConns(1)
+		lockPollInterval = 10 * time.Millisecond
+	})
+
+	appliedVersions := func() []int {
+		var applied []schemaMigration
+		Expect(db.Order("version").Find(&applied).Error).ShouldNot(HaveOccurred())
+		versions := []int{}
+		for _, m := range applied {
+			versions = append(versions, m.Version)
+		}
+		return versions
+	}
+
+	allVersions := func() []int {
+		versions := []int{}
+		for _, m := range migrations {
+			versions = append(versions, m.Version)
+		}
+		return versions
+	}
+
+	expectModelColumns := func() {
+		for _, model := range []interface{}{&models.Host{}, &models.Cluster{}, &models.Image{}, &models.Event{},
+			&webhooks.Subscription{}, &models.WebhookDelivery{}, &agenttoken.Token{}, &leader.Lease{}} {
+			scope := db.NewScope(model)
+			for _, field := range scope.GetModelStruct().StructFields {
+				if !field.IsNormal || field.IsIgnored {
+					continue
+				}
+				Expect(scope.Dialect().HasColumn(scope.TableName(), field.DBName)).Should(BeTrue(),
+					"column %s of table %s is not created by the migrations", field.DBName, scope.TableName())
+			}
+		}
+	}
+
+	It("versions_are_increasing", func() {
+		for i := 1; i < len(migrations); i++ {
+			Expect(migrations[i].Version).Should(BeNumerically(">", migrations[i-1].Version))
+		}
+	})
+
+	It("empty_db", func() {
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).ShouldNot(HaveOccurred())
+		Expect(appliedVersions()).Should(Equal(allVersions()))
+		expectModelColumns()
+	})
+
+	It("already_migrated", func() {
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).ShouldNot(HaveOccurred())
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).ShouldNot(HaveOccurred())
+		Expect(appliedVersions()).Should(Equal(allVersions()))
+	})
+
+	It("db_created_by_auto_migrate", func() {
+		Expect(db.AutoMigrate(&models.Host{}, &models.Cluster{}, &models.Image{}, &leader.Lease{}).Error).
+			ShouldNot(HaveOccurred())
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).ShouldNot(HaveOccurred())
+		Expect(appliedVersions()).Should(Equal(allVersions()))
+	})
+
+	It("newer_schema", func() {
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).ShouldNot(HaveOccurred())
+		latest := migrations[len(migrations)-1].Version
+		Expect(db.Create(&schemaMigration{Version: latest + 1, AppliedAt: time.Now()}).Error).ShouldNot(HaveOccurred())
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).Should(HaveOccurred())
+	})
+
+	It("ordered_migrations", func() {
+		var applied []int
+		migration := func(version int) Migration {
+			return Migration{Version: version, Up: func(tx *gorm.DB) error {
+				applied = append(applied, version)
+				return nil
+			}}
+		}
+		Expect(migrate(getTestLog(), db, cfg, leaderCfg, []Migration{migration(1)})).ShouldNot(HaveOccurred())
+		Expect(migrate(getTestLog(), db, cfg, leaderCfg, []Migration{migration(1), migration(2), migration(3)})).
+			ShouldNot(HaveOccurred())
+		Expect(applied).Should(Equal([]int{1, 2, 3}))
+		Expect(appliedVersions()).Should(Equal([]int{1, 2, 3}))
+	})
+
+	It("failed_migration", func() {
+		failing := []Migration{
+			{Version: 1, Up: func(tx *gorm.DB) error { return nil }},
+			{Version: 2, Up: func(tx *gorm.DB) error {
+				Expect(tx.Exec("CREATE TABLE partial (id integer)").Error).ShouldNot(HaveOccurred())
+				return errors.Errorf("failed")
+			}},
+		}
+		Expect(migrate(getTestLog(), db, cfg, leaderCfg, failing)).Should(HaveOccurred())
+		Expect(appliedVersions()).Should(Equal([]int{1}))
+		Expect(db.HasTable("partial")).Should(BeFalse())
+	})
+
+	It("agent_tokens_keyed_by_image", func() {
+		Expect(migrate(getTestLog(), db, cfg, leaderCfg, migrations[:8])).ShouldNot(HaveOccurred())
+		Expect(db.Exec("INSERT INTO agent_tokens (cluster_id, image_id, digest, created_at) VALUES (?, ?, ?, ?)",
+			"cluster1", "image1", "digest1", time.Now()).Error).ShouldNot(HaveOccurred())
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).ShouldNot(HaveOccurred())
+		Expect(db.HasTable("agent_tokens_by_image")).Should(BeFalse())
+		var token agenttoken.Token
+		Expect(db.First(&token, "image_id = ?", "image1").Error).ShouldNot(HaveOccurred())
+		Expect(token.ClusterID.String()).Should(Equal("cluster1"))
+		Expect(token.Digest).Should(Equal("digest1"))
+		Expect(token.ExpiresAt).Should(BeNil())
+		expectModelColumns()
+	})
+
+	It("lock_held_by_another_replica", func() {
+		Expect(db.AutoMigrate(&leader.Lease{}).Error).ShouldNot(HaveOccurred())
+		Expect(db.Create(&leader.Lease{Name: lockName, Holder: "other", RenewedAt: time.Now()}).Error).
+			ShouldNot(HaveOccurred())
+		err := Migrate(getTestLog(), db, Config{LockTimeout: 100 * time.Millisecond}, leaderCfg)
+		Expect(err).Should(HaveOccurred())
+		Expect(appliedVersions()).Should(BeEmpty())
+	})
+
+	It("lock_is_released", func() {
+		Expect(Migrate(getTestLog(), db, cfg, leaderCfg)).ShouldNot(HaveOccurred())
+		var lease leader.Lease
+		Expect(db.First(&lease, "name = ?", lockName).Error).ShouldNot(HaveOccurred())
+		Expect(lease.RenewedAt.Before(time.Now().Add(-leaderCfg.LeaseDuration / 2))).Should(BeTrue())
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
+
+var _ = Describe("time_columns", func() {
+	It("dialect_types", func() {
+		created := timeColumn{name: "created_at"}
+		started := timeColumn{name: "started_at", zeroDefault: true}
+		Expect(timeColumnType(database.DialectMySQL, created)).Should(Equal("datetime"))
+		Expect(timeColumnType(database.DialectMySQL, started)).Should(Equal("datetime DEFAULT 0"))
+		Expect(timeColumnType(database.DialectPostgres, created)).Should(Equal("timestamp with time zone"))
+		Expect(timeColumnType(database.DialectPostgres, started)).Should(Equal(
+			"timestamp with time zone DEFAULT '0001-01-01 00:00:00+00'"))
+	})
+
+	It("add_missing_columns", func() {
+		db, err := gorm.Open("sqlite3", ":memory:")
+		Expect(err).ShouldNot(HaveOccurred())
+		defer db.Close()
+		Expect(db.Exec("CREATE TABLE things (id integer, created_at datetime)").Error).ShouldNot(HaveOccurred())
+		Expect(addTimeColumns(db, "things", timeColumn{name: "created_at"},
+			timeColumn{name: "started_at", zeroDefault: true})).ShouldNot(HaveOccurred())
+		Expect(db.Dialect().HasColumn("things", "started_at")).Should(BeTrue())
+		// the columns are added once
+		Expect(addTimeColumns(db, "things", timeColumn{name: "started_at"})).ShouldNot(HaveOccurred())
+	})
+})
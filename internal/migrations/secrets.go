@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// alterSecretColumns changes the columns of the secrets to text, since the encrypted secrets are longer than
+// the secrets. The stored secrets are encrypted by the key rotation after the migration.
+func alterSecretColumns(tx *gorm.DB) error {
+	// sqlite doesn't limit the length of varchar columns, nor supports altering them
+	if tx.Dialect().GetName() == "sqlite3" {
+		return nil
+	}
+	for table, columns := range map[string][]string{
+		"clusters": {"pull_secret", "ssh_public_key"},
+		"webhooks": {"secret"},
+	} {
+		for _, column := range columns {
+			if err := tx.Table(table).ModifyColumn(column, "text").Error; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
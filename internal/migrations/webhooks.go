@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"github.com/jinzhu/gorm"
+)
+
+// createWebhooks creates the tables of the webhook subscriptions and of their deliveries
+func createWebhooks(tx *gorm.DB) error {
+	type webhook struct {
+		ID         string `gorm:"primary_key"`
+		URL        string `gorm:"type:text"`
+		Secret     string
+		ClusterID  string `gorm:"index"`
+		EventTypes string
+	}
+	type webhookDelivery struct {
+		ID           int64  `gorm:"primary_key"`
+		WebhookID    string `gorm:"index"`
+		EventID      int64
+		EventType    string
+		Payload      string `gorm:"type:text"`
+		Status       string
+		Attempts     int64
+		ResponseCode int64
+		LastError    string `gorm:"type:text"`
+	}
+	if err := tx.Table("webhooks").AutoMigrate(&webhook{}).Error; err != nil {
+		return err
+	}
+	if err := addTimeColumns(tx, "webhooks", timeColumn{name: "created_at"}); err != nil {
+		return err
+	}
+	if err := tx.Table("webhook_deliveries").AutoMigrate(&webhookDelivery{}).Error; err != nil {
+		return err
+	}
+	return addTimeColumns(tx, "webhook_deliveries", timeColumn{name: "created_at"},
+		timeColumn{name: "last_attempt_at", zeroDefault: true}, timeColumn{name: "next_attempt_at", zeroDefault: true})
+}
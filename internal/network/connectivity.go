@@ -0,0 +1,36 @@
+package network
+
+import (
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// ReachableHosts returns the IDs of the hosts that h reached, according to its last connectivity report.
+// A host without a report is treated as not reaching any other host.
+func ReachableHosts(h *models.Host) map[strfmt.UUID]bool {
+	reachable := make(map[strfmt.UUID]bool)
+	if h.Connectivity == nil {
+		return reachable
+	}
+	for _, remote := range h.Connectivity.RemoteHosts {
+		if remote != nil && IsRemoteHostReachable(remote) {
+			reachable[remote.HostID] = true
+		}
+	}
+	return reachable
+}
+
+// IsRemoteHostReachable returns true if any of the L2 or L3 checks of the remote host succeeded
+func IsRemoteHostReachable(remote *models.ConnectivityRemoteHost) bool {
+	for _, l2 := range remote.L2Connectivity {
+		if l2 != nil && l2.Successful {
+			return true
+		}
+	}
+	for _, l3 := range remote.L3Connectivity {
+		if l3 != nil && l3.Successful {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package network
+
+import (
+	"bytes"
+	"encoding/json"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+)
+
+// MachineNetworks returns the IPv4 subnets that all the hosts with hardware info are connected to
+func MachineNetworks(hosts []*models.Host) []*net.IPNet {
+	var shared map[string]*net.IPNet
+	for _, h := range hosts {
+		var hwInfo models.Introspection
+		if err := json.Unmarshal([]byte(h.HardwareInfo), &hwInfo); err != nil {
+			continue
+		}
+		hostNetworks := make(map[string]*net.IPNet)
+		for _, subnet := range nicNetworks(&hwInfo) {
+			if shared == nil || shared[subnet.String()] != nil {
+				hostNetworks[subnet.String()] = subnet
+			}
+		}
+		shared = hostNetworks
+	}
+	return sortedNetworks(shared)
+}
+
+// MachineNetworksStrings returns the machine networks of the hosts in the format of the cluster resource
+func MachineNetworksStrings(hosts []*models.Host) []string {
+	return toStrings(MachineNetworks(hosts))
+}
+
+// FreeAddresses returns up to limit free addresses of each of the networks. The free addresses of a network are
+// taken from the latest scan of the network reported by the hosts, without the addresses of the hosts NICs and
+// the used addresses, such as the VIPs that are already set. Networks that were not scanned have no free addresses.
+func FreeAddresses(networks []*net.IPNet, hosts []*models.Host, used []string, limit int) models.FreeNetworksAddresses {
+	taken := hostAddresses(hosts)
+	for _, address := range used {
+		taken[address] = true
+	}
+	// the latest scan of each network
+	scans := make(map[string]*models.FreeNetworkAddresses)
+	scannedAt := make(map[string]time.Time)
+	for _, h := range hosts {
+		var reported models.FreeNetworksAddresses
+		if err := json.Unmarshal([]byte(h.FreeAddresses), &reported); err != nil {
+			continue
+		}
+		for _, scan := range reported {
+			if scan == nil {
+				continue
+			}
+			_, subnet, err := net.ParseCIDR(scan.Network)
+			if err != nil {
+				continue
+			}
+			updatedAt := time.Time(h.FreeAddressesUpdatedAt)
+			if previous, ok := scannedAt[subnet.String()]; !ok || updatedAt.After(previous) {
+				scans[subnet.String()] = scan
+				scannedAt[subnet.String()] = updatedAt
+			}
+		}
+	}
+
+	ret := make(models.FreeNetworksAddresses, 0, len(networks))
+	for _, subnet := range networks {
+		networkAddresses := &models.FreeNetworkAddresses{Network: subnet.String(), FreeAddresses: []strfmt.IPv4{}}
+		var free []net.IP
+		if scan, ok := scans[subnet.String()]; ok {
+			for _, address := range scan.FreeAddresses {
+				ip := net.ParseIP(address.String()).To4()
+				if ip == nil || !subnet.Contains(ip) || taken[ip.String()] || isReserved(ip, subnet) {
+					continue
+				}
+				// addresses reported twice are returned once
+				taken[ip.String()] = true
+				free = append(free, ip)
+			}
+		}
+		sort.Slice(free, func(i, j int) bool { return bytes.Compare(free[i], free[j]) < 0 })
+		if len(free) > limit {
+			free = free[:limit]
+		}
+		for _, ip := range free {
+			networkAddresses.FreeAddresses = append(networkAddresses.FreeAddresses, strfmt.IPv4(ip.String()))
+		}
+		ret = append(ret, networkAddresses)
+	}
+	return ret
+}
+
+// isReserved returns true for the network and broadcast addresses of the subnet, which can't be assigned
+func isReserved(ip net.IP, subnet *net.IPNet) bool {
+	ones, bits := subnet.Mask.Size()
+	if bits-ones < 2 {
+		return false
+	}
+	broadcast := make(net.IP, len(subnet.IP))
+	for i := range subnet.IP {
+		broadcast[i] = subnet.IP[i] | ^subnet.Mask[i]
+	}
+	return ip.Equal(subnet.IP) || ip.Equal(broadcast)
+}
@@ -0,0 +1,79 @@
+package network
+
+import (
+	"encoding/json"
+	"net"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func withFreeAddresses(h *models.Host, updatedAt time.Time, scans ...*models.FreeNetworkAddresses) *models.Host {
+	b, err := json.Marshal(models.FreeNetworksAddresses(scans))
+	Expect(err).ShouldNot(HaveOccurred())
+	h.FreeAddresses = string(b)
+	h.FreeAddressesUpdatedAt = strfmt.DateTime(updatedAt)
+	return h
+}
+
+var _ = Describe("MachineNetworks", func() {
+	It("shared_by_all_hosts", func() {
+		hosts := []*models.Host{
+			getTestHost(&models.Cidr{IPAddress: "192.168.126.10", Mask: 24},
+				&models.Cidr{IPAddress: "10.0.0.10", Mask: 16}, &models.Cidr{IPAddress: "172.16.0.10", Mask: 24}),
+			{},
+			getTestHost(&models.Cidr{IPAddress: "10.0.1.11", Mask: 16},
+				&models.Cidr{IPAddress: "192.168.126.11", Mask: 24}),
+		}
+		Expect(MachineNetworksStrings(hosts)).Should(Equal([]string{"10.0.0.0/16", "192.168.126.0/24"}))
+		Expect(MachineNetworksStrings(hosts[:1])).Should(Equal(
+			[]string{"10.0.0.0/16", "172.16.0.0/24", "192.168.126.0/24"}))
+
+		hosts = append(hosts, getTestHost(&models.Cidr{IPAddress: "192.168.127.12", Mask: 24}))
+		Expect(MachineNetworksStrings(hosts)).Should(BeEmpty())
+		Expect(MachineNetworksStrings(nil)).Should(BeEmpty())
+	})
+})
+
+var _ = Describe("FreeAddresses", func() {
+	var (
+		networks []*net.IPNet
+		hosts    []*models.Host
+	)
+
+	BeforeEach(func() {
+		_, subnet, err := net.ParseCIDR("192.168.126.0/24")
+		Expect(err).ShouldNot(HaveOccurred())
+		_, other, err := net.ParseCIDR("10.0.0.0/16")
+		Expect(err).ShouldNot(HaveOccurred())
+		networks = []*net.IPNet{other, subnet}
+		now := time.Now()
+		hosts = []*models.Host{
+			withFreeAddresses(getTestHost(&models.Cidr{IPAddress: "192.168.126.10", Mask: 24}), now.Add(-time.Hour),
+				&models.FreeNetworkAddresses{Network: "192.168.126.0/24",
+					FreeAddresses: []strfmt.IPv4{"192.168.126.200", "192.168.126.201"}}),
+			withFreeAddresses(getTestHost(&models.Cidr{IPAddress: "192.168.126.11", Mask: 24}), now,
+				&models.FreeNetworkAddresses{Network: "192.168.126.0/24", FreeAddresses: []strfmt.IPv4{
+					"192.168.126.255", "192.168.126.100", "192.168.126.11", "192.168.126.20", "192.168.126.3",
+					"192.168.126.100", "192.168.127.5", "192.168.126.0", "192.168.126.50"}}),
+		}
+	})
+
+	It("latest_scan", func() {
+		Expect(FreeAddresses(networks, hosts, []string{"192.168.126.50", ""}, 8)).Should(Equal(
+			models.FreeNetworksAddresses{
+				{Network: "10.0.0.0/16", FreeAddresses: []strfmt.IPv4{}},
+				{Network: "192.168.126.0/24", FreeAddresses: []strfmt.IPv4{
+					"192.168.126.3", "192.168.126.20", "192.168.126.100"}},
+			}))
+	})
+
+	It("limit", func() {
+		free := FreeAddresses(networks[1:], hosts, nil, 2)
+		Expect(free).Should(HaveLen(1))
+		Expect(free[0].FreeAddresses).Should(Equal([]strfmt.IPv4{"192.168.126.3", "192.168.126.20"}))
+	})
+})
@@ -0,0 +1,236 @@
+package network
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+)
+
+// the validated fields, named as in the API
+const (
+	FieldClusterNetworkCidr       = "cluster_network_cidr"
+	FieldClusterNetworkHostPrefix = "cluster_network_host_prefix"
+	FieldServiceNetworkCidr       = "service_network_cidr"
+	FieldAPIVip                   = "api_vip"
+	FieldIngressVip               = "ingress_vip"
+	FieldDNSVip                   = "dns_vip"
+)
+
+// ValidateCluster returns the errors of the network fields of the cluster. The fields that are not set are not
+// validated. The VIPs are checked to be assignable addresses of the machine networks, that all the hosts are
+// connected to, only when some of the hosts reported their hardware info, so the VIPs of a cluster without hosts
+// can be set before the hosts boot.
+func ValidateCluster(c *models.Cluster, hosts []*models.Host) []*models.FieldError {
+	var fieldErrors []*models.FieldError
+	addError := func(field, format string, args ...interface{}) {
+		fieldErrors = append(fieldErrors, &models.FieldError{
+			Field:  swag.String(field),
+			Reason: swag.String(fmt.Sprintf(format, args...)),
+		})
+	}
+
+	clusterNetwork, err := parseCIDR(c.ClusterNetworkCidr)
+	if err != nil {
+		addError(FieldClusterNetworkCidr, "%s", err)
+	}
+	serviceNetwork, err := parseCIDR(c.ServiceNetworkCidr)
+	if err != nil {
+		addError(FieldServiceNetworkCidr, "%s", err)
+	}
+	hostNetworks, reported := HostNetworks(hosts)
+	machineNetworks := MachineNetworks(hosts)
+	hostAddresses := hostAddresses(hosts)
+
+	if clusterNetwork != nil && c.ClusterNetworkHostPrefix != 0 {
+		ones, _ := clusterNetwork.Mask.Size()
+		if c.ClusterNetworkHostPrefix < int64(ones) || c.ClusterNetworkHostPrefix > 32 {
+			addError(FieldClusterNetworkHostPrefix, "host prefix %d does not fit in the cluster network %s, "+
+				"it has to be between %d and 32", c.ClusterNetworkHostPrefix, clusterNetwork, ones)
+		}
+	}
+	if clusterNetwork != nil && serviceNetwork != nil && overlap(clusterNetwork, serviceNetwork) {
+		addError(FieldServiceNetworkCidr, "service network %s overlaps with the cluster network %s",
+			serviceNetwork, clusterNetwork)
+	}
+	for _, hostNetwork := range hostNetworks {
+		if clusterNetwork != nil && overlap(clusterNetwork, hostNetwork) {
+			addError(FieldClusterNetworkCidr, "cluster network %s overlaps with the hosts machine network %s",
+				clusterNetwork, hostNetwork)
+		}
+		if serviceNetwork != nil && overlap(serviceNetwork, hostNetwork) {
+			addError(FieldServiceNetworkCidr, "service network %s overlaps with the hosts machine network %s",
+				serviceNetwork, hostNetwork)
+		}
+	}
+
+	vips := []struct {
+		field string
+		value string
+	}{
+		{FieldAPIVip, c.APIVip.String()},
+		{FieldIngressVip, c.IngressVip.String()},
+		{FieldDNSVip, c.DNSVip.String()},
+	}
+	used := make(map[string]string)
+	for _, vip := range vips {
+		if vip.value == "" {
+			continue
+		}
+		ip := net.ParseIP(vip.value).To4()
+		if ip == nil {
+			addError(vip.field, "%s is not a valid IPv4 address", vip.value)
+			continue
+		}
+		if other, ok := used[ip.String()]; ok {
+			addError(vip.field, "%s is already used by %s", ip, other)
+			continue
+		}
+		used[ip.String()] = vip.field
+		if !reported {
+			continue
+		}
+		subnet := containing(ip, machineNetworks)
+		switch {
+		case len(machineNetworks) == 0:
+			addError(vip.field, "%s cannot be validated, the hosts are not connected to a shared IPv4 machine network", ip)
+		case subnet == nil:
+			addError(vip.field, "%s is not inside any of the hosts machine networks: %s",
+				ip, strings.Join(toStrings(machineNetworks), ", "))
+		case hostAddresses[ip.String()]:
+			addError(vip.field, "%s is already used by a host", ip)
+		case isReserved(ip, subnet):
+			addError(vip.field, "%s is the network or broadcast address of the machine network %s", ip, subnet)
+		}
+	}
+	return fieldErrors
+}
+
+// HostNetworks returns the IPv4 subnets of the NICs of the hosts, and whether any of the hosts reported its
+// hardware info. Hosts without hardware info are skipped.
+func HostNetworks(hosts []*models.Host) ([]*net.IPNet, bool) {
+	var reported bool
+	networks := make(map[string]*net.IPNet)
+	for _, h := range hosts {
+		var hwInfo models.Introspection
+		if err := json.Unmarshal([]byte(h.HardwareInfo), &hwInfo); err != nil {
+			continue
+		}
+		reported = true
+		for _, subnet := range nicNetworks(&hwInfo) {
+			networks[subnet.String()] = subnet
+		}
+	}
+	return sortedNetworks(networks), reported
+}
+
+// hostAddresses returns the IPv4 addresses of the NICs of the hosts, hosts without hardware info are skipped
+func hostAddresses(hosts []*models.Host) map[string]bool {
+	addresses := make(map[string]bool)
+	for _, h := range hosts {
+		var hwInfo models.Introspection
+		if err := json.Unmarshal([]byte(h.HardwareInfo), &hwInfo); err != nil {
+			continue
+		}
+		for _, nic := range hwInfo.Nics {
+			if nic == nil {
+				continue
+			}
+			for _, cidr := range nic.Cidrs {
+				if cidr == nil {
+					continue
+				}
+				if ip := net.ParseIP(cidr.IPAddress).To4(); ip != nil {
+					addresses[ip.String()] = true
+				}
+			}
+		}
+	}
+	return addresses
+}
+
+// nicNetworks returns the IPv4 subnets of the NICs of a host
+func nicNetworks(hwInfo *models.Introspection) []*net.IPNet {
+	var networks []*net.IPNet
+	for _, nic := range hwInfo.Nics {
+		if nic == nil {
+			continue
+		}
+		for _, cidr := range nic.Cidrs {
+			if cidr == nil {
+				continue
+			}
+			ip := net.ParseIP(cidr.IPAddress).To4()
+			if ip == nil || cidr.Mask <= 0 || cidr.Mask > 32 {
+				continue
+			}
+			mask := net.CIDRMask(int(cidr.Mask), 32)
+			networks = append(networks, &net.IPNet{IP: ip.Mask(mask), Mask: mask})
+		}
+	}
+	return networks
+}
+
+func sortedNetworks(networks map[string]*net.IPNet) []*net.IPNet {
+	keys := make([]string, 0, len(networks))
+	for key := range networks {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sorted := make([]*net.IPNet, 0, len(keys))
+	for _, key := range keys {
+		sorted = append(sorted, networks[key])
+	}
+	return sorted
+}
+
+// parseCIDR returns nil for an empty value, and an error for values that are not IPv4 networks
+func parseCIDR(value string) (*net.IPNet, error) {
+	if value == "" {
+		return nil, nil
+	}
+	ip, subnet, err := net.ParseCIDR(value)
+	if err != nil || ip.To4() == nil {
+		return nil, errors.Errorf("%s is not a valid IPv4 CIDR", value)
+	}
+	if !ip.Equal(subnet.IP) {
+		return nil, errors.Errorf("%s has host bits set, the network address is %s", value, subnet)
+	}
+	return subnet, nil
+}
+
+func overlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// containing returns the first of the networks that contains the IP, or nil when none does
+func containing(ip net.IP, networks []*net.IPNet) *net.IPNet {
+	for _, subnet := range networks {
+		if subnet.Contains(ip) {
+			return subnet
+		}
+	}
+	return nil
+}
+
+func toStrings(networks []*net.IPNet) []string {
+	ret := make([]string, 0, len(networks))
+	for _, subnet := range networks {
+		ret = append(ret, subnet.String())
+	}
+	return ret
+}
+
+// ToString returns the field errors as a single line
+func ToString(fieldErrors []*models.FieldError) string {
+	ret := make([]string, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		ret = append(ret, fmt.Sprintf("%s: %s", swag.StringValue(fieldError.Field), swag.StringValue(fieldError.Reason)))
+	}
+	return strings.Join(ret, "; ")
+}
@@ -0,0 +1,153 @@
+package network
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestNetwork(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "network tests")
+}
+
+func getTestHost(cidrs ...*models.Cidr) *models.Host {
+	hwInfo := models.Introspection{Nics: []*models.Nic{{Name: "eth0", Cidrs: cidrs}}}
+	b, err := json.Marshal(&hwInfo)
+	Expect(err).ShouldNot(HaveOccurred())
+	return &models.Host{HardwareInfo: string(b)}
+}
+
+func errorFields(fieldErrors []*models.FieldError) []string {
+	var fields []string
+	for _, fieldError := range fieldErrors {
+		fields = append(fields, swag.StringValue(fieldError.Field))
+	}
+	return fields
+}
+
+var _ = Describe("ValidateCluster", func() {
+	var (
+		cluster *models.Cluster
+		hosts   []*models.Host
+	)
+
+	BeforeEach(func() {
+		cluster = &models.Cluster{
+			ClusterNetworkCidr:       "10.128.0.0/14",
+			ClusterNetworkHostPrefix: 23,
+			ServiceNetworkCidr:       "172.30.0.0/16",
+			APIVip:                   "192.168.126.100",
+			IngressVip:               "192.168.126.101",
+			DNSVip:                   "192.168.126.102",
+		}
+		hosts = []*models.Host{
+			getTestHost(&models.Cidr{IPAddress: "192.168.126.10", Mask: 24}),
+			getTestHost(&models.Cidr{IPAddress: "192.168.126.11", Mask: 24},
+				&models.Cidr{IPAddress: "fe80::1", Mask: 64}),
+			{},
+		}
+	})
+
+	It("valid", func() {
+		Expect(ValidateCluster(cluster, hosts)).Should(BeEmpty())
+		Expect(ValidateCluster(&models.Cluster{}, nil)).Should(BeEmpty())
+	})
+
+	It("invalid_cidrs", func() {
+		cluster.ClusterNetworkCidr = "10.128.0.1/14"
+		cluster.ServiceNetworkCidr = "999.30.0.0/16"
+		Expect(errorFields(ValidateCluster(cluster, hosts))).Should(Equal(
+			[]string{FieldClusterNetworkCidr, FieldServiceNetworkCidr}))
+	})
+
+	It("host_prefix", func() {
+		cluster.ClusterNetworkHostPrefix = 13
+		fieldErrors := ValidateCluster(cluster, hosts)
+		Expect(errorFields(fieldErrors)).Should(Equal([]string{FieldClusterNetworkHostPrefix}))
+		Expect(ToString(fieldErrors)).Should(Equal("cluster_network_host_prefix: host prefix 13 does not fit in " +
+			"the cluster network 10.128.0.0/14, it has to be between 14 and 32"))
+	})
+
+	It("overlapping_networks", func() {
+		cluster.ServiceNetworkCidr = "10.130.0.0/16"
+		Expect(errorFields(ValidateCluster(cluster, hosts))).Should(Equal([]string{FieldServiceNetworkCidr}))
+
+		cluster.ServiceNetworkCidr = "192.168.0.0/16"
+		cluster.ClusterNetworkCidr = "192.168.126.128/25"
+		cluster.ClusterNetworkHostPrefix = 28
+		Expect(errorFields(ValidateCluster(cluster, hosts))).Should(Equal(
+			[]string{FieldServiceNetworkCidr, FieldClusterNetworkCidr, FieldServiceNetworkCidr}))
+	})
+
+	It("duplicate_vips", func() {
+		cluster.IngressVip = cluster.APIVip
+		cluster.DNSVip = cluster.APIVip
+		fieldErrors := ValidateCluster(cluster, hosts)
+		Expect(errorFields(fieldErrors)).Should(Equal([]string{FieldIngressVip, FieldDNSVip}))
+		Expect(swag.StringValue(fieldErrors[0].Reason)).Should(Equal("192.168.126.100 is already used by api_vip"))
+	})
+
+	It("vips_outside_machine_networks", func() {
+		cluster.APIVip = "192.168.127.100"
+		fieldErrors := ValidateCluster(cluster, hosts)
+		Expect(errorFields(fieldErrors)).Should(Equal([]string{FieldAPIVip}))
+		Expect(swag.StringValue(fieldErrors[0].Reason)).Should(Equal(
+			"192.168.127.100 is not inside any of the hosts machine networks: 192.168.126.0/24"))
+
+		// the VIPs are not validated against the hosts networks before the hosts report them
+		Expect(ValidateCluster(cluster, []*models.Host{{}})).Should(BeEmpty())
+		Expect(errorFields(ValidateCluster(cluster, []*models.Host{getTestHost()}))).Should(Equal(
+			[]string{FieldAPIVip, FieldIngressVip, FieldDNSVip}))
+	})
+
+	It("vips_outside_shared_machine_networks", func() {
+		// a network of a single host is not a machine network
+		hosts = append(hosts, getTestHost(&models.Cidr{IPAddress: "192.168.126.12", Mask: 24},
+			&models.Cidr{IPAddress: "10.0.0.5", Mask: 24}))
+		cluster.ClusterNetworkCidr = "10.128.0.0/14"
+		cluster.APIVip = "10.0.0.100"
+		fieldErrors := ValidateCluster(cluster, hosts)
+		Expect(errorFields(fieldErrors)).Should(Equal([]string{FieldAPIVip}))
+		Expect(swag.StringValue(fieldErrors[0].Reason)).Should(Equal(
+			"10.0.0.100 is not inside any of the hosts machine networks: 192.168.126.0/24"))
+
+		// hosts that don't share a network
+		Expect(errorFields(ValidateCluster(cluster, []*models.Host{
+			getTestHost(&models.Cidr{IPAddress: "192.168.126.10", Mask: 24}),
+			getTestHost(&models.Cidr{IPAddress: "10.0.0.5", Mask: 24}),
+		}))).Should(Equal([]string{FieldAPIVip, FieldIngressVip, FieldDNSVip}))
+	})
+
+	It("vips_host_and_reserved_addresses", func() {
+		cluster.APIVip = "192.168.126.11"
+		cluster.IngressVip = "192.168.126.0"
+		cluster.DNSVip = "192.168.126.255"
+		fieldErrors := ValidateCluster(cluster, hosts)
+		Expect(errorFields(fieldErrors)).Should(Equal([]string{FieldAPIVip, FieldIngressVip, FieldDNSVip}))
+		Expect(swag.StringValue(fieldErrors[0].Reason)).Should(Equal("192.168.126.11 is already used by a host"))
+		Expect(swag.StringValue(fieldErrors[1].Reason)).Should(Equal(
+			"192.168.126.0 is the network or broadcast address of the machine network 192.168.126.0/24"))
+	})
+})
+
+var _ = Describe("HostNetworks", func() {
+	It("unique_sorted_subnets", func() {
+		networks, reported := HostNetworks([]*models.Host{
+			getTestHost(&models.Cidr{IPAddress: "192.168.126.10", Mask: 24},
+				&models.Cidr{IPAddress: "10.0.0.5", Mask: 8}),
+			getTestHost(&models.Cidr{IPAddress: "192.168.126.11", Mask: 24},
+				&models.Cidr{IPAddress: "invalid", Mask: 24}),
+		})
+		Expect(reported).Should(BeTrue())
+		Expect(toStrings(networks)).Should(Equal([]string{"10.0.0.0/8", "192.168.126.0/24"}))
+
+		networks, reported = HostNetworks([]*models.Host{{}})
+		Expect(reported).Should(BeFalse())
+		Expect(networks).Should(BeEmpty())
+	})
+})
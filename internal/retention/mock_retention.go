@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: retention.go
+
+// Package retention is a generated GoMock package.
+package retention
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+	time "time"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// RetentionCleanup mocks base method.
+func (m *MockAPI) RetentionCleanup() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RetentionCleanup")
+}
+
+// RetentionCleanup indicates an expected call of RetentionCleanup.
+func (mr *MockAPIMockRecorder) RetentionCleanup() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetentionCleanup", reflect.TypeOf((*MockAPI)(nil).RetentionCleanup))
+}
+
+// ImageExpiration mocks base method.
+func (m *MockAPI) ImageExpiration(createdAt time.Time) *strfmt.DateTime {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageExpiration", createdAt)
+	ret0, _ := ret[0].(*strfmt.DateTime)
+	return ret0
+}
+
+// ImageExpiration indicates an expected call of ImageExpiration.
+func (mr *MockAPIMockRecorder) ImageExpiration(createdAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageExpiration", reflect.TypeOf((*MockAPI)(nil).ImageExpiration), createdAt)
+}
@@ -0,0 +1,197 @@
+package retention
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/events"
+	"github.com/filanov/bm-inventory/internal/image"
+	"github.com/filanov/bm-inventory/internal/webhooks"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/filanov/bm-inventory/pkg/objectstore"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const clusterStatusInstalling = "installing"
+
+// the cluster logs are stored as <cluster-id>/logs/<file name>,
+// any other object under <cluster-id>/ is a generated cluster file
+const logsDir = "logs/"
+
+// Config holds the retention of each artifact type, artifacts are kept forever when it is 0
+type Config struct {
+	ImageRetention        time.Duration `envconfig:"IMAGE_RETENTION" default:"24h"`
+	ClusterFilesRetention time.Duration `envconfig:"CLUSTER_FILES_RETENTION" default:"720h"`
+	LogsRetention         time.Duration `envconfig:"LOGS_RETENTION" default:"168h"`
+	EventsRetention       time.Duration `envconfig:"EVENTS_RETENTION" default:"720h"`
+}
+
+//go:generate mockgen -source=retention.go -package=retention -destination=mock_retention.go
+type API interface {
+	// Delete the artifacts that passed their retention, should be called periodically
+	RetentionCleanup()
+	// ImageExpiration returns the time an image created at the given time expires, nil if images never expire
+	ImageExpiration(createdAt time.Time) *strfmt.DateTime
+}
+
+type Manager struct {
+	log           logrus.FieldLogger
+	cfg           Config
+	db            *gorm.DB
+	objectStore   objectstore.API
+	leaderElector leader.ElectorInterface
+}
+
+func NewManager(log logrus.FieldLogger, cfg Config, db *gorm.DB, objectStore objectstore.API,
+	leaderElector leader.ElectorInterface) *Manager {
+	return &Manager{
+		log:           log,
+		cfg:           cfg,
+		db:            db,
+		objectStore:   objectStore,
+		leaderElector: leaderElector,
+	}
+}
+
+func (m *Manager) ImageExpiration(createdAt time.Time) *strfmt.DateTime {
+	if m.cfg.ImageRetention == 0 {
+		return nil
+	}
+	expiresAt := strfmt.DateTime(createdAt.Add(m.cfg.ImageRetention))
+	return &expiresAt
+}
+
+func (m *Manager) RetentionCleanup() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := logutil.FromContext(ctx, m.log)
+
+	// artifacts of clusters that are being installed are never deleted, they are still used by the installation
+	var installing []*models.Cluster
+	if err := m.db.Select("id").Where("status = ?", clusterStatusInstalling).Find(&installing).Error; err != nil {
+		log.WithError(err).Errorf("failed to get installing clusters")
+		return
+	}
+	skip := make(map[string]bool)
+	for _, c := range installing {
+		skip[c.ID.String()] = true
+	}
+
+	now := time.Now()
+	if err := m.deleteExpiredImages(ctx, now, skip); err != nil {
+		log.WithError(err).Errorf("failed to delete expired images")
+	}
+	if err := m.deleteExpiredClusterObjects(ctx, now, skip); err != nil {
+		log.WithError(err).Errorf("failed to delete expired cluster files and logs")
+	}
+	if m.cfg.EventsRetention != 0 {
+		deleted, err := events.DeleteOlderThan(m.db, now.Add(-m.cfg.EventsRetention))
+		if err != nil {
+			log.WithError(err).Errorf("failed to delete expired events")
+		}
+		if deleted > 0 {
+			log.Infof("deleted %d events that occurred before %s", deleted, now.Add(-m.cfg.EventsRetention))
+		}
+		deleted, err = webhooks.DeleteDeliveriesOlderThan(m.db, now.Add(-m.cfg.EventsRetention))
+		if err != nil {
+			log.WithError(err).Errorf("failed to delete expired webhook deliveries")
+		}
+		if deleted > 0 {
+			log.Infof("deleted %d webhook deliveries that were created before %s", deleted,
+				now.Add(-m.cfg.EventsRetention))
+		}
+	}
+}
+
+func (m *Manager) deleteExpiredImages(ctx context.Context, now time.Time, skip map[string]bool) error {
+	log := logutil.FromContext(ctx, m.log)
+	var images []*models.Image
+	if err := m.db.Find(&images).Error; err != nil {
+		return errors.Wrapf(err, "failed to get images")
+	}
+	known := make(map[string]bool)
+	for _, img := range images {
+		for _, objectName := range image.ObjectNames(*img.ClusterID, *img.ID) {
+			known[objectName] = true
+		}
+		if img.ExpiresAt == nil || skip[img.ClusterID.String()] || now.Before(time.Time(*img.ExpiresAt)) {
+			continue
+		}
+		if err := image.Delete(ctx, m.log, img, m.db, m.objectStore); err != nil {
+			if errors.Cause(err) != image.ErrImageInProgress {
+				log.WithError(err).Errorf("failed to delete expired image %s", img.ID)
+			}
+			continue
+		}
+		log.Infof("deleted image %s of cluster %s, expired at %s", img.ID, img.ClusterID, img.ExpiresAt)
+	}
+
+	if m.cfg.ImageRetention == 0 {
+		return nil
+	}
+	// image objects without a record, such as images of deregistered clusters that were still being generated
+	objects, err := m.objectStore.ListObjects(ctx, image.ObjectNamePrefix)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		if known[obj.Name] || skip[imageClusterID(obj.Name)] || now.Sub(obj.LastModified) < m.cfg.ImageRetention {
+			continue
+		}
+		m.deleteObject(ctx, obj)
+	}
+	return nil
+}
+
+// imageClusterID returns the ID of the cluster an image object belongs to
+func imageClusterID(objectName string) string {
+	name := strings.TrimPrefix(objectName, image.ObjectNamePrefix)
+	if len(name) < len(uuid.Nil.String()) {
+		return ""
+	}
+	return name[:len(uuid.Nil.String())]
+}
+
+func (m *Manager) deleteExpiredClusterObjects(ctx context.Context, now time.Time, skip map[string]bool) error {
+	if m.cfg.ClusterFilesRetention == 0 && m.cfg.LogsRetention == 0 {
+		return nil
+	}
+	objects, err := m.objectStore.ListObjects(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, obj := range objects {
+		parts := strings.SplitN(obj.Name, "/", 2)
+		if len(parts) != 2 || !strfmt.IsUUID(parts[0]) || skip[parts[0]] {
+			continue
+		}
+		retention := m.cfg.ClusterFilesRetention
+		if strings.HasPrefix(parts[1], logsDir) {
+			retention = m.cfg.LogsRetention
+		}
+		if retention == 0 || now.Sub(obj.LastModified) < retention {
+			continue
+		}
+		m.deleteObject(ctx, obj)
+	}
+	return nil
+}
+
+func (m *Manager) deleteObject(ctx context.Context, obj objectstore.ObjectInfo) {
+	log := logutil.FromContext(ctx, m.log)
+	if err := m.objectStore.DeleteObject(ctx, obj.Name); err != nil {
+		log.WithError(err).Errorf("failed to delete expired object %s", obj.Name)
+		return
+	}
+	log.Infof("deleted object %s, last modified at %s", obj.Name, obj.LastModified)
+}
@@ -0,0 +1,222 @@
+package retention
+
+import (
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/filanov/bm-inventory/internal/image"
+	"github.com/filanov/bm-inventory/internal/webhooks"
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/filanov/bm-inventory/pkg/objectstore"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestRetention(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "retention tests")
+}
+
+func prepareDB() *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	Expect(err).ShouldNot(HaveOccurred())
+	db.AutoMigrate(&models.Cluster{}, &models.Image{}, &models.Event{},
+		&webhooks.Subscription{}, &models.WebhookDelivery{})
+	return db
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+var _ = Describe("RetentionCleanup", func() {
+	var (
+		db           *gorm.DB
+		ctrl         *gomock.Controller
+		mockStore    *objectstore.MockAPI
+		mockLeader   *leader.MockElectorInterface
+		manager      *Manager
+		clusterId    strfmt.UUID
+		installingId strfmt.UUID
+		now          time.Time
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockStore = objectstore.NewMockAPI(ctrl)
+		mockLeader = leader.NewMockElectorInterface(ctrl)
+		manager = NewManager(getTestLog(), Config{
+			ImageRetention:        time.Hour,
+			ClusterFilesRetention: 10 * time.Hour,
+			LogsRetention:         5 * time.Hour,
+			EventsRetention:       2 * time.Hour,
+		}, db, mockStore, mockLeader)
+		now = time.Now()
+		clusterId = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Cluster{ID: &clusterId, Status: swag.String("installed")}).Error).
+			ShouldNot(HaveOccurred())
+		installingId = strfmt.UUID(uuid.New().String())
+		Expect(db.Create(&models.Cluster{ID: &installingId, Status: swag.String(clusterStatusInstalling)}).Error).
+			ShouldNot(HaveOccurred())
+	})
+
+	addImage := func(clusterID strfmt.UUID, status string, expiresAt *strfmt.DateTime) *models.Image {
+		id := strfmt.UUID(uuid.New().String())
+		img := &models.Image{ID: &id, ClusterID: &clusterID, Status: swag.String(status), ExpiresAt: expiresAt}
+		Expect(db.Create(img).Error).ShouldNot(HaveOccurred())
+		return img
+	}
+
+	imageExists := func(img *models.Image) bool {
+		var count int
+		Expect(db.Model(&models.Image{}).Where("id = ?", img.ID.String()).Count(&count).Error).ShouldNot(HaveOccurred())
+		return count == 1
+	}
+
+	object := func(name string, age time.Duration) objectstore.ObjectInfo {
+		return objectstore.ObjectInfo{Name: name, LastModified: now.Add(-age)}
+	}
+
+	expectObjects := func(images, all []objectstore.ObjectInfo) {
+		mockStore.EXPECT().ListObjects(gomock.Any(), image.ObjectNamePrefix).Return(images, nil).Times(1)
+		mockStore.EXPECT().ListObjects(gomock.Any(), "").Return(all, nil).Times(1)
+	}
+
+	expectDelete := func(names ...string) {
+		for _, name := range names {
+			mockStore.EXPECT().DeleteObject(gomock.Any(), name).Return(nil).Times(1)
+		}
+	}
+
+	It("not_leader", func() {
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+		manager.RetentionCleanup()
+	})
+
+	It("images", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		expired := manager.ImageExpiration(now.Add(-2 * time.Hour))
+		valid := manager.ImageExpiration(now)
+		expiredImage := addImage(clusterId, image.StatusReady, expired)
+		failedImage := addImage(clusterId, image.StatusFailed, expired)
+		validImage := addImage(clusterId, image.StatusReady, valid)
+		buildingImage := addImage(clusterId, image.StatusBuilding, expired)
+		noExpiryImage := addImage(clusterId, image.StatusReady, nil)
+		installingImage := addImage(installingId, image.StatusReady, expired)
+		expectObjects(nil, nil)
+		expectDelete(image.ObjectNames(clusterId, *expiredImage.ID)...)
+		expectDelete(image.ObjectNames(clusterId, *failedImage.ID)...)
+
+		manager.RetentionCleanup()
+
+		Expect(imageExists(expiredImage)).Should(BeFalse())
+		Expect(imageExists(failedImage)).Should(BeFalse())
+		Expect(imageExists(validImage)).Should(BeTrue())
+		Expect(imageExists(buildingImage)).Should(BeTrue())
+		Expect(imageExists(noExpiryImage)).Should(BeTrue())
+		Expect(imageExists(installingImage)).Should(BeTrue())
+	})
+
+	It("image_objects_without_record", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		known := addImage(clusterId, image.StatusReady, nil)
+		orphan := image.ObjectName(clusterId, strfmt.UUID(uuid.New().String()))
+		expectObjects([]objectstore.ObjectInfo{
+			object(image.ObjectName(clusterId, *known.ID), 2*time.Hour),
+			object(image.ChecksumObjectName(clusterId, *known.ID), 2*time.Hour),
+			object(orphan, 2*time.Hour),
+			object(image.ObjectName(clusterId, strfmt.UUID(uuid.New().String())), time.Minute),
+			object(image.ObjectName(installingId, strfmt.UUID(uuid.New().String())), 2*time.Hour),
+		}, nil)
+		expectDelete(orphan)
+
+		manager.RetentionCleanup()
+	})
+
+	It("cluster_files_and_logs", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		file := func(clusterID strfmt.UUID, name string) string {
+			return fmt.Sprintf("%s/%s", clusterID, name)
+		}
+		expectObjects(nil, []objectstore.ObjectInfo{
+			object(file(clusterId, "kubeconfig"), 11*time.Hour),
+			object(file(clusterId, "bootstrap.ign"), 6*time.Hour),
+			object(file(clusterId, "logs/host.log"), 6*time.Hour),
+			object(file(clusterId, "logs/agent.log"), time.Hour),
+			object(file(installingId, "kubeconfig"), 11*time.Hour),
+			object(file(installingId, "logs/host.log"), 11*time.Hour),
+			object("not-a-cluster/kubeconfig", 11*time.Hour),
+			object("discovery-image", 11*time.Hour),
+		})
+		expectDelete(file(clusterId, "kubeconfig"), file(clusterId, "logs/host.log"))
+
+		manager.RetentionCleanup()
+	})
+
+	It("events", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		addEvent := func(age time.Duration) {
+			eventTime := strfmt.DateTime(now.Add(-age).UTC())
+			Expect(db.Create(&models.Event{ClusterID: &clusterId, ToStatus: swag.String("installed"),
+				EventTime: &eventTime}).Error).ShouldNot(HaveOccurred())
+		}
+		addEvent(3 * time.Hour)
+		addEvent(time.Hour)
+		expectObjects(nil, nil)
+
+		manager.RetentionCleanup()
+
+		var remaining []*models.Event
+		Expect(db.Find(&remaining).Error).ShouldNot(HaveOccurred())
+		Expect(remaining).Should(HaveLen(1))
+		Expect(time.Time(*remaining[0].EventTime).After(now.Add(-2 * time.Hour))).Should(BeTrue())
+	})
+
+	It("webhook_deliveries", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		webhookID := strfmt.UUID(uuid.New().String())
+		addDelivery := func(age time.Duration) {
+			createdAt := strfmt.DateTime(now.Add(-age).UTC())
+			Expect(db.Create(&models.WebhookDelivery{WebhookID: &webhookID, EventID: swag.Int64(1),
+				Status: swag.String(models.WebhookDeliveryStatusDelivered), CreatedAt: createdAt}).Error).
+				ShouldNot(HaveOccurred())
+		}
+		addDelivery(3 * time.Hour)
+		addDelivery(time.Hour)
+		expectObjects(nil, nil)
+
+		manager.RetentionCleanup()
+
+		var remaining []*models.WebhookDelivery
+		Expect(db.Find(&remaining).Error).ShouldNot(HaveOccurred())
+		Expect(remaining).Should(HaveLen(1))
+		Expect(time.Time(remaining[0].CreatedAt).After(now.Add(-2 * time.Hour))).Should(BeTrue())
+	})
+
+	It("disabled_retention", func() {
+		manager = NewManager(getTestLog(), Config{}, db, mockStore, mockLeader)
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		addImage(clusterId, image.StatusReady, nil)
+		Expect(manager.ImageExpiration(now)).Should(BeNil())
+
+		manager.RetentionCleanup()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+		db.Close()
+	})
+})
@@ -0,0 +1,55 @@
+package validations
+
+import (
+	"encoding/json"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+)
+
+// New returns the result of a validation that was evaluated
+func New(id, category string, passed bool, expected, actual string) *models.ValidationResult {
+	status := models.ValidationResultStatusFailure
+	if passed {
+		status = models.ValidationResultStatusSuccess
+	}
+	return &models.ValidationResult{
+		ID:       swag.String(id),
+		Category: swag.String(category),
+		Status:   swag.String(status),
+		Expected: expected,
+		Actual:   actual,
+	}
+}
+
+// NewPending returns the result of a validation that could not be evaluated yet
+func NewPending(id, category, expected string) *models.ValidationResult {
+	return &models.ValidationResult{
+		ID:       swag.String(id),
+		Category: swag.String(category),
+		Status:   swag.String(models.ValidationResultStatusPending),
+		Expected: expected,
+	}
+}
+
+// IsSuccessful returns true if all the validations passed
+func IsSuccessful(results []*models.ValidationResult) bool {
+	for _, result := range results {
+		if swag.StringValue(result.Status) != models.ValidationResultStatusSuccess {
+			return false
+		}
+	}
+	return true
+}
+
+// ToString returns the results in the JSON format they are stored in the DB
+func ToString(results []*models.ValidationResult) (string, error) {
+	if results == nil {
+		results = []*models.ValidationResult{}
+	}
+	b, err := json.Marshal(results)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
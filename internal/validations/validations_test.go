@@ -0,0 +1,52 @@
+package validations
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/go-openapi/swag"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestValidations(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "validations tests")
+}
+
+var _ = Describe("validations", func() {
+	It("status", func() {
+		Expect(swag.StringValue(New("id", models.ValidationResultCategoryCPU, true, "1", "2").Status)).
+			To(Equal(models.ValidationResultStatusSuccess))
+		Expect(swag.StringValue(New("id", models.ValidationResultCategoryCPU, false, "2", "1").Status)).
+			To(Equal(models.ValidationResultStatusFailure))
+		Expect(swag.StringValue(NewPending("id", models.ValidationResultCategoryNetwork, "1").Status)).
+			To(Equal(models.ValidationResultStatusPending))
+	})
+
+	It("is_successful", func() {
+		success := New("a", models.ValidationResultCategoryCPU, true, "1", "1")
+		Expect(IsSuccessful(nil)).To(BeTrue())
+		Expect(IsSuccessful([]*models.ValidationResult{success})).To(BeTrue())
+		Expect(IsSuccessful([]*models.ValidationResult{success,
+			New("b", models.ValidationResultCategoryMemory, false, "2", "1")})).To(BeFalse())
+		Expect(IsSuccessful([]*models.ValidationResult{success,
+			NewPending("c", models.ValidationResultCategoryNetwork, "1")})).To(BeFalse())
+	})
+
+	It("to_string", func() {
+		str, err := ToString(nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(str).To(Equal("[]"))
+
+		results := []*models.ValidationResult{New("a", models.ValidationResultCategoryDisk, false, "1", "0")}
+		str, err = ToString(results)
+		Expect(err).NotTo(HaveOccurred())
+		var decoded models.ValidationResults
+		Expect(json.Unmarshal([]byte(str), &decoded)).NotTo(HaveOccurred())
+		Expect(decoded).To(HaveLen(1))
+		Expect(swag.StringValue(decoded[0].ID)).To(Equal("a"))
+		Expect(decoded[0].Actual).To(Equal("0"))
+	})
+})
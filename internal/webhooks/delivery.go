@@ -0,0 +1,210 @@
+package webhooks
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/database"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/filanov/bm-inventory/pkg/requestid"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// the response body is read, up to this size, only so that the connection can be reused
+const maxResponseBodySize = 64 * 1024
+
+// Config of the deliveries, failed attempts are retried after a backoff that doubles with each attempt.
+// Notifications are not posted to loopback, link-local, private or unspecified addresses, unless the addresses are
+// inside one of the allowed networks.
+type Config struct {
+	DeliveryTimeout time.Duration `envconfig:"WEBHOOK_DELIVERY_TIMEOUT" default:"10s"`
+	MaxAttempts     int64         `envconfig:"WEBHOOK_MAX_ATTEMPTS" default:"10"`
+	InitialBackoff  time.Duration `envconfig:"WEBHOOK_INITIAL_BACKOFF" default:"10s"`
+	MaxBackoff      time.Duration `envconfig:"WEBHOOK_MAX_BACKOFF" default:"1h"`
+	DeliveryBatch   int           `envconfig:"WEBHOOK_DELIVERY_BATCH" default:"500"`
+	DeliveryWorkers int           `envconfig:"WEBHOOK_DELIVERY_WORKERS" default:"10"`
+	AllowedNetworks []string      `envconfig:"WEBHOOK_ALLOWED_NETWORKS"`
+}
+
+// Manager posts the pending deliveries to the webhooks
+type Manager struct {
+	log           logrus.FieldLogger
+	cfg           Config
+	db            *gorm.DB
+	client        *http.Client
+	leaderElector leader.ElectorInterface
+}
+
+func NewManager(log logrus.FieldLogger, cfg Config, db *gorm.DB, leaderElector leader.ElectorInterface) (*Manager, error) {
+	if cfg.DeliveryWorkers < 1 || cfg.DeliveryBatch < 1 {
+		return nil, errors.Errorf("invalid webhook delivery workers %d and batch %d, both must be positive",
+			cfg.DeliveryWorkers, cfg.DeliveryBatch)
+	}
+	allowedNetworks, err := parseNetworks(cfg.AllowedNetworks)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid webhook allowed networks")
+	}
+	// the address is checked after it is resolved, right before connecting, so that a host that resolves
+	// to another address after the webhook was registered can't be used to reach a restricted address
+	dialer := &net.Dialer{
+		Timeout: cfg.DeliveryTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil {
+				return errors.Errorf("invalid address %s", address)
+			}
+			return checkAddress(ip, allowedNetworks)
+		},
+	}
+	return &Manager{
+		log: log,
+		cfg: cfg,
+		db:  db,
+		client: &http.Client{
+			Timeout:   cfg.DeliveryTimeout,
+			Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: cfg.DeliveryTimeout},
+		},
+		leaderElector: leaderElector,
+	}, nil
+}
+
+// DeliverPending attempts the pending deliveries that are due, should be called periodically. The deliveries are
+// attempted by a pool of workers, so that a webhook that doesn't respond doesn't delay the deliveries to the others.
+func (m *Manager) DeliverPending() {
+	if !m.leaderElector.IsLeader() {
+		return
+	}
+	ctx := requestid.ToContext(context.Background(), requestid.NewID())
+	log := logutil.FromContext(ctx, m.log)
+
+	var pending []*models.WebhookDelivery
+	if err := m.db.Where("status = ? and next_attempt_at <= ?", models.WebhookDeliveryStatusPending,
+		database.Time(time.Now())).Order("id").Limit(m.cfg.DeliveryBatch).Find(&pending).Error; err != nil {
+		log.WithError(err).Errorf("failed to get pending webhook deliveries")
+		return
+	}
+	if len(pending) == 0 {
+		return
+	}
+	var webhookIDs []string
+	for _, delivery := range pending {
+		webhookIDs = append(webhookIDs, delivery.WebhookID.String())
+	}
+	var found []*Subscription
+	if err := m.db.Where("id in (?)", webhookIDs).Find(&found).Error; err != nil {
+		log.WithError(err).Errorf("failed to get the webhooks of the pending deliveries")
+		return
+	}
+	subscriptions := make(map[strfmt.UUID]*Subscription)
+	for _, s := range found {
+		subscriptions[s.ID] = s
+	}
+
+	deliveries := make(chan *models.WebhookDelivery)
+	var wg sync.WaitGroup
+	for i := 0; i < m.cfg.DeliveryWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for delivery := range deliveries {
+				s := subscriptions[*delivery.WebhookID]
+				if err := m.deliver(ctx, s, delivery); err != nil {
+					log.WithError(err).Errorf("failed to update delivery %d of webhook %s", *delivery.ID, s.ID)
+				}
+			}
+		}()
+	}
+	for _, delivery := range pending {
+		if _, ok := subscriptions[*delivery.WebhookID]; !ok {
+			// the webhook may have been deregistered together with its deliveries
+			log.Errorf("failed to get webhook %s", delivery.WebhookID)
+			continue
+		}
+		deliveries <- delivery
+	}
+	close(deliveries)
+	wg.Wait()
+}
+
+// deliver makes a delivery attempt and records its result
+func (m *Manager) deliver(ctx context.Context, s *Subscription, delivery *models.WebhookDelivery) error {
+	log := logutil.FromContext(ctx, m.log)
+	responseCode, postErr := m.post(ctx, s, delivery)
+	now := database.Now()
+	attempts := delivery.Attempts + 1
+	updates := map[string]interface{}{
+		"attempts":        attempts,
+		"response_code":   responseCode,
+		"last_attempt_at": strfmt.DateTime(now),
+	}
+	switch {
+	case postErr == nil:
+		updates["status"] = models.WebhookDeliveryStatusDelivered
+		updates["last_error"] = ""
+		log.Infof("delivered event %d to webhook %s", swag.Int64Value(delivery.EventID), s.ID)
+	case attempts >= m.cfg.MaxAttempts:
+		updates["status"] = models.WebhookDeliveryStatusFailed
+		updates["last_error"] = postErr.Error()
+		log.WithError(postErr).Warnf("failed to deliver event %d to webhook %s after %d attempts",
+			swag.Int64Value(delivery.EventID), s.ID, attempts)
+	default:
+		backoff := m.backoff(attempts)
+		updates["last_error"] = postErr.Error()
+		updates["next_attempt_at"] = strfmt.DateTime(now.Add(backoff))
+		log.WithError(postErr).Warnf("failed to deliver event %d to webhook %s, retrying in %s",
+			swag.Int64Value(delivery.EventID), s.ID, backoff)
+	}
+	return m.db.Model(&models.WebhookDelivery{}).Where("id = ?", *delivery.ID).Updates(updates).Error
+}
+
+// backoff returns the time to wait after the given number of failed attempts
+func (m *Manager) backoff(attempts int64) time.Duration {
+	backoff := m.cfg.InitialBackoff
+	for i := int64(1); i < attempts; i++ {
+		if backoff *= 2; backoff >= m.cfg.MaxBackoff {
+			return m.cfg.MaxBackoff
+		}
+	}
+	return backoff
+}
+
+// post sends the notification and returns the response status code, 0 if no response was received
+func (m *Manager) post(ctx context.Context, s *Subscription, delivery *models.WebhookDelivery) (int64, error) {
+	req, err := http.NewRequest(http.MethodPost, s.URL, strings.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to create request to %s", s.URL)
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", deliveryPayloadMediaType)
+	req.Header.Set("X-Webhook-Event", string(delivery.EventType))
+	req.Header.Set("X-Webhook-Delivery", strconv.FormatInt(*delivery.ID, 10))
+	req.Header.Set("X-Webhook-Signature", Sign(string(s.Secret), delivery.Payload))
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to post to %s", s.URL)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, maxResponseBodySize))
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return int64(resp.StatusCode), errors.Errorf("%s responded with %s", s.URL, resp.Status)
+	}
+	return int64(resp.StatusCode), nil
+}
@@ -0,0 +1,349 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/database"
+	"github.com/filanov/bm-inventory/pkg/secret"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/pkg/errors"
+	"github.com/thoas/go-funk"
+)
+
+// the statuses the notified events move to, the packages that own them can't be imported since they notify the webhooks
+const (
+	hostStatusDiscovering    = "discovering"
+	hostStatusInsufficient   = "insufficient"
+	clusterStatusReady       = "ready"
+	clusterStatusInstalled   = "installed"
+	clusterStatusError       = "error"
+	eventTypesSeparator      = ","
+	signaturePrefix          = "sha256="
+	deliveryPayloadMediaType = "application/json"
+)
+
+// Subscription is a registered webhook, it is not returned by the API since its secret must not be exposed
+type Subscription struct {
+	ID        strfmt.UUID   `gorm:"primary_key"`
+	URL       string        `gorm:"type:text"`
+	Secret    secret.String `gorm:"type:text"`
+	ClusterID strfmt.UUID   `gorm:"index"`
+	// EventTypes holds the comma separated event types the webhook is subscribed to
+	EventTypes string
+	CreatedAt  time.Time `gorm:"type:datetime"`
+}
+
+func (Subscription) TableName() string {
+	return "webhooks"
+}
+
+func (s *Subscription) eventTypes() []models.WebhookEventType {
+	var types []models.WebhookEventType
+	for _, t := range strings.Split(s.EventTypes, eventTypesSeparator) {
+		types = append(types, models.WebhookEventType(t))
+	}
+	return types
+}
+
+func (s *Subscription) subscribedTo(eventType models.WebhookEventType) bool {
+	for _, t := range s.eventTypes() {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *Subscription) toModel() *models.Webhook {
+	id := s.ID
+	return &models.Webhook{
+		ID:         &id,
+		URL:        swag.String(s.URL),
+		ClusterID:  s.ClusterID,
+		EventTypes: s.eventTypes(),
+		CreatedAt:  strfmt.DateTime(s.CreatedAt),
+	}
+}
+
+// the networks notifications are not posted to unless they are allowed by the configuration,
+// so that webhooks can't be used to reach the services of the cluster the inventory runs in
+var restrictedNetworks = mustParseNetworks(
+	"0.0.0.0/8",      // unspecified
+	"10.0.0.0/8",     // private
+	"100.64.0.0/10",  // carrier-grade NAT
+	"127.0.0.0/8",    // loopback
+	"169.254.0.0/16", // link-local
+	"172.16.0.0/12",  // private
+	"192.168.0.0/16", // private
+	"::/128",         // unspecified
+	"::1/128",        // loopback
+	"fc00::/7",       // unique local
+	"fe80::/10",      // link-local
+)
+
+func mustParseNetworks(cidrs ...string) []*net.IPNet {
+	networks, err := parseNetworks(cidrs)
+	if err != nil {
+		panic(err)
+	}
+	return networks
+}
+
+func parseNetworks(cidrs []string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, cidr := range cidrs {
+		_, subnet, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid network %s", cidr)
+		}
+		networks = append(networks, subnet)
+	}
+	return networks, nil
+}
+
+func containedIn(ip net.IP, networks []*net.IPNet) bool {
+	for _, subnet := range networks {
+		if subnet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkAddress returns an error if notifications may not be posted to the address,
+// the address must not be restricted unless it is inside one of the allowed networks
+func checkAddress(ip net.IP, allowedNetworks []*net.IPNet) error {
+	if containedIn(ip, allowedNetworks) {
+		return nil
+	}
+	if ip.IsLoopback() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsUnspecified() ||
+		containedIn(ip, restrictedNetworks) {
+		return errors.Errorf("%s is a loopback, link-local, private or unspecified address", ip)
+	}
+	return nil
+}
+
+// ValidateURL returns an error if the notifications can't be posted to the URL. All the addresses the host of the
+// URL resolves to are checked, the address is checked again when the notifications are posted since it may change.
+func ValidateURL(rawURL string, cfg Config) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return errors.Wrapf(err, "invalid webhook URL %s", rawURL)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.Errorf("invalid webhook URL %s, an absolute http or https URL is expected", rawURL)
+	}
+	allowedNetworks, err := parseNetworks(cfg.AllowedNetworks)
+	if err != nil {
+		return err
+	}
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return errors.Wrapf(err, "failed to resolve the host of webhook URL %s", rawURL)
+	}
+	for _, ip := range ips {
+		if err := checkAddress(ip, allowedNetworks); err != nil {
+			return errors.Wrapf(err, "invalid webhook URL %s", rawURL)
+		}
+	}
+	return nil
+}
+
+// Register stores a new webhook subscription
+func Register(db *gorm.DB, params *models.WebhookCreateParams) (*models.Webhook, error) {
+	var types []string
+	for _, t := range params.EventTypes {
+		if !funk.ContainsString(types, string(t)) {
+			types = append(types, string(t))
+		}
+	}
+	s := &Subscription{
+		ID:         strfmt.UUID(uuid.New().String()),
+		URL:        swag.StringValue(params.URL),
+		Secret:     secret.String(swag.StringValue(params.Secret)),
+		ClusterID:  params.ClusterID,
+		EventTypes: strings.Join(types, eventTypesSeparator),
+		CreatedAt:  time.Now(),
+	}
+	if err := db.Create(s).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to register webhook %s", s.URL)
+	}
+	return s.toModel(), nil
+}
+
+// List returns the webhook subscriptions, only the subscriptions that are notified on the events of the cluster
+// when clusterID is set
+func List(db *gorm.DB, clusterID *strfmt.UUID) (models.WebhookList, error) {
+	query := db.Order("created_at")
+	if clusterID != nil {
+		query = query.Where("cluster_id in (?)", []string{clusterID.String(), ""})
+	}
+	var subscriptions []*Subscription
+	if err := query.Find(&subscriptions).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list webhooks")
+	}
+	list := models.WebhookList{}
+	for _, s := range subscriptions {
+		list = append(list, s.toModel())
+	}
+	return list, nil
+}
+
+// Get returns the webhook subscription, it returns gorm.ErrRecordNotFound if the subscription doesn't exist
+func Get(db *gorm.DB, webhookID strfmt.UUID) (*models.Webhook, error) {
+	var s Subscription
+	if err := db.First(&s, "id = ?", webhookID.String()).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get webhook %s", webhookID)
+	}
+	return s.toModel(), nil
+}
+
+// Deregister deletes the webhook subscription and its delivery history,
+// it returns gorm.ErrRecordNotFound if the subscription doesn't exist
+func Deregister(db *gorm.DB, webhookID strfmt.UUID) error {
+	tx := db.Begin()
+	if tx.Error != nil {
+		return errors.Wrapf(tx.Error, "failed to start transaction")
+	}
+	defer tx.Rollback()
+	reply := tx.Where("id = ?", webhookID.String()).Delete(&Subscription{})
+	if reply.Error != nil {
+		return errors.Wrapf(reply.Error, "failed to delete webhook %s", webhookID)
+	}
+	if reply.RowsAffected == 0 {
+		return errors.Wrapf(gorm.ErrRecordNotFound, "webhook %s", webhookID)
+	}
+	if err := tx.Where("webhook_id = ?", webhookID.String()).Delete(&models.WebhookDelivery{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete the deliveries of webhook %s", webhookID)
+	}
+	if err := tx.Commit().Error; err != nil {
+		return errors.Wrapf(err, "failed to commit transaction")
+	}
+	return nil
+}
+
+// DeregisterCluster deletes the subscriptions to the events of the cluster and their delivery history,
+// it should be called with the transaction that deregisters the cluster
+func DeregisterCluster(tx *gorm.DB, clusterID strfmt.UUID) error {
+	var subscriptions []*Subscription
+	if err := tx.Select("id").Where("cluster_id = ?", clusterID.String()).Find(&subscriptions).Error; err != nil {
+		return errors.Wrapf(err, "failed to get the webhooks of cluster %s", clusterID)
+	}
+	for _, s := range subscriptions {
+		if err := tx.Where("webhook_id = ?", s.ID.String()).Delete(&models.WebhookDelivery{}).Error; err != nil {
+			return errors.Wrapf(err, "failed to delete the deliveries of webhook %s", s.ID)
+		}
+	}
+	if err := tx.Where("cluster_id = ?", clusterID.String()).Delete(&Subscription{}).Error; err != nil {
+		return errors.Wrapf(err, "failed to delete the webhooks of cluster %s", clusterID)
+	}
+	return nil
+}
+
+// ListDeliveries returns the delivery history of the webhook subscription in the order the deliveries were created at,
+// it returns gorm.ErrRecordNotFound if the subscription doesn't exist
+func ListDeliveries(db *gorm.DB, webhookID strfmt.UUID) (models.WebhookDeliveryList, error) {
+	if err := db.First(&Subscription{}, "id = ?", webhookID.String()).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to get webhook %s", webhookID)
+	}
+	deliveries := models.WebhookDeliveryList{}
+	if err := db.Where("webhook_id = ?", webhookID.String()).Order("id").Find(&deliveries).Error; err != nil {
+		return nil, errors.Wrapf(err, "failed to list the deliveries of webhook %s", webhookID)
+	}
+	return deliveries, nil
+}
+
+// DeleteDeliveriesOlderThan deletes the deliveries that were created before the given time
+// and returns how many were deleted
+func DeleteDeliveriesOlderThan(db *gorm.DB, before time.Time) (int, error) {
+	reply := db.Where("created_at < ?", database.Time(before)).Delete(&models.WebhookDelivery{})
+	if reply.Error != nil {
+		return 0, errors.Wrapf(reply.Error, "failed to delete webhook deliveries that were created before %s", before)
+	}
+	return int(reply.RowsAffected), nil
+}
+
+// EventType returns the webhook event type of the state transition, false if webhooks are not notified on it.
+// Only transitions that change the status are notified, not updates of the status info.
+func EventType(event *models.Event) (models.WebhookEventType, bool) {
+	toStatus := swag.StringValue(event.ToStatus)
+	if event.FromStatus == toStatus {
+		return "", false
+	}
+	if event.HostID != "" {
+		switch toStatus {
+		case hostStatusDiscovering:
+			return models.WebhookEventTypeHostDiscovered, true
+		case hostStatusInsufficient:
+			return models.WebhookEventTypeHostInsufficient, true
+		}
+		return "", false
+	}
+	switch toStatus {
+	case clusterStatusReady:
+		return models.WebhookEventTypeClusterReady, true
+	case clusterStatusInstalled:
+		return models.WebhookEventTypeInstallationCompleted, true
+	case clusterStatusError:
+		return models.WebhookEventTypeInstallationFailed, true
+	}
+	return "", false
+}
+
+// Enqueue adds a pending delivery of the event to each webhook subscribed to it. It should be called with the
+// transaction the event was added in, so that the deliveries are added only if the event is.
+func Enqueue(tx *gorm.DB, event *models.Event) error {
+	eventType, ok := EventType(event)
+	if !ok {
+		return nil
+	}
+	var subscriptions []*Subscription
+	if err := tx.Where("cluster_id in (?)", []string{event.ClusterID.String(), ""}).
+		Find(&subscriptions).Error; err != nil {
+		return errors.Wrapf(err, "failed to get the webhooks of cluster %s", event.ClusterID)
+	}
+	payload, err := json.Marshal(&models.WebhookNotification{EventType: eventType, Event: event})
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal the notification of event %d", swag.Int64Value(event.ID))
+	}
+	now := strfmt.DateTime(database.Now())
+	for _, s := range subscriptions {
+		if !s.subscribedTo(eventType) {
+			continue
+		}
+		webhookID := s.ID
+		delivery := &models.WebhookDelivery{
+			WebhookID:     &webhookID,
+			EventID:       event.ID,
+			EventType:     eventType,
+			Payload:       string(payload),
+			Status:        swag.String(models.WebhookDeliveryStatusPending),
+			CreatedAt:     now,
+			NextAttemptAt: now,
+		}
+		if err := tx.Create(delivery).Error; err != nil {
+			return errors.Wrapf(err, "failed to add the delivery of event %d to webhook %s",
+				swag.Int64Value(event.ID), s.ID)
+		}
+	}
+	return nil
+}
+
+// Sign returns the X-Webhook-Signature header of the payload,
+// receivers should compute it with their copy of the secret and compare
+func Sign(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
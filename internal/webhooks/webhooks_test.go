@@ -0,0 +1,394 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/pkg/leader"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func TestWebhooks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "webhooks tests")
+}
+
+func prepareDB() *gorm.DB {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	Expect(err).ShouldNot(HaveOccurred())
+	db.AutoMigrate(&Subscription{}, &models.WebhookDelivery{})
+	return db
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+const testSecret = "0123456789abcdef"
+
+var _ = Describe("subscriptions", func() {
+	var (
+		db        *gorm.DB
+		clusterID strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		clusterID = strfmt.UUID(uuid.New().String())
+	})
+
+	register := func(cluster strfmt.UUID, types ...models.WebhookEventType) *models.Webhook {
+		webhook, err := Register(db, &models.WebhookCreateParams{
+			URL:        swag.String("http://example.com/hook"),
+			Secret:     swag.String(testSecret),
+			ClusterID:  cluster,
+			EventTypes: types,
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		return webhook
+	}
+
+	newEvent := func(cluster, host strfmt.UUID, from, to string) *models.Event {
+		now := strfmt.DateTime(time.Now())
+		return &models.Event{ID: swag.Int64(1), ClusterID: &cluster, HostID: host, FromStatus: from,
+			ToStatus: swag.String(to), EventTime: &now}
+	}
+
+	deliveries := func(webhook *models.Webhook) models.WebhookDeliveryList {
+		list, err := ListDeliveries(db, *webhook.ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		return list
+	}
+
+	It("validate_url", func() {
+		Expect(ValidateURL("https://203.0.113.10/hook", Config{})).ShouldNot(HaveOccurred())
+		Expect(ValidateURL("ftp://203.0.113.10/hook", Config{})).Should(HaveOccurred())
+		Expect(ValidateURL("/hook", Config{})).Should(HaveOccurred())
+	})
+
+	It("validate_url_restricted_addresses", func() {
+		for _, url := range []string{"http://127.0.0.1:8080/hook", "http://localhost/hook", "http://[::1]/hook",
+			"http://169.254.169.254/latest", "http://10.1.2.3/hook", "http://172.16.0.1/hook",
+			"http://192.168.1.1/hook", "http://0.0.0.0/hook", "http://[fe80::1]/hook"} {
+			Expect(ValidateURL(url, Config{})).Should(HaveOccurred(), url)
+		}
+		cfg := Config{AllowedNetworks: []string{"10.0.0.0/8"}}
+		Expect(ValidateURL("http://10.1.2.3/hook", cfg)).ShouldNot(HaveOccurred())
+		Expect(ValidateURL("http://192.168.1.1/hook", cfg)).Should(HaveOccurred())
+		Expect(ValidateURL("http://10.1.2.3/hook", Config{AllowedNetworks: []string{"invalid"}})).
+			Should(HaveOccurred())
+	})
+
+	It("register_list_deregister", func() {
+		webhook := register(clusterID, models.WebhookEventTypeClusterReady, models.WebhookEventTypeClusterReady,
+			models.WebhookEventTypeInstallationCompleted)
+		Expect(webhook.EventTypes).Should(Equal([]models.WebhookEventType{models.WebhookEventTypeClusterReady,
+			models.WebhookEventTypeInstallationCompleted}))
+		global := register("", models.WebhookEventTypeHostDiscovered)
+		register(strfmt.UUID(uuid.New().String()), models.WebhookEventTypeHostDiscovered)
+
+		list, err := List(db, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(3))
+		list, err = List(db, &clusterID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(2))
+		Expect(*list[0].ID).Should(Equal(*webhook.ID))
+		Expect(*list[1].ID).Should(Equal(*global.ID))
+
+		got, err := Get(db, *webhook.ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*got.ID).Should(Equal(*webhook.ID))
+		Expect(got.ClusterID).Should(Equal(clusterID))
+
+		Expect(Enqueue(db, newEvent(clusterID, "", "insufficient", "ready"))).ShouldNot(HaveOccurred())
+		Expect(deliveries(webhook)).Should(HaveLen(1))
+		Expect(Deregister(db, *webhook.ID)).ShouldNot(HaveOccurred())
+		_, err = ListDeliveries(db, *webhook.ID)
+		Expect(gorm.IsRecordNotFoundError(errors.Cause(err))).Should(BeTrue())
+		var count int
+		Expect(db.Model(&models.WebhookDelivery{}).Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).Should(Equal(0))
+		Expect(gorm.IsRecordNotFoundError(errors.Cause(Deregister(db, *webhook.ID)))).Should(BeTrue())
+		_, err = Get(db, *webhook.ID)
+		Expect(gorm.IsRecordNotFoundError(errors.Cause(err))).Should(BeTrue())
+	})
+
+	It("deregister_cluster", func() {
+		webhook := register(clusterID, models.WebhookEventTypeClusterReady)
+		global := register("", models.WebhookEventTypeClusterReady)
+		Expect(Enqueue(db, newEvent(clusterID, "", "insufficient", "ready"))).ShouldNot(HaveOccurred())
+
+		Expect(DeregisterCluster(db, clusterID)).ShouldNot(HaveOccurred())
+		list, err := List(db, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(1))
+		Expect(*list[0].ID).Should(Equal(*global.ID))
+		Expect(deliveries(global)).Should(HaveLen(1))
+		var count int
+		Expect(db.Model(&models.WebhookDelivery{}).Where("webhook_id = ?", webhook.ID.String()).
+			Count(&count).Error).ShouldNot(HaveOccurred())
+		Expect(count).Should(Equal(0))
+	})
+
+	It("event_types", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		for _, t := range []struct {
+			hostID   strfmt.UUID
+			from, to string
+			expected models.WebhookEventType
+		}{
+			{hostID, "", "discovering", models.WebhookEventTypeHostDiscovered},
+			{hostID, "known", "discovering", models.WebhookEventTypeHostDiscovered},
+			{hostID, "discovering", "insufficient", models.WebhookEventTypeHostInsufficient},
+			{hostID, "discovering", "known", ""},
+			{hostID, "insufficient", "insufficient", ""},
+			{"", "insufficient", "ready", models.WebhookEventTypeClusterReady},
+			{"", "installing", "installed", models.WebhookEventTypeInstallationCompleted},
+			{"", "installing", "error", models.WebhookEventTypeInstallationFailed},
+			{"", "", "insufficient", ""},
+		} {
+			eventType, ok := EventType(newEvent(clusterID, t.hostID, t.from, t.to))
+			Expect(ok).Should(Equal(t.expected != ""), "transition from %s to %s", t.from, t.to)
+			Expect(eventType).Should(Equal(t.expected), "transition from %s to %s", t.from, t.to)
+		}
+	})
+
+	It("enqueue", func() {
+		hostID := strfmt.UUID(uuid.New().String())
+		clusterHook := register(clusterID, models.WebhookEventTypeHostDiscovered)
+		globalHook := register("", models.WebhookEventTypeHostDiscovered, models.WebhookEventTypeClusterReady)
+		otherClusterHook := register(strfmt.UUID(uuid.New().String()), models.WebhookEventTypeHostDiscovered)
+
+		Expect(Enqueue(db, newEvent(clusterID, hostID, "", "discovering"))).ShouldNot(HaveOccurred())
+		Expect(Enqueue(db, newEvent(clusterID, "", "insufficient", "ready"))).ShouldNot(HaveOccurred())
+		Expect(Enqueue(db, newEvent(clusterID, hostID, "discovering", "known"))).ShouldNot(HaveOccurred())
+
+		list := deliveries(clusterHook)
+		Expect(list).Should(HaveLen(1))
+		Expect(list[0].EventType).Should(Equal(models.WebhookEventTypeHostDiscovered))
+		Expect(swag.StringValue(list[0].Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+		var notification models.WebhookNotification
+		Expect(json.Unmarshal([]byte(list[0].Payload), &notification)).ShouldNot(HaveOccurred())
+		Expect(notification.EventType).Should(Equal(models.WebhookEventTypeHostDiscovered))
+		Expect(notification.Event.HostID).Should(Equal(hostID))
+
+		list = deliveries(globalHook)
+		Expect(list).Should(HaveLen(2))
+		Expect(list[1].EventType).Should(Equal(models.WebhookEventTypeClusterReady))
+		Expect(deliveries(otherClusterHook)).Should(BeEmpty())
+	})
+
+	It("delete_deliveries_non_utc_offset", func() {
+		hook := register(clusterID, models.WebhookEventTypeHostDiscovered)
+		now := time.Now()
+		for _, age := range []time.Duration{3 * time.Hour, time.Hour} {
+			Expect(db.Create(&models.WebhookDelivery{WebhookID: hook.ID, EventID: swag.Int64(1),
+				Status:    swag.String(models.WebhookDeliveryStatusDelivered),
+				CreatedAt: strfmt.DateTime(now.Add(-age).UTC())}).Error).ShouldNot(HaveOccurred())
+		}
+		for _, offset := range []int{5 * 60 * 60, -7 * 60 * 60} {
+			before := now.Add(-2 * time.Hour).In(time.FixedZone("test", offset))
+			deleted, err := DeleteDeliveriesOlderThan(db, before)
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(deleted).Should(BeNumerically("<=", 1))
+		}
+		Expect(deliveries(hook)).Should(HaveLen(1))
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
+
+var _ = Describe("DeliverPending", func() {
+	var (
+		db         *gorm.DB
+		ctrl       *gomock.Controller
+		mockLeader *leader.MockElectorInterface
+		cfg        Config
+		manager    *Manager
+		server     *httptest.Server
+		responses  chan int
+		requests   chan *http.Request
+		bodies     chan string
+		webhook    *models.Webhook
+		clusterID  strfmt.UUID
+	)
+
+	BeforeEach(func() {
+		db = prepareDB()
+		ctrl = gomock.NewController(GinkgoT())
+		mockLeader = leader.NewMockElectorInterface(ctrl)
+		responses = make(chan int, 10)
+		requests = make(chan *http.Request, 10)
+		bodies = make(chan string, 10)
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			requests <- r
+			bodies <- string(body)
+			w.WriteHeader(<-responses)
+		}))
+		cfg = Config{
+			DeliveryTimeout: 5 * time.Second,
+			MaxAttempts:     2,
+			InitialBackoff:  time.Minute,
+			MaxBackoff:      time.Hour,
+			DeliveryBatch:   10,
+			DeliveryWorkers: 2,
+			// the test server listens on the loopback address
+			AllowedNetworks: []string{"127.0.0.0/8"},
+		}
+		var err error
+		manager, err = NewManager(getTestLog(), cfg, db, mockLeader)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		clusterID = strfmt.UUID(uuid.New().String())
+		webhook, err = Register(db, &models.WebhookCreateParams{
+			URL:        swag.String(server.URL),
+			Secret:     swag.String(testSecret),
+			EventTypes: []models.WebhookEventType{models.WebhookEventTypeClusterReady},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		now := strfmt.DateTime(time.Now())
+		Expect(Enqueue(db, &models.Event{ID: swag.Int64(7), ClusterID: &clusterID, FromStatus: "insufficient",
+			ToStatus: swag.String("ready"), EventTime: &now})).ShouldNot(HaveOccurred())
+	})
+
+	getDelivery := func() *models.WebhookDelivery {
+		list, err := ListDeliveries(db, *webhook.ID)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(list).Should(HaveLen(1))
+		return list[0]
+	}
+
+	It("delivered", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		responses <- http.StatusOK
+
+		manager.DeliverPending()
+
+		req := <-requests
+		body := <-bodies
+		delivery := getDelivery()
+		Expect(req.Method).Should(Equal(http.MethodPost))
+		Expect(req.Header.Get("X-Webhook-Event")).Should(Equal(string(models.WebhookEventTypeClusterReady)))
+		Expect(req.Header.Get("X-Webhook-Delivery")).Should(Equal("1"))
+		Expect(req.Header.Get("X-Webhook-Signature")).Should(Equal(Sign(testSecret, body)))
+		Expect(body).Should(Equal(delivery.Payload))
+		Expect(swag.StringValue(delivery.Status)).Should(Equal(models.WebhookDeliveryStatusDelivered))
+		Expect(delivery.Attempts).Should(Equal(int64(1)))
+		Expect(delivery.ResponseCode).Should(Equal(int64(http.StatusOK)))
+	})
+
+	It("retried_until_failed", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(3)
+		responses <- http.StatusInternalServerError
+
+		manager.DeliverPending()
+		<-requests
+		delivery := getDelivery()
+		Expect(swag.StringValue(delivery.Status)).Should(Equal(models.WebhookDeliveryStatusPending))
+		Expect(delivery.Attempts).Should(Equal(int64(1)))
+		Expect(delivery.ResponseCode).Should(Equal(int64(http.StatusInternalServerError)))
+		Expect(delivery.LastError).ShouldNot(BeEmpty())
+		Expect(time.Time(delivery.NextAttemptAt).After(time.Now().Add(50 * time.Second))).Should(BeTrue())
+
+		// the backoff didn't pass yet
+		manager.DeliverPending()
+		Expect(getDelivery().Attempts).Should(Equal(int64(1)))
+
+		Expect(db.Model(&models.WebhookDelivery{}).Where("id = ?", *delivery.ID).
+			Update("next_attempt_at", strfmt.DateTime(time.Now())).Error).ShouldNot(HaveOccurred())
+		responses <- http.StatusBadGateway
+		manager.DeliverPending()
+		<-requests
+		delivery = getDelivery()
+		Expect(swag.StringValue(delivery.Status)).Should(Equal(models.WebhookDeliveryStatusFailed))
+		Expect(delivery.Attempts).Should(Equal(int64(2)))
+		Expect(delivery.ResponseCode).Should(Equal(int64(http.StatusBadGateway)))
+	})
+
+	It("restricted_address", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		cfg.AllowedNetworks = nil
+		var err error
+		manager, err = NewManager(getTestLog(), cfg, db, mockLeader)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		manager.DeliverPending()
+		delivery := getDelivery()
+		Expect(requests).Should(BeEmpty())
+		Expect(delivery.Attempts).Should(Equal(int64(1)))
+		Expect(delivery.ResponseCode).Should(Equal(int64(0)))
+		Expect(delivery.LastError).Should(ContainSubstring("loopback"))
+	})
+
+	It("delivered_to_each_webhook", func() {
+		mockLeader.EXPECT().IsLeader().Return(true).Times(1)
+		other, err := Register(db, &models.WebhookCreateParams{
+			URL:        swag.String(server.URL),
+			Secret:     swag.String(testSecret),
+			EventTypes: []models.WebhookEventType{models.WebhookEventTypeClusterReady},
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		now := strfmt.DateTime(time.Now())
+		Expect(Enqueue(db, &models.Event{ID: swag.Int64(8), ClusterID: &clusterID, FromStatus: "insufficient",
+			ToStatus: swag.String("ready"), EventTime: &now})).ShouldNot(HaveOccurred())
+		for i := 0; i < 3; i++ {
+			responses <- http.StatusOK
+		}
+
+		manager.DeliverPending()
+		for _, w := range []*models.Webhook{webhook, other} {
+			list, err := ListDeliveries(db, *w.ID)
+			Expect(err).ShouldNot(HaveOccurred())
+			for _, delivery := range list {
+				Expect(swag.StringValue(delivery.Status)).Should(Equal(models.WebhookDeliveryStatusDelivered))
+			}
+		}
+		Expect(requests).Should(HaveLen(3))
+	})
+
+	It("invalid_config", func() {
+		_, err := NewManager(getTestLog(), Config{DeliveryWorkers: 1, DeliveryBatch: 1,
+			AllowedNetworks: []string{"10.0.0.0"}}, db, mockLeader)
+		Expect(err).Should(HaveOccurred())
+		_, err = NewManager(getTestLog(), Config{DeliveryBatch: 1}, db, mockLeader)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("not_leader", func() {
+		mockLeader.EXPECT().IsLeader().Return(false).Times(1)
+		manager.DeliverPending()
+		Expect(getDelivery().Attempts).Should(Equal(int64(0)))
+	})
+
+	It("backoff", func() {
+		Expect(manager.backoff(1)).Should(Equal(time.Minute))
+		Expect(manager.backoff(3)).Should(Equal(4 * time.Minute))
+		Expect(manager.backoff(10)).Should(Equal(time.Hour))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+		db.Close()
+	})
+})
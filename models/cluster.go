@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"strconv"
 
+	"github.com/filanov/bm-inventory/pkg/secret"
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -27,8 +28,15 @@ type Cluster struct {
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
+	// The master host that bootstraps the installation. Either pinned by the user or elected when the installation starts.
+	// Format: uuid
+	BootstrapHostID strfmt.UUID `json:"bootstrap_host_id,omitempty"`
+
+	// Explains why the bootstrap host was chosen.
+	BootstrapInfo string `json:"bootstrap_info,omitempty"`
+
 	// IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$
 	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
 
 	// The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.
@@ -44,6 +52,9 @@ type Cluster struct {
 	// Format: ipv4
 	DNSVip strfmt.IPv4 `json:"dns_vip,omitempty"`
 
+	// Name of the hardware requirement profile the cluster hosts are validated against.
+	HardwareProfile string `json:"hardware_profile,omitempty"`
+
 	// Hosts that are associated with this cluster.
 	Hosts []*Host `json:"hosts" gorm:"foreignkey:ClusterID;association_foreignkey:ID"`
 
@@ -73,6 +84,10 @@ type Cluster struct {
 	// Enum: [Cluster]
 	Kind *string `json:"kind"`
 
+	// The IPv4 networks that all the cluster hosts are connected to, detected from the NICs of the hosts. The VIPs are chosen from these networks.
+	// Read Only: true
+	MachineNetworks []string `json:"machine_networks" gorm:"-"`
+
 	// Name of the OpenShift cluster.
 	Name string `json:"name,omitempty"`
 
@@ -80,15 +95,21 @@ type Cluster struct {
 	// Enum: [4.4]
 	OpenshiftVersion string `json:"openshift_version,omitempty"`
 
-	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
-	PullSecret string `json:"pull_secret,omitempty" gorm:"type:varchar(4096)"`
+	// The organization of the user that registered the cluster, the users of the organization can access the cluster.
+	Organization string `json:"organization,omitempty" gorm:"index"`
+
+	// The user that registered the cluster.
+	Owner string `json:"owner,omitempty" gorm:"index"`
+
+	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site. It is encrypted in the DB and redacted in the responses unless requested.
+	PullSecret secret.String `json:"pull_secret,omitempty" gorm:"type:text"`
 
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
 
-	// SSH public key for debugging OpenShift nodes.
-	SSHPublicKey string `json:"ssh_public_key,omitempty" gorm:"type:varchar(1024)"`
+	// SSH public key for debugging OpenShift nodes. It is encrypted in the DB and redacted in the responses unless requested.
+	SSHPublicKey secret.String `json:"ssh_public_key,omitempty" gorm:"type:text"`
 
 	// Status of the OpenShift cluster.
 	// Required: true
@@ -102,6 +123,9 @@ type Cluster struct {
 	// The last time that this cluster was updated.
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:datetime"`
+
+	// The results (validation-results) of the last validation of the cluster readiness for installation, in JSON format.
+	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
 }
 
 // Validate validates this cluster
@@ -112,6 +136,10 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateBootstrapHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -160,10 +188,18 @@ func (m *Cluster) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePullSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateServiceNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSSHPublicKey(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateStatus(formats); err != nil {
 		res = append(res, err)
 	}
@@ -195,13 +231,26 @@ func (m *Cluster) validateAPIVip(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validateBootstrapHostID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BootstrapHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("bootstrap_host_id", "body", "uuid", m.BootstrapHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateClusterNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$`); err != nil {
 		return err
 	}
 
@@ -417,13 +466,45 @@ func (m *Cluster) validateOpenshiftVersion(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Cluster) validatePullSecret(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PullSecret) { // not required
+		return nil
+	}
+
+	if err := m.PullSecret.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("pull_secret")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *Cluster) validateServiceNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ServiceNetworkCidr) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Cluster) validateSSHPublicKey(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.SSHPublicKey) { // not required
+		return nil
+	}
+
+	if err := m.SSHPublicKey.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("ssh_public_key")
+		}
 		return err
 	}
 
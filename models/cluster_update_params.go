@@ -27,8 +27,12 @@ type ClusterUpdateParams struct {
 	// Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.
 	BaseDNSDomain string `json:"base_dns_domain,omitempty"`
 
+	// The master host that will bootstrap the installation, instead of the one chosen by the bootstrap election policy.
+	// Format: uuid
+	BootstrapHostID strfmt.UUID `json:"bootstrap_host_id,omitempty"`
+
 	// IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$
 	ClusterNetworkCidr string `json:"cluster_network_cidr,omitempty"`
 
 	// The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.
@@ -40,6 +44,9 @@ type ClusterUpdateParams struct {
 	// Format: ipv4
 	DNSVip strfmt.IPv4 `json:"dns_vip,omitempty"`
 
+	// Name of the hardware requirement profile the cluster hosts are validated against.
+	HardwareProfile string `json:"hardware_profile,omitempty"`
+
 	// The desired role for hosts associated with the cluster.
 	HostsRoles []*ClusterUpdateParamsHostsRolesItems0 `json:"hosts_roles" gorm:"type:varchar(64)[]"`
 
@@ -50,11 +57,11 @@ type ClusterUpdateParams struct {
 	// OpenShift cluster name
 	Name string `json:"name,omitempty"`
 
-	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.
+	// The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site..
 	PullSecret string `json:"pull_secret,omitempty"`
 
 	// The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.
-	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$
+	// Pattern: ^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$
 	ServiceNetworkCidr string `json:"service_network_cidr,omitempty"`
 
 	// SSH public key for debugging OpenShift nodes.
@@ -69,6 +76,10 @@ func (m *ClusterUpdateParams) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateBootstrapHostID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterNetworkCidr(formats); err != nil {
 		res = append(res, err)
 	}
@@ -112,13 +123,26 @@ func (m *ClusterUpdateParams) validateAPIVip(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *ClusterUpdateParams) validateBootstrapHostID(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.BootstrapHostID) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("bootstrap_host_id", "body", "uuid", m.BootstrapHostID.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *ClusterUpdateParams) validateClusterNetworkCidr(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ClusterNetworkCidr) { // not required
 		return nil
 	}
 
-	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("cluster_network_cidr", "body", string(m.ClusterNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$`); err != nil {
 		return err
 	}
 
@@ -199,7 +223,7 @@ func (m *ClusterUpdateParams) validateServiceNetworkCidr(formats strfmt.Registry
 		return nil
 	}
 
-	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/[0-9]|[1-2][0-9]|3[0-2]?$`); err != nil {
+	if err := validate.Pattern("service_network_cidr", "body", string(m.ServiceNetworkCidr), `^([0-9]{1,3}\.){3}[0-9]{1,3}\/([0-9]|[1-2][0-9]|3[0-2])$`); err != nil {
 		return err
 	}
 
@@ -234,7 +258,7 @@ type ClusterUpdateParamsHostsRolesItems0 struct {
 	ID strfmt.UUID `json:"id,omitempty"`
 
 	// role
-	// Enum: [master worker]
+	// Enum: [master worker auto-assign]
 	Role string `json:"role,omitempty"`
 }
 
@@ -273,7 +297,7 @@ var clusterUpdateParamsHostsRolesItems0TypeRolePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["master","worker"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["master","worker","auto-assign"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -288,6 +312,9 @@ const (
 
 	// ClusterUpdateParamsHostsRolesItems0RoleWorker captures enum value "worker"
 	ClusterUpdateParamsHostsRolesItems0RoleWorker string = "worker"
+
+	// ClusterUpdateParamsHostsRolesItems0RoleAutoAssign captures enum value "auto-assign"
+	ClusterUpdateParamsHostsRolesItems0RoleAutoAssign string = "auto-assign"
 )
 
 // prop value enum
@@ -0,0 +1,39 @@
+package models
+
+// This file is not generated, it is kept when the models are regenerated from swagger.yaml.
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// Value stores the connectivity report in the DB as JSON text
+func (m ConnectivityReport) Value() (driver.Value, error) {
+	b, err := json.Marshal(&m)
+	if err != nil {
+		return nil, err
+	}
+	return string(b), nil
+}
+
+// Scan loads the connectivity report from its JSON text in the DB, an empty value is loaded as an empty report
+func (m *ConnectivityReport) Scan(src interface{}) error {
+	var b []byte
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case string:
+		b = []byte(v)
+	case []byte:
+		b = v
+	default:
+		return errors.Errorf("cannot scan %T into a connectivity report", src)
+	}
+	*m = ConnectivityReport{}
+	if len(b) == 0 {
+		return nil
+	}
+	return json.Unmarshal(b, m)
+}
@@ -7,6 +7,7 @@ package models
 
 import (
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -23,6 +24,9 @@ type Error struct {
 	// Required: true
 	Code *string `json:"code"`
 
+	// The invalid fields of the request, when the error is caused by them.
+	FieldErrors []*FieldError `json:"field_errors"`
+
 	// Self link.
 	// Required: true
 	Href *string `json:"href"`
@@ -51,6 +55,10 @@ func (m *Error) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFieldErrors(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHref(formats); err != nil {
 		res = append(res, err)
 	}
@@ -82,6 +90,31 @@ func (m *Error) validateCode(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Error) validateFieldErrors(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.FieldErrors) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.FieldErrors); i++ {
+		if swag.IsZero(m.FieldErrors[i]) { // not required
+			continue
+		}
+
+		if m.FieldErrors[i] != nil {
+			if err := m.FieldErrors[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("field_errors" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Error) validateHref(formats strfmt.Registry) error {
 
 	if err := validate.Required("href", "body", m.Href); err != nil {
@@ -22,17 +22,28 @@ type Host struct {
 	// bootstrap
 	Bootstrap bool `json:"bootstrap,omitempty"`
 
+	// The last time the host's agent communicated with the service.
+	// Format: date-time
+	CheckedInAt strfmt.DateTime `json:"checked_in_at,omitempty" gorm:"type:datetime"`
+
 	// The cluster that this host is associated with.
 	// Format: uuid
 	ClusterID strfmt.UUID `json:"cluster_id,omitempty" gorm:"primary_key;foreignkey:Cluster"`
 
 	// connectivity
-	Connectivity *ConnectivityReport `json:"connectivity,omitempty"`
+	Connectivity *ConnectivityReport `json:"connectivity,omitempty" gorm:"type:text"`
 
 	// created at
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty" gorm:"type:datetime"`
 
+	// The free addresses of the machine networks (free-networks-addresses) found by the last scan of the host, in JSON format.
+	FreeAddresses string `json:"free_addresses,omitempty" gorm:"type:text"`
+
+	// The last time the host reported the free addresses of the machine networks.
+	// Format: date-time
+	FreeAddressesUpdatedAt strfmt.DateTime `json:"free_addresses_updated_at,omitempty" gorm:"type:datetime"`
+
 	// hardware info
 	HardwareInfo string `json:"hardware_info,omitempty" gorm:"type:text"`
 
@@ -45,15 +56,24 @@ type Host struct {
 	// Format: uuid
 	ID *strfmt.UUID `json:"id" gorm:"primary_key"`
 
+	// The disk the host will be installed on, as set by the user. Identified by its name, by-path, WWN or serial number. Empty if the disk is chosen by the default selection policy.
+	InstallationDisk string `json:"installation_disk,omitempty"`
+
+	// The last inventory (inventory) received from the host, in JSON format.
+	Inventory string `json:"inventory,omitempty" gorm:"type:text"`
+
 	// Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.
 	// Required: true
 	// Enum: [Host]
 	Kind *string `json:"kind"`
 
-	// role
-	// Enum: [undefined master worker]
+	// The host role. Hosts with the auto-assign role are given the master or worker role when the cluster installation starts.
+	// Enum: [undefined master worker auto-assign]
 	Role string `json:"role,omitempty"`
 
+	// Explains why the role was chosen, for hosts whose role was automatically assigned.
+	RoleInfo string `json:"role_info,omitempty"`
+
 	// status
 	// Required: true
 	// Enum: [discovering known disconnected insufficient disabled installing installed error]
@@ -66,12 +86,19 @@ type Host struct {
 	// updated at
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty" gorm:"type:datetime"`
+
+	// The results (validation-results) of the last validation of the host hardware, in JSON format.
+	ValidationsInfo string `json:"validations_info,omitempty" gorm:"type:text"`
 }
 
 // Validate validates this host
 func (m *Host) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCheckedInAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClusterID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -84,6 +111,10 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateFreeAddressesUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHref(formats); err != nil {
 		res = append(res, err)
 	}
@@ -118,6 +149,19 @@ func (m *Host) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateCheckedInAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CheckedInAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("checked_in_at", "body", "date-time", m.CheckedInAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateClusterID(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.ClusterID) { // not required
@@ -162,6 +206,19 @@ func (m *Host) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Host) validateFreeAddressesUpdatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.FreeAddressesUpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("free_addresses_updated_at", "body", "date-time", m.FreeAddressesUpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Host) validateHref(formats strfmt.Registry) error {
 
 	if err := validate.Required("href", "body", m.Href); err != nil {
@@ -228,7 +285,7 @@ var hostTypeRolePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["undefined","master","worker"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["undefined","master","worker","auto-assign"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -246,6 +303,9 @@ const (
 
 	// HostRoleWorker captures enum value "worker"
 	HostRoleWorker string = "worker"
+
+	// HostRoleAutoAssign captures enum value "auto-assign"
+	HostRoleAutoAssign string = "auto-assign"
 )
 
 // prop value enum
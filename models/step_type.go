@@ -31,6 +31,9 @@ const (
 
 	// StepTypeInventory captures enum value "inventory"
 	StepTypeInventory StepType = "inventory"
+
+	// StepTypeFreeNetworkAddresses captures enum value "free-network-addresses"
+	StepTypeFreeNetworkAddresses StepType = "free-network-addresses"
 )
 
 // for schema
@@ -38,7 +41,7 @@ var stepTypeEnum []interface{}
 
 func init() {
 	var res []StepType
-	if err := json.Unmarshal([]byte(`["hardware-info","connectivity-check","execute","inventory"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["hardware-info","connectivity-check","execute","inventory","free-network-addresses"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
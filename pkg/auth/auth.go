@@ -0,0 +1,114 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/filanov/bm-inventory/restapi"
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// TypeStatic authenticates the users by tokens that are configured in the service, for local deployments
+	TypeStatic = "static"
+	// TypeJWT authenticates the users by JWTs that are signed by the configured issuer
+	TypeJWT = "jwt"
+
+	bearerPrefix = "bearer "
+)
+
+// Config of the user authentication.
+//
+// Static tokens are configured as token:user:organization, the organization may be omitted. The users of JWTs
+// and their organizations are taken from the JWTUserClaim and JWTOrgClaim claims. The signing keys are read from
+// JWKSURL, or from the jwks_uri of the issuer OpenID configuration when it is not set.
+//
+// Roles are configured as role:permission|permission, and the roles of the users as user:role|role. The roles of
+// JWT users are also taken from the JWTRolesClaim claim. The users without roles get the DefaultRole, which only
+// reads by default, the other permissions must be granted explicitly.
+type Config struct {
+	AuthType      string            `envconfig:"AUTH_TYPE" default:"static"`
+	StaticTokens  []string          `envconfig:"AUTH_STATIC_TOKENS"`
+	AdminUsers    []string          `envconfig:"AUTH_ADMIN_USERS"`
+	Roles         map[string]string `envconfig:"AUTH_ROLES" default:"viewer:read,user:read|write|install,admin:read|write|install|debug|sensitive|admin"`
+	UserRoles     map[string]string `envconfig:"AUTH_USER_ROLES"`
+	DefaultRole   string            `envconfig:"AUTH_DEFAULT_ROLE" default:"viewer"`
+	JWTIssuer     string            `envconfig:"JWT_ISSUER" default:""`
+	JWTAudience   string            `envconfig:"JWT_AUDIENCE" default:""`
+	JWKSURL       string            `envconfig:"JWT_JWKS_URL" default:""`
+	JWTUserClaim  string            `envconfig:"JWT_USER_CLAIM" default:"sub"`
+	JWTOrgClaim   string            `envconfig:"JWT_ORG_CLAIM" default:"org_id"`
+	JWTRolesClaim string            `envconfig:"JWT_ROLES_CLAIM" default:"roles"`
+}
+
+// User is the authenticated user of a request
+type User struct {
+	Name string
+	// Organization is empty for users that don't belong to an organization
+	Organization string
+	Roles        []string
+	// Permissions are granted by the roles of the user
+	Permissions []Permission
+	// Admin users access the resources of all the users
+	Admin bool
+}
+
+// HasPermission returns whether the user was granted the permission
+func (u *User) HasPermission(permission Permission) bool {
+	for _, p := range u.Permissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticator validates the bearer tokens of the users
+type Authenticator interface {
+	// Authenticate returns the user of the bearer token, or an error if the token is invalid
+	Authenticate(token string) (*User, error)
+}
+
+// NewAuthenticator returns the authenticator of the configured auth type
+func NewAuthenticator(log logrus.FieldLogger, cfg Config) (Authenticator, error) {
+	switch cfg.AuthType {
+	case TypeStatic:
+		return newStaticAuthenticator(cfg)
+	case TypeJWT:
+		return newJWTAuthenticator(log, cfg)
+	}
+	return nil, errors.Errorf("unknown auth type %s, expected %s or %s", cfg.AuthType, TypeStatic, TypeJWT)
+}
+
+// UserAuth returns the function that authenticates the Authorization header of the user requests and grants the
+// users their permissions, it is set as restapi.Config.AuthUserAuth and the users it returns are stored in the
+// request contexts
+func UserAuth(log logrus.FieldLogger, authenticator Authenticator, authorizer *Authorizer) func(string) (interface{}, error) {
+	return func(header string) (interface{}, error) {
+		if !strings.HasPrefix(strings.ToLower(header), bearerPrefix) {
+			log.Warn("rejected user request without a bearer token")
+			return nil, openapierrors.New(http.StatusUnauthorized, "a bearer token is expected")
+		}
+		user, err := authenticator.Authenticate(strings.TrimSpace(header[len(bearerPrefix):]))
+		if err != nil {
+			log.WithError(err).Warn("rejected user request with invalid credentials")
+			return nil, openapierrors.New(http.StatusUnauthorized, "invalid credentials")
+		}
+		authorizer.grant(user)
+		return user, nil
+	}
+}
+
+// FromContext returns the authenticated user of the request, nil if the request is not authenticated
+func FromContext(ctx context.Context) *User {
+	user, _ := ctx.Value(restapi.AuthKey).(*User)
+	return user
+}
+
+// ToContext stores the authenticated user of the request in its context
+func ToContext(ctx context.Context, user *User) context.Context {
+	return context.WithValue(ctx, restapi.AuthKey, user)
+}
@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestAuth(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "auth tests")
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+var _ = Describe("static", func() {
+	var cfg Config
+
+	BeforeEach(func() {
+		cfg = Config{
+			AuthType:     TypeStatic,
+			StaticTokens: []string{"token1:user1:org1", "token2:user2"},
+			AdminUsers:   []string{"user2"},
+			Roles:        map[string]string{"user": "read|write"},
+			DefaultRole:  "user",
+		}
+	})
+
+	authenticate := func(header string) (*User, error) {
+		authenticator, err := NewAuthenticator(getTestLog(), cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		authorizer, err := NewAuthorizer(getTestLog(), cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		principal, err := UserAuth(getTestLog(), authenticator, authorizer)(header)
+		if err != nil {
+			return nil, err
+		}
+		return principal.(*User), nil
+	}
+
+	It("authenticate", func() {
+		user, err := authenticate("Bearer token1")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(user.Name).Should(Equal("user1"))
+		Expect(user.Organization).Should(Equal("org1"))
+		Expect(user.Admin).Should(BeFalse())
+
+		user, err = authenticate("bearer token2")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(user.Name).Should(Equal("user2"))
+		Expect(user.Organization).Should(BeEmpty())
+		Expect(user.Admin).Should(BeTrue())
+	})
+
+	It("invalid_credentials", func() {
+		for _, header := range []string{"Bearer token3", "Bearer ", "token1", "Basic token1", ""} {
+			_, err := authenticate(header)
+			Expect(err).Should(HaveOccurred(), header)
+		}
+	})
+
+	It("invalid_config", func() {
+		for _, tokens := range [][]string{nil, {"token1"}, {":user1"}, {"token1:"}, {"token1:user1:org1:extra"}} {
+			cfg.StaticTokens = tokens
+			_, err := NewAuthenticator(getTestLog(), cfg)
+			Expect(err).Should(HaveOccurred())
+		}
+		cfg.AuthType = "none"
+		cfg.StaticTokens = []string{"token1:user1"}
+		_, err := NewAuthenticator(getTestLog(), cfg)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("context", func() {
+		Expect(FromContext(context.Background())).Should(BeNil())
+		user := &User{Name: "user1"}
+		Expect(FromContext(ToContext(context.Background(), user))).Should(Equal(user))
+	})
+})
+
+var _ = Describe("jwt", func() {
+	var (
+		server        *httptest.Server
+		key           *rsa.PrivateKey
+		keyID         string
+		cfg           Config
+		authenticator Authenticator
+		jwksRequests  int
+	)
+
+	BeforeEach(func() {
+		var err error
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ShouldNot(HaveOccurred())
+		keyID = "key1"
+		jwksRequests = 0
+		mux := http.NewServeMux()
+		server = httptest.NewServer(mux)
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+			Expect(json.NewEncoder(w).Encode(map[string]string{"issuer": server.URL, "jwks_uri": server.URL + "/keys"})).
+				ShouldNot(HaveOccurred())
+		})
+		mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+			jwksRequests++
+			w.Header().Set("Cache-Control", "max-age=300")
+			jwk := map[string]string{
+				"kty": "RSA",
+				"use": "sig",
+				"kid": keyID,
+				"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}
+			Expect(json.NewEncoder(w).Encode(map[string]interface{}{"keys": []interface{}{jwk}})).
+				ShouldNot(HaveOccurred())
+		})
+		cfg = Config{
+			AuthType:      TypeJWT,
+			JWTIssuer:     server.URL,
+			JWTAudience:   "bm-inventory",
+			JWTUserClaim:  "preferred_username",
+			JWTOrgClaim:   "org_id",
+			JWTRolesClaim: "roles",
+		}
+		authenticator, err = NewAuthenticator(getTestLog(), cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	sign := func(alg, kid string, claims map[string]interface{}) string {
+		header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+		Expect(err).ShouldNot(HaveOccurred())
+		payload, err := json.Marshal(claims)
+		Expect(err).ShouldNot(HaveOccurred())
+		signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+		h := crypto.SHA256.New()
+		h.Write([]byte(signed))
+		signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, h.Sum(nil))
+		Expect(err).ShouldNot(HaveOccurred())
+		return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+	}
+
+	validClaims := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss":                server.URL,
+			"aud":                []string{"other", "bm-inventory"},
+			"exp":                time.Now().Add(time.Hour).Unix(),
+			"preferred_username": "user1",
+			"org_id":             "org1",
+			"roles":              []string{"viewer"},
+		}
+	}
+
+	It("authenticate", func() {
+		user, err := authenticator.Authenticate(sign("RS256", keyID, validClaims()))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(*user).Should(Equal(User{Name: "user1", Organization: "org1", Roles: []string{"viewer"}}))
+
+		// the keys are cached
+		_, err = authenticator.Authenticate(sign("RS256", "", validClaims()))
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(jwksRequests).Should(Equal(1))
+	})
+
+	It("jwks_url", func() {
+		cfg.JWKSURL = server.URL + "/keys"
+		cfg.JWTIssuer = "https://sso.example.com/auth"
+		authenticator, err := NewAuthenticator(getTestLog(), cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		claims := validClaims()
+		claims["iss"] = cfg.JWTIssuer
+		_, err = authenticator.Authenticate(sign("RS256", keyID, claims))
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	It("invalid_claims", func() {
+		for _, update := range []func(claims map[string]interface{}){
+			func(claims map[string]interface{}) { claims["iss"] = "https://other.example.com" },
+			func(claims map[string]interface{}) { claims["aud"] = "other" },
+			func(claims map[string]interface{}) { delete(claims, "exp") },
+			func(claims map[string]interface{}) { claims["exp"] = time.Now().Add(-time.Hour).Unix() },
+			func(claims map[string]interface{}) { claims["nbf"] = time.Now().Add(time.Hour).Unix() },
+			func(claims map[string]interface{}) { delete(claims, "preferred_username") },
+		} {
+			claims := validClaims()
+			update(claims)
+			_, err := authenticator.Authenticate(sign("RS256", keyID, claims))
+			Expect(err).Should(HaveOccurred())
+		}
+	})
+
+	It("invalid_signature", func() {
+		token := sign("RS256", keyID, validClaims())
+		other := sign("RS256", keyID, map[string]interface{}{"iss": server.URL})
+		_, err := authenticator.Authenticate(token[:len(token)-10] + other[len(other)-10:])
+		Expect(err).Should(HaveOccurred())
+
+		_, err = authenticator.Authenticate(sign("HS256", keyID, validClaims()))
+		Expect(err).Should(HaveOccurred())
+		_, err = authenticator.Authenticate("not.a.jwt")
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("unknown_key_id", func() {
+		_, err := authenticator.Authenticate(sign("RS256", keyID, validClaims()))
+		Expect(err).ShouldNot(HaveOccurred())
+		// the keys are not fetched again for unknown key IDs until they expire by their cache headers
+		_, err = authenticator.Authenticate(sign("RS256", "key2", validClaims()))
+		Expect(err).Should(HaveOccurred())
+		Expect(jwksRequests).Should(Equal(1))
+	})
+
+	It("invalid_config", func() {
+		cfg.JWTIssuer = ""
+		_, err := NewAuthenticator(getTestLog(), cfg)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+})
@@ -0,0 +1,205 @@
+package auth
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	openapierrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/thoas/go-funk"
+)
+
+// Permission is granted to the users by their roles and required by the operations of the API
+type Permission string
+
+const (
+	// PermissionRead permits reading the clusters, their hosts, images, events and artifacts
+	PermissionRead Permission = "read"
+	// PermissionWrite permits changing the clusters and their hosts
+	PermissionWrite Permission = "write"
+	// PermissionInstall permits installing the clusters
+	PermissionInstall Permission = "install"
+	// PermissionDebug permits running debug commands on the hosts
+	PermissionDebug Permission = "debug"
+	// PermissionSensitive permits downloading the sensitive artifacts of the clusters, such as kubeconfig
+	PermissionSensitive Permission = "sensitive"
+	// PermissionAdmin permits accessing the resources of all the users
+	PermissionAdmin Permission = "admin"
+
+	permissionsSeparator = "|"
+)
+
+var allPermissions = []Permission{PermissionRead, PermissionWrite, PermissionInstall, PermissionDebug,
+	PermissionSensitive, PermissionAdmin}
+
+// operationPermissions maps the user operations to the permissions they require, operations that aren't mapped
+// are denied. The agent operations are authenticated by the agent tokens and aren't authorized by roles.
+var operationPermissions = map[string]Permission{
+	"ListClusters":          PermissionRead,
+	"GetCluster":            PermissionRead,
+	"DownloadClusterISO":    PermissionRead,
+	"ListClusterEvents":     PermissionRead,
+	"WatchCluster":          PermissionRead,
+	"ListClusterArtifacts":  PermissionRead,
+	"GetFreeAddresses":      PermissionRead,
+	"DownloadClusterFiles":  PermissionRead,
+	"ListClusterImages":     PermissionRead,
+	"GetClusterImage":       PermissionRead,
+	"ListHosts":             PermissionRead,
+	"GetHost":               PermissionRead,
+	"ListHardwareProfiles":  PermissionRead,
+	"GetHardwareProfile":    PermissionRead,
+	"ListWebhooks":          PermissionRead,
+	"ListWebhookDeliveries": PermissionRead,
+
+	"RegisterCluster":         PermissionWrite,
+	"UpdateCluster":           PermissionWrite,
+	"DeregisterCluster":       PermissionWrite,
+	"GenerateClusterISO":      PermissionWrite,
+	"DeleteClusterImage":      PermissionWrite,
+	"RevokeAgentToken":        PermissionWrite,
+	"DeregisterHost":          PermissionWrite,
+	"SetHostInstallationDisk": PermissionWrite,
+	"EnableHost":              PermissionWrite,
+	"DisableHost":             PermissionWrite,
+	"RegisterWebhook":         PermissionWrite,
+	"DeregisterWebhook":       PermissionWrite,
+
+	"InstallCluster": PermissionInstall,
+
+	"SetDebugStep": PermissionDebug,
+}
+
+// Authorizer grants the users the permissions of their roles and authorizes their requests by these permissions
+type Authorizer struct {
+	log         logrus.FieldLogger
+	roles       map[string][]Permission
+	userRoles   map[string][]string
+	defaultRole string
+	adminUsers  []string
+}
+
+// NewAuthorizer returns the authorizer of the configured roles
+func NewAuthorizer(log logrus.FieldLogger, cfg Config) (*Authorizer, error) {
+	a := &Authorizer{
+		log:         log,
+		roles:       make(map[string][]Permission),
+		userRoles:   make(map[string][]string),
+		defaultRole: cfg.DefaultRole,
+		adminUsers:  cfg.AdminUsers,
+	}
+	for role, value := range cfg.Roles {
+		var permissions []Permission
+		for _, p := range strings.Split(value, permissionsSeparator) {
+			permission := Permission(strings.TrimSpace(p))
+			if !funk.Contains(allPermissions, permission) {
+				return nil, errors.Errorf("unknown permission %s of role %s", permission, role)
+			}
+			permissions = append(permissions, permission)
+		}
+		a.roles[role] = permissions
+	}
+	for user, value := range cfg.UserRoles {
+		for _, role := range strings.Split(value, permissionsSeparator) {
+			if _, ok := a.roles[role]; !ok {
+				return nil, errors.Errorf("unknown role %s of user %s", role, user)
+			}
+			a.userRoles[user] = append(a.userRoles[user], role)
+		}
+	}
+	if _, ok := a.roles[a.defaultRole]; a.defaultRole != "" && !ok {
+		return nil, errors.Errorf("unknown default role %s", a.defaultRole)
+	}
+	return a, nil
+}
+
+// grant sets the permissions of the authenticated user: the permissions of the roles the user was given by the
+// issuer and by the configuration, or of the default role when the user has neither. The admin users are granted
+// all the permissions.
+func (a *Authorizer) grant(user *User) {
+	user.Roles = append(user.Roles, a.userRoles[user.Name]...)
+	if len(user.Roles) == 0 && a.defaultRole != "" {
+		user.Roles = []string{a.defaultRole}
+	}
+	user.Permissions = nil
+	if funk.ContainsString(a.adminUsers, user.Name) {
+		user.Permissions = append(user.Permissions, allPermissions...)
+	}
+	for _, role := range user.Roles {
+		for _, permission := range a.roles[role] {
+			if !user.HasPermission(permission) {
+				user.Permissions = append(user.Permissions, permission)
+			}
+		}
+	}
+	user.Admin = user.HasPermission(PermissionAdmin)
+}
+
+// Authorize is set as restapi.Config.Authorizer, it denies the requests of users that lack the permission the
+// operation requires, the denials are audited
+func (a *Authorizer) Authorize(r *http.Request) error {
+	route := middleware.MatchedRouteFrom(r)
+	if route == nil || route.Operation == nil {
+		return errors.Errorf("unknown operation")
+	}
+	operation := route.Operation.ID
+	user := FromContext(r.Context())
+	permission, ok := operationPermissions[operation]
+	if user != nil && ok && user.HasPermission(permission) {
+		return nil
+	}
+
+	fields := logrus.Fields{
+		"audit":     "authorization",
+		"operation": operation,
+		"method":    r.Method,
+		"path":      r.URL.Path,
+	}
+	var reason string
+	switch {
+	case user == nil:
+		reason = fmt.Sprintf("the request is not authenticated, %s is denied", operation)
+	case !ok:
+		fields["user"] = user.Name
+		reason = fmt.Sprintf("%s is not permitted to any user", operation)
+	default:
+		fields["user"] = user.Name
+		fields["organization"] = user.Organization
+		fields["roles"] = user.Roles
+		fields["permission"] = permission
+		reason = fmt.Sprintf("user %s is not permitted to %s, the %s permission is required", user.Name,
+			operation, permission)
+	}
+	logutil.FromContext(r.Context(), a.log).WithFields(fields).Warnf("denied request: %s", reason)
+	return errors.New(reason)
+}
+
+// ServeError replaces the default error renderer of the API, so that the authentication and authorization failures
+// are returned as models.Error like the errors of the handlers
+func ServeError(rw http.ResponseWriter, r *http.Request, err error) {
+	e, ok := err.(openapierrors.Error)
+	if !ok || (e.Code() != http.StatusUnauthorized && e.Code() != http.StatusForbidden) {
+		openapierrors.ServeError(rw, r, err)
+		return
+	}
+	rw.Header().Set("Content-Type", "application/json")
+	rw.WriteHeader(int(e.Code()))
+	if r != nil && r.Method == http.MethodHead {
+		return
+	}
+	_ = json.NewEncoder(rw).Encode(&models.Error{
+		Code:   swag.String(strconv.Itoa(int(e.Code()))),
+		Href:   swag.String(""),
+		ID:     swag.Int32(e.Code()),
+		Kind:   swag.String("Error"),
+		Reason: swag.String(e.Error()),
+	})
+}
@@ -0,0 +1,160 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/filanov/bm-inventory/models"
+	"github.com/filanov/bm-inventory/restapi"
+	"github.com/filanov/bm-inventory/restapi/operations/installer"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/swag"
+	"github.com/google/uuid"
+	"github.com/kelseyhightower/envconfig"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+)
+
+var _ = Describe("authorization", func() {
+	var (
+		cfg     Config
+		api     *restapi.MockInstallerAPI
+		handler http.Handler
+	)
+
+	buildHandler := func() {
+		authenticator, err := NewAuthenticator(getTestLog(), cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		authorizer, err := NewAuthorizer(getTestLog(), cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		api = &restapi.MockInstallerAPI{}
+		h, restAPI, err := restapi.HandlerAPI(restapi.Config{
+			InstallerAPI: api,
+			Authorizer:   authorizer.Authorize,
+			AuthUserAuth: UserAuth(getTestLog(), authenticator, authorizer),
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		restAPI.ServeError = ServeError
+		handler = h
+	}
+
+	BeforeEach(func() {
+		cfg = Config{
+			AuthType:     TypeStatic,
+			StaticTokens: []string{"viewer-token:viewer1", "user-token:user1", "debug-token:user2", "admin-token:admin"},
+			AdminUsers:   []string{"admin"},
+			Roles:        map[string]string{"viewer": "read", "user": "read|write|install", "debugger": "debug"},
+			UserRoles:    map[string]string{"viewer1": "viewer", "user2": "user|debugger"},
+			DefaultRole:  "user",
+		}
+		buildHandler()
+	})
+
+	request := func(token, method, path, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(method, "/api/assisted-install/v1"+path, strings.NewReader(body))
+		r.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w
+	}
+
+	expectDenied := func(w *httptest.ResponseRecorder, code int, reason string) {
+		Expect(w.Code).Should(Equal(code))
+		var e models.Error
+		Expect(json.Unmarshal(w.Body.Bytes(), &e)).ShouldNot(HaveOccurred())
+		Expect(swag.Int32Value(e.ID)).Should(BeEquivalentTo(code))
+		Expect(swag.StringValue(e.Reason)).Should(ContainSubstring(reason))
+	}
+
+	clusterID := uuid.New().String()
+	hostID := uuid.New().String()
+	debugPath := "/clusters/" + clusterID + "/hosts/" + hostID + "/actions/debug"
+	debugBody := `{"command": "echo hello"}`
+
+	It("debug_step", func() {
+		expectDenied(request("user-token", http.MethodPost, debugPath, debugBody), http.StatusForbidden,
+			"user user1 is not permitted to SetDebugStep, the debug permission is required")
+		api.AssertNotCalled(GinkgoT(), "SetDebugStep", mock.Anything, mock.Anything)
+
+		for _, token := range []string{"debug-token", "admin-token"} {
+			api.On("SetDebugStep", mock.Anything, mock.Anything).Return(installer.NewSetDebugStepNoContent()).Once()
+			Expect(request(token, http.MethodPost, debugPath, debugBody).Code).Should(Equal(http.StatusNoContent))
+		}
+		api.AssertExpectations(GinkgoT())
+	})
+
+	It("install_and_deregister", func() {
+		installPath := "/clusters/" + clusterID + "/actions/install"
+		expectDenied(request("viewer-token", http.MethodPost, installPath, ""), http.StatusForbidden,
+			"the install permission is required")
+		expectDenied(request("viewer-token", http.MethodDelete, "/clusters/"+clusterID, ""), http.StatusForbidden,
+			"the write permission is required")
+
+		api.On("InstallCluster", mock.Anything, mock.Anything).Return(installer.NewInstallClusterOK())
+		Expect(request("user-token", http.MethodPost, installPath, "").Code).Should(Equal(http.StatusOK))
+		api.On("DeregisterCluster", mock.Anything, mock.Anything).Return(installer.NewDeregisterClusterNoContent())
+		Expect(request("user-token", http.MethodDelete, "/clusters/"+clusterID, "").Code).
+			Should(Equal(http.StatusNoContent))
+	})
+
+	It("read", func() {
+		api.On("ListClusters", mock.Anything, mock.Anything).Return(installer.NewListClustersOK())
+		Expect(request("viewer-token", http.MethodGet, "/clusters", "").Code).Should(Equal(http.StatusOK))
+	})
+
+	It("default_role", func() {
+		cfg = Config{}
+		Expect(envconfig.Process("test", &cfg)).ShouldNot(HaveOccurred())
+		cfg.StaticTokens = []string{"user-token:user1"}
+		buildHandler()
+
+		api.On("ListClusters", mock.Anything, mock.Anything).Return(installer.NewListClustersOK())
+		Expect(request("user-token", http.MethodGet, "/clusters", "").Code).Should(Equal(http.StatusOK))
+		expectDenied(request("user-token", http.MethodPost, "/clusters/"+clusterID+"/actions/install", ""),
+			http.StatusForbidden, "the install permission is required")
+		expectDenied(request("user-token", http.MethodDelete, "/clusters/"+clusterID, ""), http.StatusForbidden,
+			"the write permission is required")
+		expectDenied(request("user-token", http.MethodPost, debugPath, debugBody), http.StatusForbidden,
+			"the debug permission is required")
+		api.AssertNotCalled(GinkgoT(), "InstallCluster", mock.Anything, mock.Anything)
+	})
+
+	It("unauthenticated", func() {
+		expectDenied(request("no-such-token", http.MethodGet, "/clusters", ""), http.StatusUnauthorized,
+			"invalid credentials")
+		Expect(request("", http.MethodGet, "/clusters", "").Code).Should(Equal(http.StatusUnauthorized))
+	})
+
+	It("invalid_config", func() {
+		for _, update := range []func(){
+			func() { cfg.Roles = map[string]string{"user": "read|execute"} },
+			func() { cfg.UserRoles = map[string]string{"user1": "operator"} },
+			func() { cfg.DefaultRole = "operator" },
+		} {
+			cfg.Roles = map[string]string{"user": "read"}
+			cfg.UserRoles = nil
+			cfg.DefaultRole = "user"
+			update()
+			_, err := NewAuthorizer(getTestLog(), cfg)
+			Expect(err).Should(HaveOccurred())
+		}
+	})
+
+	It("all_user_operations_are_mapped", func() {
+		spec, err := loads.Analyzed(restapi.SwaggerJSON, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, op := range spec.Analyzer.OperationIDs() {
+			_, _, operation, ok := spec.Analyzer.OperationForName(op)
+			Expect(ok).Should(BeTrue())
+			_, mapped := operationPermissions[operation.ID]
+			// the agent operations don't require the user authentication
+			Expect(mapped).Should(Equal(operation.Security == nil), operation.ID)
+		}
+	})
+})
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/coreos/go-oidc"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const jwksFetchTimeout = 10 * time.Second
+
+// the supported signing algorithms
+var jwtAlgorithms = []string{oidc.RS256, oidc.RS384, oidc.RS512}
+
+// jwtAuthenticator validates JWTs signed by the issuer with the keys of its JSON web key set. The signature, issuer,
+// audience and expiration are verified by the OpenID Connect library, the keys are fetched and cached by it too.
+type jwtAuthenticator struct {
+	cfg      Config
+	verifier *oidc.IDTokenVerifier
+}
+
+func newJWTAuthenticator(log logrus.FieldLogger, cfg Config) (*jwtAuthenticator, error) {
+	if cfg.JWTIssuer == "" {
+		return nil, errors.Errorf("the JWT issuer is not configured")
+	}
+	if cfg.JWTUserClaim == "" {
+		return nil, errors.Errorf("the JWT user claim is not configured")
+	}
+	ctx := oidc.ClientContext(context.Background(), &http.Client{Timeout: jwksFetchTimeout})
+	verifierCfg := &oidc.Config{
+		ClientID:             cfg.JWTAudience,
+		SkipClientIDCheck:    cfg.JWTAudience == "",
+		SupportedSigningAlgs: jwtAlgorithms,
+	}
+	var verifier *oidc.IDTokenVerifier
+	if cfg.JWKSURL != "" {
+		verifier = oidc.NewVerifier(cfg.JWTIssuer, oidc.NewRemoteKeySet(ctx, cfg.JWKSURL), verifierCfg)
+	} else {
+		provider, err := oidc.NewProvider(ctx, cfg.JWTIssuer)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get the OpenID configuration of %s", cfg.JWTIssuer)
+		}
+		verifier = provider.Verifier(verifierCfg)
+	}
+	log.Infof("validating the JWTs issued by %s", cfg.JWTIssuer)
+	return &jwtAuthenticator{cfg: cfg, verifier: verifier}, nil
+}
+
+func (a *jwtAuthenticator) Authenticate(token string) (*User, error) {
+	idToken, err := a.verifier.Verify(context.Background(), token)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid JWT")
+	}
+	var claims map[string]interface{}
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, errors.Wrapf(err, "malformed JWT claims")
+	}
+	return a.user(claims)
+}
+
+// user returns the user of the verified claims
+func (a *jwtAuthenticator) user(claims map[string]interface{}) (*User, error) {
+	name, _ := claims[a.cfg.JWTUserClaim].(string)
+	if name == "" {
+		return nil, errors.Errorf("JWT without %s claim", a.cfg.JWTUserClaim)
+	}
+	user := &User{Name: name}
+	if a.cfg.JWTOrgClaim != "" {
+		user.Organization, _ = claims[a.cfg.JWTOrgClaim].(string)
+	}
+	if a.cfg.JWTRolesClaim != "" {
+		user.Roles = stringList(claims[a.cfg.JWTRolesClaim])
+	}
+	return user, nil
+}
+
+// stringList returns the strings of a claim that is either a string or an array of strings
+func stringList(claim interface{}) []string {
+	switch v := claim.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var list []string
+		for _, s := range v {
+			if str, ok := s.(string); ok {
+				list = append(list, str)
+			}
+		}
+		return list
+	}
+	return nil
+}
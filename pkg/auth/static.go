@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const staticTokenSeparator = ":"
+
+// staticAuthenticator authenticates the tokens that are configured in the service, the tokens are looked up by
+// their digests so that the lookup time doesn't depend on how much of a token matches
+type staticAuthenticator struct {
+	users map[[sha256.Size]byte]User
+}
+
+func newStaticAuthenticator(cfg Config) (*staticAuthenticator, error) {
+	a := &staticAuthenticator{users: make(map[[sha256.Size]byte]User)}
+	for _, entry := range cfg.StaticTokens {
+		fields := strings.Split(entry, staticTokenSeparator)
+		if len(fields) < 2 || len(fields) > 3 || fields[0] == "" || fields[1] == "" {
+			return nil, errors.Errorf("invalid static token entry, token:user:organization is expected")
+		}
+		user := User{Name: fields[1]}
+		if len(fields) == 3 {
+			user.Organization = fields[2]
+		}
+		a.users[sha256.Sum256([]byte(fields[0]))] = user
+	}
+	if len(a.users) == 0 {
+		return nil, errors.Errorf("no static tokens are configured")
+	}
+	return a, nil
+}
+
+func (a *staticAuthenticator) Authenticate(token string) (*User, error) {
+	user, ok := a.users[sha256.Sum256([]byte(token))]
+	if !ok {
+		return nil, errors.Errorf("unknown static token")
+	}
+	return &user, nil
+}
@@ -0,0 +1,140 @@
+package database
+
+import (
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/mysql"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	DialectMySQL    = "mysql"
+	DialectPostgres = "postgres"
+)
+
+// Config of the DB connection, the user and password are expected to be passed from a k8s secret.
+// SSLMode takes the PostgreSQL sslmode values: disable, require, verify-ca and verify-full.
+type Config struct {
+	Dialect         string        `envconfig:"DB_DIALECT" default:"mysql"`
+	Host            string        `envconfig:"DB_HOST" default:"mariadb"`
+	Port            string        `envconfig:"DB_PORT" default:""`
+	User            string        `envconfig:"DB_USER" default:"admin"`
+	Pass            string        `envconfig:"DB_PASS" default:"admin"`
+	Name            string        `envconfig:"DB_NAME" default:"installer"`
+	SSLMode         string        `envconfig:"DB_SSL_MODE" default:"disable"`
+	MaxOpenConns    int           `envconfig:"DB_MAX_OPEN_CONNS" default:"0"`
+	MaxIdleConns    int           `envconfig:"DB_MAX_IDLE_CONNS" default:"2"`
+	ConnMaxLifetime time.Duration `envconfig:"DB_CONN_MAX_LIFETIME" default:"0"`
+	ConnectTimeout  time.Duration `envconfig:"DB_CONNECT_TIMEOUT" default:"5m"`
+}
+
+// the connection attempts back off exponentially up to maxBackoff
+var (
+	initialBackoff = time.Second
+	maxBackoff     = 30 * time.Second
+)
+
+// mysqlTLS maps the SSL modes to the values of the MySQL driver tls parameter
+var mysqlTLS = map[string]string{
+	"disable":     "false",
+	"require":     "skip-verify",
+	"verify-ca":   "true",
+	"verify-full": "true",
+}
+
+// ConnectionString returns the connection string of the configured DB
+func ConnectionString(cfg Config) (string, error) {
+	switch cfg.Dialect {
+	case DialectMySQL:
+		tls, ok := mysqlTLS[cfg.SSLMode]
+		if !ok {
+			return "", errors.Errorf("unsupported SSL mode %s", cfg.SSLMode)
+		}
+		mysqlCfg := mysql.NewConfig()
+		mysqlCfg.User = cfg.User
+		mysqlCfg.Passwd = cfg.Pass
+		mysqlCfg.Net = "tcp"
+		mysqlCfg.Addr = net.JoinHostPort(cfg.Host, portOrDefault(cfg.Port, "3306"))
+		mysqlCfg.DBName = cfg.Name
+		mysqlCfg.ParseTime = true
+		mysqlCfg.Loc = time.UTC
+		mysqlCfg.Params = map[string]string{"charset": "utf8", "tls": tls}
+		return mysqlCfg.FormatDSN(), nil
+	case DialectPostgres:
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(cfg.User, cfg.Pass),
+			Host:     net.JoinHostPort(cfg.Host, portOrDefault(cfg.Port, "5432")),
+			Path:     "/" + cfg.Name,
+			RawQuery: url.Values{"sslmode": []string{cfg.SSLMode}, "timezone": []string{"UTC"}}.Encode(),
+		}
+		return u.String(), nil
+	default:
+		return "", errors.Errorf("unsupported DB dialect %s", cfg.Dialect)
+	}
+}
+
+func portOrDefault(port, defaultPort string) string {
+	if port == "" {
+		return defaultPort
+	}
+	return port
+}
+
+// Open connects to the configured DB, the connection is retried with an exponential backoff until
+// ConnectTimeout passes, since the DB may still be starting when the service starts.
+// The times are stored in UTC, the connections read the time columns in UTC and gorm sets the CreatedAt and
+// UpdatedAt fields of the models to UTC times.
+func Open(log logrus.FieldLogger, cfg Config) (*gorm.DB, error) {
+	gorm.NowFunc = Now
+	return connect(log, cfg, gorm.Open)
+}
+
+// Now returns the current time in UTC, as the times are stored
+func Now() time.Time {
+	return time.Now().UTC()
+}
+
+// Time returns the parameter that compares t with the date-time columns of the models. The models write these
+// columns as strfmt.DateTime strings of UTC times, which the DBs without a time type, such as sqlite, compare as
+// strings, so t is passed in UTC in the same format.
+func Time(t time.Time) strfmt.DateTime {
+	return strfmt.DateTime(t.UTC())
+}
+
+func connect(log logrus.FieldLogger, cfg Config,
+	open func(dialect string, args ...interface{}) (*gorm.DB, error)) (*gorm.DB, error) {
+	dsn, err := ConnectionString(cfg)
+	if err != nil {
+		return nil, err
+	}
+	deadline := time.Now().Add(cfg.ConnectTimeout)
+	backoff := initialBackoff
+	for attempt := 1; ; attempt++ {
+		db, err := open(cfg.Dialect, dsn)
+		if err == nil {
+			db.DB().SetMaxOpenConns(cfg.MaxOpenConns)
+			db.DB().SetMaxIdleConns(cfg.MaxIdleConns)
+			db.DB().SetConnMaxLifetime(cfg.ConnMaxLifetime)
+			log.Infof("connected to %s DB %s at %s", cfg.Dialect, cfg.Name, cfg.Host)
+			return db, nil
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, errors.Wrapf(err, "failed to connect to %s DB %s at %s after %d attempts",
+				cfg.Dialect, cfg.Name, cfg.Host, attempt)
+		}
+		log.WithError(err).Warnf("failed to connect to %s DB %s at %s, retrying in %s",
+			cfg.Dialect, cfg.Name, cfg.Host, backoff)
+		time.Sleep(backoff)
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
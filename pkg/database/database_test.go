@@ -0,0 +1,102 @@
+package database
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func TestDatabase(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "database tests")
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+var _ = Describe("ConnectionString", func() {
+	cfg := Config{Host: "db", User: "user", Pass: "p@ss:word/", Name: "installer", SSLMode: "disable"}
+
+	It("mysql", func() {
+		cfg.Dialect = DialectMySQL
+		dsn, err := ConnectionString(cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(dsn).Should(HavePrefix("user:p@ss:word/@tcp(db:3306)/installer?"))
+		Expect(dsn).Should(ContainSubstring("parseTime=true"))
+		Expect(dsn).Should(ContainSubstring("tls=false"))
+		// the times are read in UTC, which is the default location of the driver
+		Expect(dsn).ShouldNot(ContainSubstring("loc="))
+	})
+
+	It("mysql_tls", func() {
+		cfg.Dialect = DialectMySQL
+		cfg.SSLMode = "require"
+		cfg.Port = "3307"
+		dsn, err := ConnectionString(cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(dsn).Should(ContainSubstring("tcp(db:3307)"))
+		Expect(dsn).Should(ContainSubstring("tls=skip-verify"))
+	})
+
+	It("postgres", func() {
+		cfg.Dialect = DialectPostgres
+		cfg.SSLMode = "verify-full"
+		cfg.Port = ""
+		dsn, err := ConnectionString(cfg)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(dsn).Should(Equal("postgres://user:p%40ss%3Aword%2F@db:5432/installer?sslmode=verify-full&timezone=UTC"))
+	})
+
+	It("unsupported", func() {
+		_, err := ConnectionString(Config{Dialect: "oracle"})
+		Expect(err).Should(HaveOccurred())
+		_, err = ConnectionString(Config{Dialect: DialectMySQL, SSLMode: "prefer"})
+		Expect(err).Should(HaveOccurred())
+	})
+})
+
+var _ = Describe("Open", func() {
+	cfg := Config{Dialect: DialectMySQL, Host: "db", SSLMode: "disable", MaxOpenConns: 5, ConnectTimeout: time.Second}
+
+	BeforeEach(func() {
+		initialBackoff = 10 * time.Millisecond
+		maxBackoff = 20 * time.Millisecond
+	})
+
+	It("retries", func() {
+		attempts := 0
+		db, err := connect(getTestLog(), cfg, func(dialect string, args ...interface{}) (*gorm.DB, error) {
+			attempts++
+			Expect(dialect).Should(Equal(DialectMySQL))
+			if attempts < 4 {
+				return nil, errors.Errorf("connection refused")
+			}
+			return gorm.Open("sqlite3", ":memory:")
+		})
+		Expect(err).ShouldNot(HaveOccurred())
+		defer db.Close()
+		Expect(attempts).Should(Equal(4))
+		Expect(db.DB().Stats().MaxOpenConnections).Should(Equal(5))
+	})
+
+	It("times_out", func() {
+		cfg.ConnectTimeout = 50 * time.Millisecond
+		attempts := 0
+		_, err := connect(getTestLog(), cfg, func(dialect string, args ...interface{}) (*gorm.DB, error) {
+			attempts++
+			return nil, errors.Errorf("connection refused")
+		})
+		Expect(err).Should(HaveOccurred())
+		Expect(attempts).Should(BeNumerically(">", 1))
+	})
+})
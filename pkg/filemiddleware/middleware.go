@@ -1,26 +1,67 @@
 package filemiddleware
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 )
 
-func NewResponder(next middleware.Responder, fname string) middleware.Responder {
-	return &fileMiddlewareResponder{
-		next:     next,
-		fileName: fname,
-	}
+type headKey string
+
+const ctxHeadKey headKey = "head-request"
+
+// HeadMiddleware wraps an http handler.
+// The API router only routes the methods listed in the swagger spec, so HEAD requests are passed to the inner
+// handler as GET requests, and marked as HEAD requests in the request context. The http server discards the body
+// written in response to HEAD requests.
+func HeadMiddleware(inner http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			r = r.WithContext(context.WithValue(r.Context(), ctxHeadKey, true))
+			r.Method = http.MethodGet
+		}
+		inner.ServeHTTP(w, r)
+	})
+}
+
+// IsHeadRequest returns true for HEAD requests that were passed to the handler as GET requests by HeadMiddleware
+func IsHeadRequest(r *http.Request) bool {
+	head, _ := r.Context().Value(ctxHeadKey).(bool)
+	return head
 }
 
-type fileMiddlewareResponder struct {
-	next     middleware.Responder
-	fileName string
+// NewContentResponder returns a responder that serves the content as a file, with support for HEAD requests,
+// byte range requests and conditional requests by the ETag and the modification time.
+// The content is closed once it is served if it is an io.Closer.
+func NewContentResponder(r *http.Request, fileName string, content io.ReadSeeker, etag string,
+	modTime time.Time) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		if closer, ok := content.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if IsHeadRequest(r) {
+			head := *r
+			head.Method = http.MethodHead
+			r = &head
+		}
+		rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+		rw.Header().Set(runtime.HeaderContentType, runtime.DefaultMime)
+		if etag != "" {
+			rw.Header().Set("ETag", fmt.Sprintf("%q", etag))
+		}
+		http.ServeContent(rw, r, fileName, modTime, content)
+	})
 }
 
-func (f *fileMiddlewareResponder) WriteResponse(rw http.ResponseWriter, r runtime.Producer) {
-	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", f.fileName))
-	f.next.WriteResponse(rw, r)
+// NewRedirectResponder returns a responder that redirects the client to download the file from the given URL
+func NewRedirectResponder(url string) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set("Location", url)
+		rw.WriteHeader(http.StatusTemporaryRedirect)
+	})
 }
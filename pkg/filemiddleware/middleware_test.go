@@ -0,0 +1,58 @@
+package filemiddleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestFileMiddleware(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "file middleware tests")
+}
+
+var _ = Describe("HeadMiddleware", func() {
+	serve := func(method string) *httptest.ResponseRecorder {
+		server := httptest.NewServer(HeadMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).Should(Equal(http.MethodGet))
+			NewContentResponder(r, "file.txt", strings.NewReader("content"), "etag", time.Now()).
+				WriteResponse(w, runtime.ByteStreamProducer())
+		})))
+		defer server.Close()
+		req, err := http.NewRequest(method, server.URL, nil)
+		Expect(err).ShouldNot(HaveOccurred())
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer resp.Body.Close()
+		rec := httptest.NewRecorder()
+		rec.Code = resp.StatusCode
+		for k, v := range resp.Header {
+			rec.Header()[k] = v
+		}
+		_, err = rec.Body.ReadFrom(resp.Body)
+		Expect(err).ShouldNot(HaveOccurred())
+		return rec
+	}
+
+	It("get", func() {
+		rec := serve(http.MethodGet)
+		Expect(rec.Code).Should(Equal(http.StatusOK))
+		Expect(rec.Body.String()).Should(Equal("content"))
+		Expect(rec.Header().Get("Content-Disposition")).Should(Equal(`attachment; filename="file.txt"`))
+		Expect(rec.Header().Get("Content-Type")).Should(Equal(runtime.DefaultMime))
+		Expect(rec.Header().Get("ETag")).Should(Equal(`"etag"`))
+	})
+
+	It("head", func() {
+		rec := serve(http.MethodHead)
+		Expect(rec.Code).Should(Equal(http.StatusOK))
+		Expect(rec.Body.Len()).Should(Equal(0))
+		Expect(rec.Header().Get("Content-Length")).Should(Equal("7"))
+	})
+})
@@ -2,6 +2,7 @@ package job
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	logutil "github.com/filanov/bm-inventory/pkg/log"
@@ -9,6 +10,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -17,10 +21,29 @@ import (
 type API interface {
 	// Create k8s job
 	Create(ctx context.Context, obj runtime.Object, opts ...client.CreateOption) error
+	// CreateWithSecret creates a k8s job and a secret owned by the job that holds the data, the job containers read
+	// the data by environment variables returned by SecretEnv. The secret is deleted together with the job.
+	CreateWithSecret(ctx context.Context, job *batch.Job, data map[string]string) error
 	// Monitor k8s job return error in case job fails
 	Monitor(ctx context.Context, name, namespace string) error
+	// Status returns the current state of a k8s job without waiting for it to finish,
+	// together with the failure reason of failed jobs
+	Status(ctx context.Context, name, namespace string) (State, string, error)
+	// Delete k8s job together with its pods and secret, a job that doesn't exist is not an error
+	Delete(ctx context.Context, name, namespace string) error
 }
 
+// State of a k8s job
+type State string
+
+const (
+	StatePending   State = "pending"
+	StateRunning   State = "running"
+	StateSucceeded State = "succeeded"
+	StateFailed    State = "failed"
+	StateNotFound  State = "not-found"
+)
+
 type Config struct {
 	MonitorLoopInterval time.Duration `envconfig:"JOB_MONITOR_INTERVAL" default:"500ms"`
 	RetryInterval       time.Duration `envconfig:"JOB_RETRY_INTERVAL" default:"1s"`
@@ -45,6 +68,58 @@ func (k *kubeJob) Create(ctx context.Context, obj runtime.Object, opts ...client
 	return k.kube.Create(ctx, obj, opts...)
 }
 
+// SecretEnv returns an environment variable of a job container that is set from the key of the job secret
+func SecretEnv(name, jobName, key string) core.EnvVar {
+	return core.EnvVar{
+		Name: name,
+		ValueFrom: &core.EnvVarSource{
+			SecretKeyRef: &core.SecretKeySelector{
+				LocalObjectReference: core.LocalObjectReference{Name: jobName},
+				Key:                  key,
+			},
+		},
+	}
+}
+
+// CreateWithSecret creates the job before its secret, so that the secret can be owned by the job and garbage
+// collected with it. The job pods start once the secret is created.
+func (k *kubeJob) CreateWithSecret(ctx context.Context, job *batch.Job, data map[string]string) error {
+	log := logutil.FromContext(ctx, k.log)
+	if err := k.kube.Create(ctx, job); err != nil {
+		return err
+	}
+	secret := &core.Secret{
+		TypeMeta: meta.TypeMeta{
+			Kind:       "Secret",
+			APIVersion: "v1",
+		},
+		ObjectMeta: meta.ObjectMeta{
+			Name:      job.Name,
+			Namespace: job.Namespace,
+			OwnerReferences: []meta.OwnerReference{{
+				APIVersion: "batch/v1",
+				Kind:       "Job",
+				Name:       job.Name,
+				UID:        job.UID,
+			}},
+		},
+		Type:       core.SecretTypeOpaque,
+		StringData: data,
+	}
+	if err := k.kube.Create(ctx, secret); err != nil {
+		if deleteErr := k.delete(job); deleteErr != nil {
+			log.WithError(deleteErr).Errorf("Failed to delete job <%s> without secret", job.Name)
+		}
+		return errors.Wrapf(err, "failed to create secret of job <%s>", job.Name)
+	}
+	return nil
+}
+
+// delete the job together with its pods and secret
+func (k *kubeJob) delete(job *batch.Job) error {
+	return k.kube.Delete(context.Background(), job, client.PropagationPolicy(meta.DeletePropagationBackground))
+}
+
 // Monitor k8s job
 func (k *kubeJob) Monitor(ctx context.Context, name, namespace string) error {
 	log := logutil.FromContext(ctx, k.log)
@@ -87,10 +162,47 @@ func (k *kubeJob) Monitor(ctx context.Context, name, namespace string) error {
 	}
 
 	// not deleting a job if it failed
-	if err := k.kube.Delete(context.Background(), &job); err != nil {
+	if err := k.delete(&job); err != nil {
 		log.WithError(err).Errorf("Failed to delete job <%s>", name)
 	}
 
 	log.Infof("Job <%s> completed successfully", name)
 	return nil
 }
+
+func (k *kubeJob) Status(ctx context.Context, name, namespace string) (State, string, error) {
+	var job batch.Job
+	if err := k.kube.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &job); err != nil {
+		if apierrors.IsNotFound(err) {
+			return StateNotFound, "", nil
+		}
+		return "", "", errors.Wrapf(err, "failed to get job <%s>", name)
+	}
+
+	switch {
+	case job.Status.Succeeded > 0:
+		return StateSucceeded, "", nil
+	case job.Status.Failed >= swag.Int32Value(job.Spec.BackoffLimit)+1:
+		reason := fmt.Sprintf("Job <%s> failed <%d> times", name, job.Status.Failed)
+		for _, condition := range job.Status.Conditions {
+			if condition.Type == batch.JobFailed && condition.Message != "" {
+				reason = fmt.Sprintf("%s: %s", reason, condition.Message)
+			}
+		}
+		return StateFailed, reason, nil
+	case job.Status.Active > 0:
+		return StateRunning, "", nil
+	default:
+		return StatePending, "", nil
+	}
+}
+
+func (k *kubeJob) Delete(ctx context.Context, name, namespace string) error {
+	log := logutil.FromContext(ctx, k.log)
+	job := &batch.Job{ObjectMeta: meta.ObjectMeta{Name: name, Namespace: namespace}}
+	if err := k.delete(job); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to delete job <%s>", name)
+	}
+	log.Infof("Deleted job <%s>", name)
+	return nil
+}
@@ -13,6 +13,9 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/sirupsen/logrus"
 	batch "k8s.io/api/batch/v1"
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -49,7 +52,8 @@ var _ = Describe("job_test", func() {
 			}).Times(times)
 	}
 	mockDeleteSuccess := func() {
-		kube.EXPECT().Delete(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+		kube.EXPECT().Delete(gomock.Any(), gomock.Any(), client.PropagationPolicy(meta.DeletePropagationBackground)).
+			Return(nil).Times(1)
 	}
 
 	mockGetError := func(times int) {
@@ -75,6 +79,37 @@ var _ = Describe("job_test", func() {
 			Expect(j.Create(ctx, &batch.Job{})).ShouldNot(HaveOccurred())
 		})
 
+		It("create_with_secret", func() {
+			job := &batch.Job{ObjectMeta: meta.ObjectMeta{Name: "some-job", Namespace: "default"}}
+			var secret *core.Secret
+			gomock.InOrder(
+				kube.EXPECT().Create(gomock.Any(), job).Return(nil).
+					Do(func(ctx context.Context, obj runtime.Object) { obj.(*batch.Job).UID = "job-uid" }),
+				kube.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).
+					Do(func(ctx context.Context, obj runtime.Object) { secret = obj.(*core.Secret) }),
+			)
+			Expect(j.CreateWithSecret(ctx, job, map[string]string{"CONFIG": "secret"})).ShouldNot(HaveOccurred())
+			Expect(secret.Name).Should(Equal("some-job"))
+			Expect(secret.Namespace).Should(Equal("default"))
+			Expect(secret.StringData).Should(Equal(map[string]string{"CONFIG": "secret"}))
+			Expect(secret.OwnerReferences).Should(HaveLen(1))
+			Expect(secret.OwnerReferences[0].UID).Should(BeEquivalentTo("job-uid"))
+			Expect(SecretEnv("CONFIG", "some-job", "CONFIG").ValueFrom.SecretKeyRef.Name).Should(Equal("some-job"))
+		})
+
+		It("create_with_secret_job_failure", func() {
+			kube.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).Times(1)
+			Expect(j.CreateWithSecret(ctx, &batch.Job{}, map[string]string{"CONFIG": "secret"})).Should(HaveOccurred())
+		})
+
+		It("create_with_secret_secret_failure", func() {
+			kube.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+			kube.EXPECT().Create(gomock.Any(), gomock.Any()).Return(fmt.Errorf("err")).Times(1)
+			// the job is deleted, since it can't run without its secret
+			mockDeleteSuccess()
+			Expect(j.CreateWithSecret(ctx, &batch.Job{}, map[string]string{"CONFIG": "secret"})).Should(HaveOccurred())
+		})
+
 		It("monitor_with_retry", func() {
 			mockGetSuccess(5)
 			mockGetJobDone(1)
@@ -109,6 +144,94 @@ var _ = Describe("job_test", func() {
 		})
 	})
 
+	Context("status", func() {
+		BeforeEach(func() {
+			j = New(log, kube, Config{})
+		})
+
+		mockGetJob := func(update func(job *batch.Job)) {
+			kube.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).
+				Do(func(ctx context.Context, key client.ObjectKey, obj runtime.Object) {
+					update(obj.(*batch.Job))
+				}).Times(1)
+		}
+
+		It("pending", func() {
+			mockGetJob(func(job *batch.Job) {})
+			state, _, err := j.Status(ctx, "some-job", "default")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state).Should(Equal(StatePending))
+		})
+
+		It("running", func() {
+			mockGetJob(func(job *batch.Job) { job.Status.Active = 1 })
+			state, _, err := j.Status(ctx, "some-job", "default")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state).Should(Equal(StateRunning))
+		})
+
+		It("succeeded", func() {
+			// the job is not deleted before its result is recorded by the caller
+			mockGetJob(func(job *batch.Job) { job.Status.Succeeded = 1 })
+			state, _, err := j.Status(ctx, "some-job", "default")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state).Should(Equal(StateSucceeded))
+		})
+
+		It("failed", func() {
+			mockGetJob(func(job *batch.Job) {
+				job.Spec.BackoffLimit = swag.Int32(1)
+				job.Status.Failed = 2
+				job.Status.Conditions = []batch.JobCondition{{Type: batch.JobFailed, Message: "backoff limit exceeded"}}
+			})
+			state, reason, err := j.Status(ctx, "some-job", "default")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state).Should(Equal(StateFailed))
+			Expect(reason).Should(ContainSubstring("backoff limit exceeded"))
+		})
+
+		It("retrying_failed_pod", func() {
+			mockGetJob(func(job *batch.Job) {
+				job.Spec.BackoffLimit = swag.Int32(2)
+				job.Status.Failed = 1
+				job.Status.Active = 1
+			})
+			state, _, err := j.Status(ctx, "some-job", "default")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state).Should(Equal(StateRunning))
+		})
+
+		It("not_found", func() {
+			kube.EXPECT().Get(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(apierrors.NewNotFound(batch.Resource("jobs"), "some-job")).Times(1)
+			state, _, err := j.Status(ctx, "some-job", "default")
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(state).Should(Equal(StateNotFound))
+		})
+
+		It("get_failure", func() {
+			mockGetError(1)
+			_, _, err := j.Status(ctx, "some-job", "default")
+			Expect(err).Should(HaveOccurred())
+		})
+
+		It("delete", func() {
+			mockDeleteSuccess()
+			Expect(j.Delete(ctx, "some-job", "default")).ShouldNot(HaveOccurred())
+		})
+
+		It("delete_not_found", func() {
+			kube.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(apierrors.NewNotFound(batch.Resource("jobs"), "some-job")).Times(1)
+			Expect(j.Delete(ctx, "some-job", "default")).ShouldNot(HaveOccurred())
+		})
+
+		It("delete_failure", func() {
+			kube.EXPECT().Delete(gomock.Any(), gomock.Any(), gomock.Any()).Return(fmt.Errorf("error")).Times(1)
+			Expect(j.Delete(ctx, "some-job", "default")).Should(HaveOccurred())
+		})
+	})
+
 	Context("monitor_retry", func() {
 		BeforeEach(func() {
 			j = New(log, kube, Config{
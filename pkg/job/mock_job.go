@@ -7,6 +7,7 @@ package job
 import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
+	v1 "k8s.io/api/batch/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	reflect "reflect"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,6 +55,20 @@ func (mr *MockAPIMockRecorder) Create(ctx, obj interface{}, opts ...interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAPI)(nil).Create), varargs...)
 }
 
+// CreateWithSecret mocks base method.
+func (m *MockAPI) CreateWithSecret(ctx context.Context, job *v1.Job, data map[string]string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithSecret", ctx, job, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithSecret indicates an expected call of CreateWithSecret.
+func (mr *MockAPIMockRecorder) CreateWithSecret(ctx, job, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithSecret", reflect.TypeOf((*MockAPI)(nil).CreateWithSecret), ctx, job, data)
+}
+
 // Monitor mocks base method.
 func (m *MockAPI) Monitor(ctx context.Context, name, namespace string) error {
 	m.ctrl.T.Helper()
@@ -67,3 +82,33 @@ func (mr *MockAPIMockRecorder) Monitor(ctx, name, namespace interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Monitor", reflect.TypeOf((*MockAPI)(nil).Monitor), ctx, name, namespace)
 }
+
+// Status mocks base method.
+func (m *MockAPI) Status(ctx context.Context, name, namespace string) (State, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", ctx, name, namespace)
+	ret0, _ := ret[0].(State)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockAPIMockRecorder) Status(ctx, name, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockAPI)(nil).Status), ctx, name, namespace)
+}
+
+// Delete mocks base method.
+func (m *MockAPI) Delete(ctx context.Context, name, namespace string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, name, namespace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockAPIMockRecorder) Delete(ctx, name, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAPI)(nil).Delete), ctx, name, namespace)
+}
@@ -0,0 +1,127 @@
+package leader
+
+import (
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/filanov/bm-inventory/pkg/thread"
+	"github.com/google/uuid"
+	"github.com/jinzhu/gorm"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -source=leader.go -package=leader -destination=mock_leader.go
+type ElectorInterface interface {
+	// IsLeader returns true if the current service instance holds the lease
+	IsLeader() bool
+}
+
+type Config struct {
+	LeaseDuration time.Duration `envconfig:"LEADER_LEASE_DURATION" default:"15s"`
+	RenewInterval time.Duration `envconfig:"LEADER_RENEW_INTERVAL" default:"5s"`
+}
+
+// Lease is a named lock held in the DB by a single service instance at a time.
+// The holder must renew the lease before LeaseDuration passes, otherwise any other instance can take it over.
+type Lease struct {
+	Name      string    `gorm:"primary_key"`
+	Holder    string    `gorm:"type:varchar(255)"`
+	RenewedAt time.Time `gorm:"type:datetime"`
+}
+
+// Elector provides a DB based leader election between service replicas,
+// so that background tasks run on a single replica at a time.
+//
+// Sample usage:
+//    elector := leader.NewElector(log, db, cfg, "bm-inventory")
+//    elector.Start()
+//    defer elector.Stop()
+//    ....
+//    if elector.IsLeader() {
+//        //do leader only logic
+//    }
+//
+type Elector struct {
+	Config
+	log      logrus.FieldLogger
+	db       *gorm.DB
+	name     string
+	identity string
+	isLeader int32
+	renewer  *thread.Thread
+}
+
+func NewElector(log logrus.FieldLogger, db *gorm.DB, cfg Config, name string) *Elector {
+	e := &Elector{
+		Config:   cfg,
+		log:      log,
+		db:       db,
+		name:     name,
+		identity: newIdentity(),
+	}
+	e.renewer = thread.New(log, fmt.Sprintf("Leader Elector %s", name), cfg.RenewInterval, e.tryAcquireOrRenew)
+	return e
+}
+
+func newIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return fmt.Sprintf("%s-%s", hostname, uuid.New().String()[:8])
+}
+
+// Start trying to acquire the lease and keep renewing it
+func (e *Elector) Start() {
+	e.renewer.Start()
+}
+
+// Stop renewing the lease and release it, if held, so another instance can take over
+func (e *Elector) Stop() {
+	e.renewer.Stop()
+	if !e.IsLeader() {
+		return
+	}
+	e.setLeader(false)
+	// mark the lease as expired instead of deleting it to avoid racing with a new holder
+	if err := e.db.Model(&Lease{}).Where("name = ? and holder = ?", e.name, e.identity).
+		Update("renewed_at", time.Now().Add(-e.LeaseDuration)).Error; err != nil {
+		e.log.WithError(err).Warnf("failed to release lease %s", e.name)
+	}
+}
+
+func (e *Elector) IsLeader() bool {
+	return atomic.LoadInt32(&e.isLeader) == 1
+}
+
+func (e *Elector) setLeader(isLeader bool) {
+	var val int32
+	if isLeader {
+		val = 1
+	}
+	if old := atomic.SwapInt32(&e.isLeader, val); old != val {
+		e.log.Infof("%s leadership of lease %s changed, is leader: %t", e.identity, e.name, isLeader)
+	}
+}
+
+func (e *Elector) tryAcquireOrRenew() {
+	now := time.Now()
+	reply := e.db.Model(&Lease{}).
+		Where("name = ? and (holder = ? or renewed_at < ?)", e.name, e.identity, now.Add(-e.LeaseDuration)).
+		Updates(map[string]interface{}{"holder": e.identity, "renewed_at": now})
+	if reply.Error != nil {
+		e.log.WithError(reply.Error).Errorf("failed to renew lease %s", e.name)
+		e.setLeader(false)
+		return
+	}
+	if reply.RowsAffected == 0 {
+		// the lease is held by another instance or does not exist yet, creation will fail in the first case
+		if err := e.db.Create(&Lease{Name: e.name, Holder: e.identity, RenewedAt: now}).Error; err != nil {
+			e.setLeader(false)
+			return
+		}
+	}
+	e.setLeader(true)
+}
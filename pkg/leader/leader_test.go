@@ -0,0 +1,82 @@
+package leader
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+func TestLeader(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Leader Test")
+}
+
+var _ = Describe("leader_elector", func() {
+	var (
+		db     *gorm.DB
+		log    = logrus.New()
+		cfg    = Config{LeaseDuration: time.Hour, RenewInterval: time.Hour}
+		first  *Elector
+		second *Elector
+	)
+
+	BeforeEach(func() {
+		var err error
+		log.SetOutput(ioutil.Discard)
+		db, err = gorm.Open("sqlite3", ":memory:")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(db.AutoMigrate(&Lease{}).Error).ShouldNot(HaveOccurred())
+		first = NewElector(log, db, cfg, "test")
+		second = NewElector(log, db, cfg, "test")
+	})
+
+	It("single_leader", func() {
+		first.tryAcquireOrRenew()
+		second.tryAcquireOrRenew()
+		Expect(first.IsLeader()).Should(BeTrue())
+		Expect(second.IsLeader()).Should(BeFalse())
+
+		// renewing keeps the leadership
+		first.tryAcquireOrRenew()
+		second.tryAcquireOrRenew()
+		Expect(first.IsLeader()).Should(BeTrue())
+		Expect(second.IsLeader()).Should(BeFalse())
+	})
+
+	It("expired_lease", func() {
+		first.tryAcquireOrRenew()
+		Expect(db.Model(&Lease{}).Where("name = ?", "test").
+			Update("renewed_at", time.Now().Add(-2*time.Hour)).Error).ShouldNot(HaveOccurred())
+		second.tryAcquireOrRenew()
+		Expect(second.IsLeader()).Should(BeTrue())
+		first.tryAcquireOrRenew()
+		Expect(first.IsLeader()).Should(BeFalse())
+	})
+
+	It("stop_releases_lease", func() {
+		first.Start()
+		Eventually(first.IsLeader).Should(BeTrue())
+		first.Stop()
+		Expect(first.IsLeader()).Should(BeFalse())
+		second.tryAcquireOrRenew()
+		Expect(second.IsLeader()).Should(BeTrue())
+	})
+
+	It("different_leases", func() {
+		other := NewElector(log, db, cfg, "other")
+		first.tryAcquireOrRenew()
+		other.tryAcquireOrRenew()
+		Expect(first.IsLeader()).Should(BeTrue())
+		Expect(other.IsLeader()).Should(BeTrue())
+	})
+
+	AfterEach(func() {
+		db.Close()
+	})
+})
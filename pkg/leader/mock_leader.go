@@ -0,0 +1,47 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: leader.go
+
+// Package leader is a generated GoMock package.
+package leader
+
+import (
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockElectorInterface is a mock of ElectorInterface interface.
+type MockElectorInterface struct {
+	ctrl     *gomock.Controller
+	recorder *MockElectorInterfaceMockRecorder
+}
+
+// MockElectorInterfaceMockRecorder is the mock recorder for MockElectorInterface.
+type MockElectorInterfaceMockRecorder struct {
+	mock *MockElectorInterface
+}
+
+// NewMockElectorInterface creates a new mock instance.
+func NewMockElectorInterface(ctrl *gomock.Controller) *MockElectorInterface {
+	mock := &MockElectorInterface{ctrl: ctrl}
+	mock.recorder = &MockElectorInterfaceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockElectorInterface) EXPECT() *MockElectorInterfaceMockRecorder {
+	return m.recorder
+}
+
+// IsLeader mocks base method.
+func (m *MockElectorInterface) IsLeader() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLeader")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLeader indicates an expected call of IsLeader.
+func (mr *MockElectorInterfaceMockRecorder) IsLeader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLeader", reflect.TypeOf((*MockElectorInterface)(nil).IsLeader))
+}
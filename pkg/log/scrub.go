@@ -0,0 +1,42 @@
+package log
+
+import (
+	"regexp"
+
+	"github.com/sirupsen/logrus"
+)
+
+const redacted = "[redacted]"
+
+// the secrets that may end up in the logs, as part of the logged configs and requests, the first group is kept
+var secretPatterns = []*regexp.Regexp{
+	// the auths of pull secrets, also when the pull secret is quoted in a field or in an install config
+	regexp.MustCompile(`(\\*"auth\\*"\s*:\s*\\*")[^"\\]+`),
+	// SSH public keys, the key type is kept
+	regexp.MustCompile(`((?:ssh-rsa|ssh-dss|ssh-ed25519|ecdsa-sha2-nistp\d+)\s+)[A-Za-z0-9+/=]+`),
+	// agent and user tokens
+	regexp.MustCompile(`(--agent-token[\s=]+)[A-Za-z0-9]+`),
+	regexp.MustCompile(`((?i:bearer)\s+)[A-Za-z0-9._~+/=-]+`),
+}
+
+// Scrub returns the text with the secrets it holds masked
+func Scrub(text []byte) []byte {
+	for _, pattern := range secretPatterns {
+		text = pattern.ReplaceAll(text, []byte("${1}"+redacted))
+	}
+	return text
+}
+
+// ScrubbingFormatter masks the secrets in the log entries that are formatted by the wrapped formatter,
+// so that secrets that are logged by accident don't leak to the logs
+type ScrubbingFormatter struct {
+	logrus.Formatter
+}
+
+func (f *ScrubbingFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	text, err := f.Formatter.Format(entry)
+	if err != nil {
+		return nil, err
+	}
+	return Scrub(text), nil
+}
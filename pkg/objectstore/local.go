@@ -0,0 +1,214 @@
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	core "k8s.io/api/core/v1"
+)
+
+// the name of the volume the local storage directory is mounted from in the jobs
+const localVolumeName = "storage"
+
+// the prefix of the temporary files uploads are written to
+const uploadPrefix = ".upload-"
+
+// localClient stores the objects as files in a local directory, object names that contain "/" are stored in
+// sub directories. It lets the service run without an S3 deployment, e.g. in development and CI environments.
+type localClient struct {
+	log logrus.FieldLogger
+	cfg Config
+}
+
+// NewLocalClient returns a client that stores the objects in the configured local directory
+func NewLocalClient(log logrus.FieldLogger, cfg Config) (*localClient, error) {
+	if err := os.MkdirAll(cfg.LocalDir, 0755); err != nil {
+		return nil, errors.Wrapf(err, "failed to create storage directory %s", cfg.LocalDir)
+	}
+	return &localClient{log: log, cfg: cfg}, nil
+}
+
+// objectPath returns the path of the object file, names that escape the storage directory are refused
+func (c *localClient) objectPath(objectName string) (string, error) {
+	path := filepath.Join(c.cfg.LocalDir, filepath.FromSlash(objectName))
+	if !strings.HasPrefix(path, filepath.Clean(c.cfg.LocalDir)+string(filepath.Separator)) {
+		return "", errors.Errorf("invalid object name %s", objectName)
+	}
+	return path, nil
+}
+
+func (c *localClient) Upload(ctx context.Context, reader io.Reader, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	path, err := c.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.Wrapf(err, "failed to create directory of object %s", objectName)
+	}
+	// the content is written to a temporary file first, so readers never see a partially uploaded object
+	tmp, err := ioutil.TempFile(filepath.Dir(path), uploadPrefix)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create temporary file for object %s", objectName)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = io.Copy(tmp, reader); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "failed to write object %s", objectName)
+	}
+	if err = tmp.Close(); err != nil {
+		return errors.Wrapf(err, "failed to write object %s", objectName)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return errors.Wrapf(err, "failed to store object %s", objectName)
+	}
+	log.Infof("uploaded object %s to %s", objectName, c.cfg.LocalDir)
+	return nil
+}
+
+func (c *localClient) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	path, err := c.objectPath(objectName)
+	if err != nil {
+		return nil, 0, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, 0, errors.Wrapf(ErrObjectNotFound, "object %s does not exist in %s", objectName, c.cfg.LocalDir)
+		}
+		return nil, 0, errors.Wrapf(err, "failed to open object %s", objectName)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, 0, errors.Wrapf(err, "failed to get object %s size", objectName)
+	}
+	log.Debugf("downloading object %s from %s", objectName, c.cfg.LocalDir)
+	return f, info.Size(), nil
+}
+
+func (c *localClient) DownloadFrom(ctx context.Context, objectName string, offset int64) (io.ReadCloser, error) {
+	reader, _, err := c.Download(ctx, objectName)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = reader.(*os.File).Seek(offset, io.SeekStart); err != nil {
+		reader.Close()
+		return nil, errors.Wrapf(err, "failed to read object %s at offset %d", objectName, offset)
+	}
+	return reader, nil
+}
+
+func (c *localClient) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	path, err := c.objectPath(objectName)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.Wrapf(ErrObjectNotFound, "object %s does not exist in %s", objectName, c.cfg.LocalDir)
+		}
+		return nil, errors.Wrapf(err, "failed to get object %s", objectName)
+	}
+	return fileObjectInfo(objectName, info), nil
+}
+
+// fileObjectInfo returns the metadata of an object file, the ETag is derived from the file modification time
+// and size since objects are only replaced as a whole
+func fileObjectInfo(objectName string, info os.FileInfo) *ObjectInfo {
+	return &ObjectInfo{
+		Name:         objectName,
+		LastModified: info.ModTime(),
+		Size:         info.Size(),
+		ETag:         fmt.Sprintf("%x-%x", info.ModTime().UnixNano(), info.Size()),
+	}
+}
+
+func (c *localClient) PresignedDownloadURL(ctx context.Context, objectName, fileName string, expiry time.Duration) (string, error) {
+	return "", errors.Wrapf(ErrNotSupported, "local objects can't be downloaded directly")
+}
+
+func (c *localClient) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	if _, err := c.GetObjectInfo(ctx, objectName); err != nil {
+		if errors.Cause(err) == ErrObjectNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *localClient) DeleteObject(ctx context.Context, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	path, err := c.objectPath(objectName)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to delete object %s", objectName)
+	}
+	log.Infof("deleted object %s from %s", objectName, c.cfg.LocalDir)
+	return nil
+}
+
+func (c *localClient) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	err := filepath.Walk(c.cfg.LocalDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		// skip directories and uploads that are still in progress
+		if info.IsDir() || strings.HasPrefix(info.Name(), uploadPrefix) {
+			return nil
+		}
+		rel, err := filepath.Rel(c.cfg.LocalDir, path)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if strings.HasPrefix(name, prefix) {
+			objects = append(objects, *fileObjectInfo(name, info))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list objects with prefix %s in %s", prefix, c.cfg.LocalDir)
+	}
+	return objects, nil
+}
+
+// JobAccess mounts the storage directory in the jobs and passes its path in STORAGE_DIR.
+// The directory is taken from the configured volume claim, or from the node when no claim is configured,
+// which is enough for single node development clusters.
+func (c *localClient) JobAccess() ([]core.EnvVar, []core.Volume, []core.VolumeMount) {
+	volume := core.Volume{Name: localVolumeName}
+	if c.cfg.LocalVolumeClaim != "" {
+		volume.PersistentVolumeClaim = &core.PersistentVolumeClaimVolumeSource{ClaimName: c.cfg.LocalVolumeClaim}
+	} else {
+		volume.HostPath = &core.HostPathVolumeSource{Path: c.cfg.LocalDir}
+	}
+	env := []core.EnvVar{
+		{
+			Name:  "STORAGE_DIR",
+			Value: c.cfg.LocalDir,
+		},
+	}
+	mounts := []core.VolumeMount{
+		{
+			Name:      localVolumeName,
+			MountPath: c.cfg.LocalDir,
+		},
+	}
+	return env, []core.Volume{volume}, mounts
+}
@@ -0,0 +1,172 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: objectstore.go
+
+// Package objectstore is a generated GoMock package.
+package objectstore
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	io "io"
+	v1 "k8s.io/api/core/v1"
+	reflect "reflect"
+	time "time"
+)
+
+// MockAPI is a mock of API interface.
+type MockAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIMockRecorder
+}
+
+// MockAPIMockRecorder is the mock recorder for MockAPI.
+type MockAPIMockRecorder struct {
+	mock *MockAPI
+}
+
+// NewMockAPI creates a new mock instance.
+func NewMockAPI(ctrl *gomock.Controller) *MockAPI {
+	mock := &MockAPI{ctrl: ctrl}
+	mock.recorder = &MockAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPI) EXPECT() *MockAPIMockRecorder {
+	return m.recorder
+}
+
+// Upload mocks base method.
+func (m *MockAPI) Upload(ctx context.Context, reader io.Reader, objectName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, reader, objectName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockAPIMockRecorder) Upload(ctx, reader, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockAPI)(nil).Upload), ctx, reader, objectName)
+}
+
+// Download mocks base method.
+func (m *MockAPI) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Download", ctx, objectName)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Download indicates an expected call of Download.
+func (mr *MockAPIMockRecorder) Download(ctx, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockAPI)(nil).Download), ctx, objectName)
+}
+
+// DownloadFrom mocks base method.
+func (m *MockAPI) DownloadFrom(ctx context.Context, objectName string, offset int64) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadFrom", ctx, objectName, offset)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadFrom indicates an expected call of DownloadFrom.
+func (mr *MockAPIMockRecorder) DownloadFrom(ctx, objectName, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadFrom", reflect.TypeOf((*MockAPI)(nil).DownloadFrom), ctx, objectName, offset)
+}
+
+// GetObjectInfo mocks base method.
+func (m *MockAPI) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObjectInfo", ctx, objectName)
+	ret0, _ := ret[0].(*ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObjectInfo indicates an expected call of GetObjectInfo.
+func (mr *MockAPIMockRecorder) GetObjectInfo(ctx, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectInfo", reflect.TypeOf((*MockAPI)(nil).GetObjectInfo), ctx, objectName)
+}
+
+// PresignedDownloadURL mocks base method.
+func (m *MockAPI) PresignedDownloadURL(ctx context.Context, objectName, fileName string, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignedDownloadURL", ctx, objectName, fileName, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignedDownloadURL indicates an expected call of PresignedDownloadURL.
+func (mr *MockAPIMockRecorder) PresignedDownloadURL(ctx, objectName, fileName, expiry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedDownloadURL", reflect.TypeOf((*MockAPI)(nil).PresignedDownloadURL), ctx, objectName, fileName, expiry)
+}
+
+// DoesObjectExist mocks base method.
+func (m *MockAPI) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoesObjectExist", ctx, objectName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DoesObjectExist indicates an expected call of DoesObjectExist.
+func (mr *MockAPIMockRecorder) DoesObjectExist(ctx, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoesObjectExist", reflect.TypeOf((*MockAPI)(nil).DoesObjectExist), ctx, objectName)
+}
+
+// DeleteObject mocks base method.
+func (m *MockAPI) DeleteObject(ctx context.Context, objectName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, objectName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockAPIMockRecorder) DeleteObject(ctx, objectName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockAPI)(nil).DeleteObject), ctx, objectName)
+}
+
+// ListObjects mocks base method.
+func (m *MockAPI) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", ctx, prefix)
+	ret0, _ := ret[0].([]ObjectInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockAPIMockRecorder) ListObjects(ctx, prefix interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockAPI)(nil).ListObjects), ctx, prefix)
+}
+
+// JobAccess mocks base method.
+func (m *MockAPI) JobAccess() ([]v1.EnvVar, []v1.Volume, []v1.VolumeMount) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JobAccess")
+	ret0, _ := ret[0].([]v1.EnvVar)
+	ret1, _ := ret[1].([]v1.Volume)
+	ret2, _ := ret[2].([]v1.VolumeMount)
+	return ret0, ret1, ret2
+}
+
+// JobAccess indicates an expected call of JobAccess.
+func (mr *MockAPIMockRecorder) JobAccess() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JobAccess", reflect.TypeOf((*MockAPI)(nil).JobAccess))
+}
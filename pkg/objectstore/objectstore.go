@@ -0,0 +1,78 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	core "k8s.io/api/core/v1"
+)
+
+const (
+	BackendS3    = "s3"
+	BackendLocal = "local"
+)
+
+// ErrObjectNotFound is returned when the requested object does not exist in the store
+var ErrObjectNotFound = errors.New("object not found")
+
+// ErrNotSupported is returned by operations the store backend does not support
+var ErrNotSupported = errors.New("not supported by the storage backend")
+
+type ObjectInfo struct {
+	Name         string
+	LastModified time.Time
+	Size         int64
+	// ETag identifies the object content, it changes whenever the object is replaced
+	ETag string
+}
+
+//go:generate mockgen -source=objectstore.go -package=objectstore -destination=mock_objectstore.go
+type API interface {
+	// Upload stores the content read from reader as the given object, replacing an existing object
+	Upload(ctx context.Context, reader io.Reader, objectName string) error
+	// Download returns a reader of the object content and the object size, the reader must be closed by the caller
+	Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error)
+	// DownloadFrom returns a reader of the object content starting at the given offset
+	DownloadFrom(ctx context.Context, objectName string, offset int64) (io.ReadCloser, error)
+	// GetObjectInfo returns the object metadata without its content
+	GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error)
+	// PresignedDownloadURL returns a URL the object can be downloaded from, without credentials, until it expires.
+	// The object is downloaded as fileName. Returns ErrNotSupported if the backend can't be accessed directly.
+	PresignedDownloadURL(ctx context.Context, objectName, fileName string, expiry time.Duration) (string, error)
+	// DoesObjectExist returns true if the object exists in the store
+	DoesObjectExist(ctx context.Context, objectName string) (bool, error)
+	// DeleteObject deletes the object, deleting an object that does not exist is not an error
+	DeleteObject(ctx context.Context, objectName string) error
+	// ListObjects returns the objects whose name starts with the given prefix
+	ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error)
+	// JobAccess returns the environment and the volumes that let the k8s jobs, that generate the cluster
+	// artifacts, upload their output to the store
+	JobAccess() ([]core.EnvVar, []core.Volume, []core.VolumeMount)
+}
+
+type Config struct {
+	Backend             string `envconfig:"STORAGE_BACKEND" default:"s3"`
+	LocalDir            string `envconfig:"STORAGE_LOCAL_DIR" default:"/data"`
+	LocalVolumeClaim    string `envconfig:"STORAGE_LOCAL_VOLUME_CLAIM" default:""`
+	S3EndpointURL       string `envconfig:"S3_ENDPOINT_URL" default:"http://10.35.59.36:30925"`
+	S3Bucket            string `envconfig:"S3_BUCKET" default:"test"`
+	AwsAccessKeyID      string `envconfig:"AWS_ACCESS_KEY_ID" default:"accessKey1"`
+	AwsSecretAccessKey  string `envconfig:"AWS_SECRET_ACCESS_KEY" default:"verySecretKey1"`
+	S3CredentialsSecret string `envconfig:"S3_CREDENTIALS_SECRET" default:"s3-credentials"`
+}
+
+// New returns a client of the object store backend selected by the configuration
+func New(log logrus.FieldLogger, cfg Config) (API, error) {
+	switch cfg.Backend {
+	case BackendS3:
+		return NewS3Client(log, cfg)
+	case BackendLocal:
+		return NewLocalClient(log, cfg)
+	default:
+		return nil, errors.Errorf("unknown storage backend %s, supported backends are %s and %s",
+			cfg.Backend, BackendS3, BackendLocal)
+	}
+}
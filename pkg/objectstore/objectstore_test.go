@@ -0,0 +1,184 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+func TestObjectStore(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "object store tests")
+}
+
+func getTestLog() logrus.FieldLogger {
+	l := logrus.New()
+	l.SetOutput(ioutil.Discard)
+	return l
+}
+
+var _ = Describe("local", func() {
+	var (
+		ctx    = context.Background()
+		dir    string
+		client API
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "objectstore")
+		Expect(err).ShouldNot(HaveOccurred())
+		client, err = New(getTestLog(), Config{Backend: BackendLocal, LocalDir: filepath.Join(dir, "storage")})
+		Expect(err).ShouldNot(HaveOccurred())
+	})
+
+	download := func(objectName string) string {
+		reader, size, err := client.Download(ctx, objectName)
+		Expect(err).ShouldNot(HaveOccurred())
+		defer reader.Close()
+		content, err := ioutil.ReadAll(reader)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(size).Should(Equal(int64(len(content))))
+		return string(content)
+	}
+
+	It("upload_download_delete", func() {
+		objectName := "cluster-id/kubeconfig"
+		exists, err := client.DoesObjectExist(ctx, objectName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(exists).Should(BeFalse())
+
+		Expect(client.Upload(ctx, strings.NewReader("first"), objectName)).ShouldNot(HaveOccurred())
+		Expect(download(objectName)).Should(Equal("first"))
+		Expect(client.Upload(ctx, strings.NewReader("second"), objectName)).ShouldNot(HaveOccurred())
+		Expect(download(objectName)).Should(Equal("second"))
+		exists, err = client.DoesObjectExist(ctx, objectName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(exists).Should(BeTrue())
+
+		Expect(client.DeleteObject(ctx, objectName)).ShouldNot(HaveOccurred())
+		exists, err = client.DoesObjectExist(ctx, objectName)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(exists).Should(BeFalse())
+		// deleting a missing object is not an error
+		Expect(client.DeleteObject(ctx, objectName)).ShouldNot(HaveOccurred())
+	})
+
+	It("list_objects", func() {
+		for _, objectName := range []string{"discovery-image-1", "discovery-image-2", "cluster-id/kubeconfig"} {
+			Expect(client.Upload(ctx, strings.NewReader("content"), objectName)).ShouldNot(HaveOccurred())
+		}
+		objects, err := client.ListObjects(ctx, "discovery-image-")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect([]string{objects[0].Name, objects[1].Name}).Should(ConsistOf("discovery-image-1", "discovery-image-2"))
+		Expect(len(objects)).Should(Equal(2))
+		Expect(objects[0].LastModified).ShouldNot(BeZero())
+
+		objects, err = client.ListObjects(ctx, "")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(len(objects)).Should(Equal(3))
+	})
+
+	It("object_info", func() {
+		Expect(client.Upload(ctx, strings.NewReader("content"), "object")).ShouldNot(HaveOccurred())
+		info, err := client.GetObjectInfo(ctx, "object")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(info.Size).Should(Equal(int64(7)))
+		Expect(info.ETag).ShouldNot(BeEmpty())
+
+		Expect(client.Upload(ctx, strings.NewReader("other content"), "object")).ShouldNot(HaveOccurred())
+		replaced, err := client.GetObjectInfo(ctx, "object")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(replaced.ETag).ShouldNot(Equal(info.ETag))
+
+		_, err = client.GetObjectInfo(ctx, "missing")
+		Expect(errors.Cause(err)).Should(Equal(ErrObjectNotFound))
+		_, err = client.PresignedDownloadURL(ctx, "object", "file", time.Minute)
+		Expect(errors.Cause(err)).Should(Equal(ErrNotSupported))
+	})
+
+	It("object_reader", func() {
+		content := "0123456789"
+		Expect(client.Upload(ctx, strings.NewReader(content), "object")).ShouldNot(HaveOccurred())
+		reader := NewObjectReader(ctx, client, "object", int64(len(content)))
+		defer reader.Close()
+
+		size, err := reader.Seek(0, io.SeekEnd)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(size).Should(Equal(int64(len(content))))
+		_, err = reader.Seek(4, io.SeekStart)
+		Expect(err).ShouldNot(HaveOccurred())
+		buf := make([]byte, 3)
+		_, err = io.ReadFull(reader, buf)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(buf)).Should(Equal("456"))
+		_, err = reader.Seek(-2, io.SeekCurrent)
+		Expect(err).ShouldNot(HaveOccurred())
+		rest, err := ioutil.ReadAll(reader)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(string(rest)).Should(Equal("56789"))
+
+		_, err = reader.Seek(-1, io.SeekStart)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("download_missing_object", func() {
+		_, _, err := client.Download(ctx, "discovery-image")
+		Expect(errors.Cause(err)).Should(Equal(ErrObjectNotFound))
+	})
+
+	It("invalid_object_name", func() {
+		for _, objectName := range []string{"../outside", "a/../../outside", ""} {
+			Expect(client.Upload(ctx, strings.NewReader("content"), objectName)).Should(HaveOccurred())
+		}
+		_, err := os.Stat(filepath.Join(dir, "outside"))
+		Expect(os.IsNotExist(err)).Should(BeTrue())
+	})
+
+	It("job_access", func() {
+		env, volumes, mounts := client.JobAccess()
+		Expect(env[0].Name).Should(Equal("STORAGE_DIR"))
+		Expect(env[0].Value).Should(Equal(filepath.Join(dir, "storage")))
+		Expect(volumes[0].HostPath.Path).Should(Equal(filepath.Join(dir, "storage")))
+		Expect(mounts[0].MountPath).Should(Equal(filepath.Join(dir, "storage")))
+
+		claimClient, err := NewLocalClient(getTestLog(), Config{LocalDir: dir, LocalVolumeClaim: "storage-claim"})
+		Expect(err).ShouldNot(HaveOccurred())
+		_, volumes, _ = claimClient.JobAccess()
+		Expect(volumes[0].HostPath).Should(BeNil())
+		Expect(volumes[0].PersistentVolumeClaim.ClaimName).Should(Equal("storage-claim"))
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+})
+
+var _ = Describe("backend", func() {
+	It("unknown_backend", func() {
+		_, err := New(getTestLog(), Config{Backend: "ftp"})
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("s3_job_access_hides_credentials", func() {
+		client, err := New(getTestLog(), Config{Backend: BackendS3, S3EndpointURL: "http://s3:8000", S3Bucket: "test",
+			AwsAccessKeyID: "accessKey1", AwsSecretAccessKey: "verySecretKey1", S3CredentialsSecret: "s3-credentials"})
+		Expect(err).ShouldNot(HaveOccurred())
+		env, _, _ := client.JobAccess()
+		for _, e := range env {
+			Expect(e.Value).ShouldNot(ContainSubstring("verySecretKey1"))
+			Expect(e.Value).ShouldNot(ContainSubstring("accessKey1"))
+		}
+		Expect(env[3].ValueFrom.SecretKeyRef.Name).Should(Equal("s3-credentials"))
+	})
+})
@@ -0,0 +1,80 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+// ReadSeekCloser is the interface of readers that can be seeked and must be closed
+type ReadSeekCloser interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+// objectReader reads an object lazily from the offset it was seeked to, so serving parts of an object,
+// or only its size, never downloads all of it
+type objectReader struct {
+	ctx        context.Context
+	store      API
+	objectName string
+	size       int64
+	offset     int64
+	body       io.ReadCloser
+}
+
+// NewObjectReader returns a seekable reader of an object of the given size
+func NewObjectReader(ctx context.Context, store API, objectName string, size int64) ReadSeekCloser {
+	return &objectReader{ctx: ctx, store: store, objectName: objectName, size: size}
+}
+
+func (r *objectReader) Read(p []byte) (int, error) {
+	if r.offset >= r.size {
+		return 0, io.EOF
+	}
+	if r.body == nil {
+		body, err := r.store.DownloadFrom(r.ctx, r.objectName, r.offset)
+		if err != nil {
+			return 0, err
+		}
+		r.body = body
+	}
+	n, err := r.body.Read(p)
+	r.offset += int64(n)
+	return n, err
+}
+
+func (r *objectReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = r.offset + offset
+	case io.SeekEnd:
+		abs = r.size + offset
+	default:
+		return 0, errors.Errorf("invalid whence %d", whence)
+	}
+	if abs < 0 {
+		return 0, errors.Errorf("negative position %d", abs)
+	}
+	if abs != r.offset {
+		// the next read downloads the object from the new offset
+		if err := r.Close(); err != nil {
+			return 0, err
+		}
+		r.offset = abs
+	}
+	return abs, nil
+}
+
+func (r *objectReader) Close() error {
+	if r.body == nil {
+		return nil
+	}
+	err := r.body.Close()
+	r.body = nil
+	return err
+}
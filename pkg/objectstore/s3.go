@@ -0,0 +1,217 @@
+package objectstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	logutil "github.com/filanov/bm-inventory/pkg/log"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	core "k8s.io/api/core/v1"
+)
+
+// keys of the k8s secret that holds the credentials the jobs use to access the bucket
+const (
+	secretAccessKeyID     = "aws_access_key_id"
+	secretSecretAccessKey = "aws_secret_access_key"
+)
+
+type s3Client struct {
+	log      logrus.FieldLogger
+	cfg      Config
+	client   *s3.S3
+	uploader *s3manager.Uploader
+}
+
+// NewS3Client returns a client of the configured bucket in an S3 compatible store
+func NewS3Client(log logrus.FieldLogger, cfg Config) (*s3Client, error) {
+	sess, err := session.NewSession(&aws.Config{
+		Endpoint:    aws.String(cfg.S3EndpointURL),
+		Region:      aws.String("us-east-1"),
+		Credentials: credentials.NewStaticCredentials(cfg.AwsAccessKeyID, cfg.AwsSecretAccessKey, ""),
+		// the bucket can't be resolved as a sub domain of self hosted stores
+		S3ForcePathStyle: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create s3 session for %s", cfg.S3EndpointURL)
+	}
+	client := s3.New(sess)
+	return &s3Client{
+		log:      log,
+		cfg:      cfg,
+		client:   client,
+		uploader: s3manager.NewUploaderWithClient(client),
+	}, nil
+}
+
+func isNotFound(err error) bool {
+	if aerr, ok := err.(awserr.RequestFailure); ok && aerr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+	if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+		return true
+	}
+	return false
+}
+
+func (c *s3Client) Upload(ctx context.Context, reader io.Reader, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	if _, err := c.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(c.cfg.S3Bucket),
+		Key:    aws.String(objectName),
+		Body:   reader,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to upload object %s to bucket %s", objectName, c.cfg.S3Bucket)
+	}
+	log.Infof("uploaded object %s to bucket %s", objectName, c.cfg.S3Bucket)
+	return nil
+}
+
+func (c *s3Client) Download(ctx context.Context, objectName string) (io.ReadCloser, int64, error) {
+	log := logutil.FromContext(ctx, c.log)
+	out, err := c.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.cfg.S3Bucket),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, 0, errors.Wrapf(ErrObjectNotFound, "object %s does not exist in bucket %s",
+				objectName, c.cfg.S3Bucket)
+		}
+		return nil, 0, errors.Wrapf(err, "failed to get object %s from bucket %s", objectName, c.cfg.S3Bucket)
+	}
+	log.Debugf("downloading object %s from bucket %s", objectName, c.cfg.S3Bucket)
+	return out.Body, aws.Int64Value(out.ContentLength), nil
+}
+
+func (c *s3Client) DownloadFrom(ctx context.Context, objectName string, offset int64) (io.ReadCloser, error) {
+	out, err := c.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(c.cfg.S3Bucket),
+		Key:    aws.String(objectName),
+		Range:  aws.String(fmt.Sprintf("bytes=%d-", offset)),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errors.Wrapf(ErrObjectNotFound, "object %s does not exist in bucket %s",
+				objectName, c.cfg.S3Bucket)
+		}
+		return nil, errors.Wrapf(err, "failed to get object %s from bucket %s at offset %d",
+			objectName, c.cfg.S3Bucket, offset)
+	}
+	return out.Body, nil
+}
+
+func (c *s3Client) GetObjectInfo(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	out, err := c.client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket: aws.String(c.cfg.S3Bucket),
+		Key:    aws.String(objectName),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return nil, errors.Wrapf(ErrObjectNotFound, "object %s does not exist in bucket %s",
+				objectName, c.cfg.S3Bucket)
+		}
+		return nil, errors.Wrapf(err, "failed to get object %s metadata from bucket %s", objectName, c.cfg.S3Bucket)
+	}
+	return &ObjectInfo{
+		Name:         objectName,
+		LastModified: aws.TimeValue(out.LastModified),
+		Size:         aws.Int64Value(out.ContentLength),
+		ETag:         strings.Trim(aws.StringValue(out.ETag), `"`),
+	}, nil
+}
+
+func (c *s3Client) PresignedDownloadURL(ctx context.Context, objectName, fileName string, expiry time.Duration) (string, error) {
+	req, _ := c.client.GetObjectRequest(&s3.GetObjectInput{
+		Bucket:                     aws.String(c.cfg.S3Bucket),
+		Key:                        aws.String(objectName),
+		ResponseContentDisposition: aws.String(fmt.Sprintf("attachment; filename=%q", fileName)),
+	})
+	req.SetContext(ctx)
+	url, err := req.Presign(expiry)
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to presign object %s of bucket %s", objectName, c.cfg.S3Bucket)
+	}
+	return url, nil
+}
+
+func (c *s3Client) DoesObjectExist(ctx context.Context, objectName string) (bool, error) {
+	if _, err := c.GetObjectInfo(ctx, objectName); err != nil {
+		if errors.Cause(err) == ErrObjectNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *s3Client) DeleteObject(ctx context.Context, objectName string) error {
+	log := logutil.FromContext(ctx, c.log)
+	if _, err := c.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(c.cfg.S3Bucket),
+		Key:    aws.String(objectName),
+	}); err != nil {
+		return errors.Wrapf(err, "failed to delete object %s from bucket %s", objectName, c.cfg.S3Bucket)
+	}
+	log.Infof("deleted object %s from bucket %s", objectName, c.cfg.S3Bucket)
+	return nil
+}
+
+func (c *s3Client) ListObjects(ctx context.Context, prefix string) ([]ObjectInfo, error) {
+	var objects []ObjectInfo
+	if err := c.client.ListObjectsPagesWithContext(ctx, &s3.ListObjectsInput{
+		Bucket: aws.String(c.cfg.S3Bucket),
+		Prefix: aws.String(prefix),
+	}, func(page *s3.ListObjectsOutput, lastPage bool) bool {
+		for _, obj := range page.Contents {
+			objects = append(objects, ObjectInfo{
+				Name:         aws.StringValue(obj.Key),
+				LastModified: aws.TimeValue(obj.LastModified),
+				Size:         aws.Int64Value(obj.Size),
+				ETag:         strings.Trim(aws.StringValue(obj.ETag), `"`),
+			})
+		}
+		return true
+	}); err != nil {
+		return nil, errors.Wrapf(err, "failed to list objects with prefix %s in bucket %s", prefix, c.cfg.S3Bucket)
+	}
+	return objects, nil
+}
+
+// JobAccess passes the credentials to the jobs by a reference to a k8s secret, so they never appear in the job spec
+func (c *s3Client) JobAccess() ([]core.EnvVar, []core.Volume, []core.VolumeMount) {
+	secretEnv := func(name, key string) core.EnvVar {
+		return core.EnvVar{
+			Name: name,
+			ValueFrom: &core.EnvVarSource{
+				SecretKeyRef: &core.SecretKeySelector{
+					LocalObjectReference: core.LocalObjectReference{Name: c.cfg.S3CredentialsSecret},
+					Key:                  key,
+				},
+			},
+		}
+	}
+	env := []core.EnvVar{
+		{
+			Name:  "S3_ENDPOINT_URL",
+			Value: c.cfg.S3EndpointURL,
+		},
+		{
+			Name:  "S3_BUCKET",
+			Value: c.cfg.S3Bucket,
+		},
+		secretEnv("aws_access_key_id", secretAccessKeyID),
+		secretEnv("aws_secret_access_key", secretSecretAccessKey),
+	}
+	return env, nil, nil
+}
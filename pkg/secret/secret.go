@@ -0,0 +1,194 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"database/sql/driver"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/pkg/errors"
+)
+
+const (
+	// encrypted values are stored as enc:<key id>:<base64 of the nonce and the AES-256-GCM ciphertext>
+	encryptedPrefix = "enc:"
+	keySeparator    = ":"
+	keySize         = 32
+	redacted        = "[redacted]"
+)
+
+// Config holds the encryption keys as <key id>:<base64 of a 32 bytes key>. The first key encrypts the stored
+// secrets, the other keys only decrypt the secrets that were encrypted before the first key was added, until they
+// are rotated to the first key.
+type Config struct {
+	Keys []string `envconfig:"ENCRYPTION_KEYS" default:""`
+}
+
+type keyring struct {
+	currentID string
+	ciphers   map[string]cipher.AEAD
+}
+
+var (
+	mu   sync.RWMutex
+	keys *keyring
+)
+
+// Init sets the keys that encrypt and decrypt the secrets, until it is called the secrets are stored as is
+func Init(cfg Config) error {
+	k, err := newKeyring(cfg)
+	if err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	keys = k
+	return nil
+}
+
+func newKeyring(cfg Config) (*keyring, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, errors.Errorf("no encryption keys are configured")
+	}
+	k := &keyring{ciphers: make(map[string]cipher.AEAD)}
+	for _, entry := range cfg.Keys {
+		parts := strings.Split(entry, keySeparator)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid encryption key, expected <key id>:<base64 key>")
+		}
+		id := parts[0]
+		if _, ok := k.ciphers[id]; ok {
+			return nil, errors.Errorf("duplicate encryption key %s", id)
+		}
+		key, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil || len(key) != keySize {
+			return nil, errors.Errorf("invalid encryption key %s, expected base64 of %d bytes", id, keySize)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid encryption key %s", id)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid encryption key %s", id)
+		}
+		k.ciphers[id] = aead
+		if k.currentID == "" {
+			k.currentID = id
+		}
+	}
+	return k, nil
+}
+
+func current() *keyring {
+	mu.RLock()
+	defer mu.RUnlock()
+	return keys
+}
+
+// Encrypt returns the value encrypted with the current key, empty values are not encrypted
+func Encrypt(value string) (string, error) {
+	k := current()
+	if k == nil || value == "" {
+		return value, nil
+	}
+	aead := k.ciphers[k.currentID]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", errors.Wrapf(err, "failed to generate nonce")
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(value), []byte(k.currentID))
+	return encryptedPrefix + k.currentID + keySeparator + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plain value of a stored value, values that were stored before the encryption was configured
+// are returned as is
+func Decrypt(stored string) (string, error) {
+	if !strings.HasPrefix(stored, encryptedPrefix) {
+		return stored, nil
+	}
+	parts := strings.SplitN(strings.TrimPrefix(stored, encryptedPrefix), keySeparator, 2)
+	if len(parts) != 2 {
+		return "", errors.Errorf("malformed encrypted value")
+	}
+	k := current()
+	if k == nil {
+		return "", errors.Errorf("the value is encrypted with key %s, but no encryption keys are configured", parts[0])
+	}
+	aead, ok := k.ciphers[parts[0]]
+	if !ok {
+		return "", errors.Errorf("the value is encrypted with unknown key %s", parts[0])
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", errors.Errorf("malformed encrypted value")
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(parts[0]))
+	if err != nil {
+		return "", errors.Wrapf(err, "failed to decrypt the value with key %s", parts[0])
+	}
+	return string(plain), nil
+}
+
+// CurrentPrefix returns the prefix of the values encrypted with the current key, the stored values without it are
+// either empty or have to be rotated. It is empty when the encryption is not configured.
+func CurrentPrefix() string {
+	k := current()
+	if k == nil {
+		return ""
+	}
+	return encryptedPrefix + k.currentID + keySeparator
+}
+
+// String is a string that is encrypted when it is stored in the DB and decrypted when it is loaded,
+// it is printed redacted so that it isn't logged by accident
+type String string
+
+// Value implements driver.Valuer
+func (s String) Value() (driver.Value, error) {
+	return Encrypt(string(s))
+}
+
+// Scan implements sql.Scanner
+func (s *String) Scan(src interface{}) error {
+	var stored string
+	switch v := src.(type) {
+	case nil:
+	case string:
+		stored = v
+	case []byte:
+		stored = string(v)
+	default:
+		return errors.Errorf("cannot scan %T into a secret", src)
+	}
+	plain, err := Decrypt(stored)
+	if err != nil {
+		return err
+	}
+	*s = String(plain)
+	return nil
+}
+
+// Validate implements the validation of the generated models, any string is a valid secret
+func (s String) Validate(strfmt.Registry) error {
+	return nil
+}
+
+// String implements fmt.Stringer
+func (s String) String() string {
+	if s == "" {
+		return ""
+	}
+	return redacted
+}
+
+// GoString implements fmt.GoStringer, so that %#v doesn't print the secret either
+func (s String) GoString() string {
+	return fmt.Sprintf("%q", s.String())
+}
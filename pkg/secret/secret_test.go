@@ -0,0 +1,104 @@
+package secret
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSecret(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "secret tests")
+}
+
+const (
+	key1 = "key1:MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	key2 = "key2:ZmVkY2JhOTg3NjU0MzIxMGZlZGNiYTk4NzY1NDMyMTA="
+)
+
+var _ = Describe("secret", func() {
+	BeforeEach(func() {
+		Expect(Init(Config{Keys: []string{key1}})).ShouldNot(HaveOccurred())
+	})
+
+	It("encrypt", func() {
+		encrypted, err := Encrypt("pull-secret")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(encrypted).Should(HavePrefix("enc:key1:"))
+		Expect(encrypted).Should(HavePrefix(CurrentPrefix()))
+		Expect(encrypted).ShouldNot(ContainSubstring("pull-secret"))
+		other, err := Encrypt("pull-secret")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(other).ShouldNot(Equal(encrypted))
+
+		plain, err := Decrypt(encrypted)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(plain).Should(Equal("pull-secret"))
+
+		empty, err := Encrypt("")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(empty).Should(BeEmpty())
+	})
+
+	It("not_encrypted", func() {
+		plain, err := Decrypt("ssh-rsa key")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(plain).Should(Equal("ssh-rsa key"))
+	})
+
+	It("rotation", func() {
+		encrypted, err := Encrypt("pull-secret")
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(Init(Config{Keys: []string{key2, key1}})).ShouldNot(HaveOccurred())
+		plain, err := Decrypt(encrypted)
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(plain).Should(Equal("pull-secret"))
+		Expect(encrypted).ShouldNot(HavePrefix(CurrentPrefix()))
+
+		Expect(Init(Config{Keys: []string{key2}})).ShouldNot(HaveOccurred())
+		_, err = Decrypt(encrypted)
+		Expect(err).Should(HaveOccurred())
+	})
+
+	It("tampered", func() {
+		encrypted, err := Encrypt("pull-secret")
+		Expect(err).ShouldNot(HaveOccurred())
+		for _, value := range []string{
+			encrypted[:len(encrypted)-4] + "AAA=",
+			"enc:key2:" + encrypted[len("enc:key1:"):],
+			"enc:key1",
+			"enc:key1:not-base64",
+		} {
+			_, err = Decrypt(value)
+			Expect(err).Should(HaveOccurred(), value)
+		}
+	})
+
+	It("invalid_config", func() {
+		for _, keys := range [][]string{nil, {"key1"}, {":MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="},
+			{"key1:MDEyMzQ1Njc4OWFiY2RlZg=="}, {"key1:not-base64"}, {key1, key1}} {
+			Expect(Init(Config{Keys: keys})).Should(HaveOccurred(), fmt.Sprint(keys))
+		}
+		// the configured keys are kept
+		Expect(CurrentPrefix()).Should(Equal("enc:key1:"))
+	})
+
+	It("string", func() {
+		s := String("pull-secret")
+		value, err := s.Value()
+		Expect(err).ShouldNot(HaveOccurred())
+		Expect(value).Should(HavePrefix("enc:key1:"))
+
+		var scanned String
+		Expect(scanned.Scan([]byte(value.(string)))).ShouldNot(HaveOccurred())
+		Expect(scanned).Should(Equal(s))
+		Expect(scanned.Scan(nil)).ShouldNot(HaveOccurred())
+		Expect(scanned).Should(BeEmpty())
+		Expect(scanned.Scan(42)).Should(HaveOccurred())
+
+		Expect(fmt.Sprintf("%v %+v %#v", s, struct{ S String }{s}, s)).ShouldNot(ContainSubstring("pull-secret"))
+		Expect(String("").String()).Should(BeEmpty())
+	})
+})
@@ -25,25 +25,43 @@ const AuthKey contextKey = "Auth"
 
 // InstallerAPI
 type InstallerAPI interface {
+	DeleteClusterImage(ctx context.Context, params installer.DeleteClusterImageParams) middleware.Responder
 	DeregisterCluster(ctx context.Context, params installer.DeregisterClusterParams) middleware.Responder
 	DeregisterHost(ctx context.Context, params installer.DeregisterHostParams) middleware.Responder
+	DeregisterWebhook(ctx context.Context, params installer.DeregisterWebhookParams) middleware.Responder
 	DisableHost(ctx context.Context, params installer.DisableHostParams) middleware.Responder
+	// DownloadClusterFiles is Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.
 	DownloadClusterFiles(ctx context.Context, params installer.DownloadClusterFilesParams) middleware.Responder
+	// DownloadClusterISO is Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.
 	DownloadClusterISO(ctx context.Context, params installer.DownloadClusterISOParams) middleware.Responder
 	EnableHost(ctx context.Context, params installer.EnableHostParams) middleware.Responder
 	GenerateClusterISO(ctx context.Context, params installer.GenerateClusterISOParams) middleware.Responder
 	GetCluster(ctx context.Context, params installer.GetClusterParams) middleware.Responder
+	GetClusterImage(ctx context.Context, params installer.GetClusterImageParams) middleware.Responder
+	// GetFreeAddresses is The addresses are found free by a scan of the machine networks made by one of the cluster hosts, the addresses of the hosts and the VIPs already set are excluded.
+	GetFreeAddresses(ctx context.Context, params installer.GetFreeAddressesParams) middleware.Responder
+	GetHardwareProfile(ctx context.Context, params installer.GetHardwareProfileParams) middleware.Responder
 	GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder
 	GetNextSteps(ctx context.Context, params installer.GetNextStepsParams) middleware.Responder
 	InstallCluster(ctx context.Context, params installer.InstallClusterParams) middleware.Responder
+	ListClusterArtifacts(ctx context.Context, params installer.ListClusterArtifactsParams) middleware.Responder
+	ListClusterEvents(ctx context.Context, params installer.ListClusterEventsParams) middleware.Responder
+	ListClusterImages(ctx context.Context, params installer.ListClusterImagesParams) middleware.Responder
 	ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder
+	ListHardwareProfiles(ctx context.Context, params installer.ListHardwareProfilesParams) middleware.Responder
 	ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder
+	ListWebhookDeliveries(ctx context.Context, params installer.ListWebhookDeliveriesParams) middleware.Responder
+	ListWebhooks(ctx context.Context, params installer.ListWebhooksParams) middleware.Responder
 	PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder
 	RegisterCluster(ctx context.Context, params installer.RegisterClusterParams) middleware.Responder
 	RegisterHost(ctx context.Context, params installer.RegisterHostParams) middleware.Responder
+	RegisterWebhook(ctx context.Context, params installer.RegisterWebhookParams) middleware.Responder
+	RevokeAgentToken(ctx context.Context, params installer.RevokeAgentTokenParams) middleware.Responder
 	SetDebugStep(ctx context.Context, params installer.SetDebugStepParams) middleware.Responder
+	SetHostInstallationDisk(ctx context.Context, params installer.SetHostInstallationDiskParams) middleware.Responder
 	UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder
 	UpdateHostInstallProgress(ctx context.Context, params installer.UpdateHostInstallProgressParams) middleware.Responder
+	WatchCluster(ctx context.Context, params installer.WatchClusterParams) middleware.Responder
 }
 
 // Config is configuration for Handler
@@ -57,6 +75,9 @@ type Config struct {
 	// Authorizer is used to authorize a request after the Auth function was called using the "Auth*" functions
 	// and the principal was stored in the context in the "AuthKey" context value.
 	Authorizer func(*http.Request) error
+
+	// AuthUserAuth Applies when the "Authorization" header is set
+	AuthUserAuth func(token string) (interface{}, error)
 }
 
 // Handler returns an http.Handler given the handler configuration
@@ -81,82 +102,180 @@ func HandlerAPI(c Config) (http.Handler, *operations.AssistedInstallAPI, error)
 	api.JSONConsumer = runtime.JSONConsumer()
 	api.BinProducer = runtime.ByteStreamProducer()
 	api.JSONProducer = runtime.JSONProducer()
-	api.InstallerDeregisterClusterHandler = installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams) middleware.Responder {
+	api.UserAuthAuth = func(token string) (interface{}, error) {
+		if c.AuthUserAuth == nil {
+			return token, nil
+		}
+		return c.AuthUserAuth(token)
+	}
+
+	api.APIAuthorizer = authorizer(c.Authorizer)
+	api.InstallerDeleteClusterImageHandler = installer.DeleteClusterImageHandlerFunc(func(params installer.DeleteClusterImageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DeleteClusterImage(ctx, params)
+	})
+	api.InstallerDeregisterClusterHandler = installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterCluster(ctx, params)
 	})
-	api.InstallerDeregisterHostHandler = installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams) middleware.Responder {
+	api.InstallerDeregisterHostHandler = installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DeregisterHost(ctx, params)
 	})
-	api.InstallerDisableHostHandler = installer.DisableHostHandlerFunc(func(params installer.DisableHostParams) middleware.Responder {
+	api.InstallerDeregisterWebhookHandler = installer.DeregisterWebhookHandlerFunc(func(params installer.DeregisterWebhookParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.DeregisterWebhook(ctx, params)
+	})
+	api.InstallerDisableHostHandler = installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DisableHost(ctx, params)
 	})
-	api.InstallerDownloadClusterFilesHandler = installer.DownloadClusterFilesHandlerFunc(func(params installer.DownloadClusterFilesParams) middleware.Responder {
+	api.InstallerDownloadClusterFilesHandler = installer.DownloadClusterFilesHandlerFunc(func(params installer.DownloadClusterFilesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DownloadClusterFiles(ctx, params)
 	})
-	api.InstallerDownloadClusterISOHandler = installer.DownloadClusterISOHandlerFunc(func(params installer.DownloadClusterISOParams) middleware.Responder {
+	api.InstallerDownloadClusterISOHandler = installer.DownloadClusterISOHandlerFunc(func(params installer.DownloadClusterISOParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.DownloadClusterISO(ctx, params)
 	})
-	api.InstallerEnableHostHandler = installer.EnableHostHandlerFunc(func(params installer.EnableHostParams) middleware.Responder {
+	api.InstallerEnableHostHandler = installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.EnableHost(ctx, params)
 	})
-	api.InstallerGenerateClusterISOHandler = installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams) middleware.Responder {
+	api.InstallerGenerateClusterISOHandler = installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GenerateClusterISO(ctx, params)
 	})
-	api.InstallerGetClusterHandler = installer.GetClusterHandlerFunc(func(params installer.GetClusterParams) middleware.Responder {
+	api.InstallerGetClusterHandler = installer.GetClusterHandlerFunc(func(params installer.GetClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetCluster(ctx, params)
 	})
-	api.InstallerGetHostHandler = installer.GetHostHandlerFunc(func(params installer.GetHostParams) middleware.Responder {
+	api.InstallerGetClusterImageHandler = installer.GetClusterImageHandlerFunc(func(params installer.GetClusterImageParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetClusterImage(ctx, params)
+	})
+	api.InstallerGetFreeAddressesHandler = installer.GetFreeAddressesHandlerFunc(func(params installer.GetFreeAddressesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetFreeAddresses(ctx, params)
+	})
+	api.InstallerGetHardwareProfileHandler = installer.GetHardwareProfileHandlerFunc(func(params installer.GetHardwareProfileParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.GetHardwareProfile(ctx, params)
+	})
+	api.InstallerGetHostHandler = installer.GetHostHandlerFunc(func(params installer.GetHostParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.GetHost(ctx, params)
 	})
 	api.InstallerGetNextStepsHandler = installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.GetNextSteps(ctx, params)
 	})
-	api.InstallerInstallClusterHandler = installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams) middleware.Responder {
+	api.InstallerInstallClusterHandler = installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.InstallCluster(ctx, params)
 	})
-	api.InstallerListClustersHandler = installer.ListClustersHandlerFunc(func(params installer.ListClustersParams) middleware.Responder {
+	api.InstallerListClusterArtifactsHandler = installer.ListClusterArtifactsHandlerFunc(func(params installer.ListClusterArtifactsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterArtifacts(ctx, params)
+	})
+	api.InstallerListClusterEventsHandler = installer.ListClusterEventsHandlerFunc(func(params installer.ListClusterEventsParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterEvents(ctx, params)
+	})
+	api.InstallerListClusterImagesHandler = installer.ListClusterImagesHandlerFunc(func(params installer.ListClusterImagesParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListClusterImages(ctx, params)
+	})
+	api.InstallerListClustersHandler = installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ListClusters(ctx, params)
 	})
-	api.InstallerListHostsHandler = installer.ListHostsHandlerFunc(func(params installer.ListHostsParams) middleware.Responder {
+	api.InstallerListHardwareProfilesHandler = installer.ListHardwareProfilesHandlerFunc(func(params installer.ListHardwareProfilesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListHardwareProfiles(ctx, params)
+	})
+	api.InstallerListHostsHandler = installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.ListHosts(ctx, params)
 	})
+	api.InstallerListWebhookDeliveriesHandler = installer.ListWebhookDeliveriesHandlerFunc(func(params installer.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListWebhookDeliveries(ctx, params)
+	})
+	api.InstallerListWebhooksHandler = installer.ListWebhooksHandlerFunc(func(params installer.ListWebhooksParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.ListWebhooks(ctx, params)
+	})
 	api.InstallerPostStepReplyHandler = installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.PostStepReply(ctx, params)
 	})
-	api.InstallerRegisterClusterHandler = installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams) middleware.Responder {
+	api.InstallerRegisterClusterHandler = installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.RegisterCluster(ctx, params)
 	})
 	api.InstallerRegisterHostHandler = installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.RegisterHost(ctx, params)
 	})
-	api.InstallerSetDebugStepHandler = installer.SetDebugStepHandlerFunc(func(params installer.SetDebugStepParams) middleware.Responder {
+	api.InstallerRegisterWebhookHandler = installer.RegisterWebhookHandlerFunc(func(params installer.RegisterWebhookParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.RegisterWebhook(ctx, params)
+	})
+	api.InstallerRevokeAgentTokenHandler = installer.RevokeAgentTokenHandlerFunc(func(params installer.RevokeAgentTokenParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.RevokeAgentToken(ctx, params)
+	})
+	api.InstallerSetDebugStepHandler = installer.SetDebugStepHandlerFunc(func(params installer.SetDebugStepParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.SetDebugStep(ctx, params)
 	})
-	api.InstallerUpdateClusterHandler = installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams) middleware.Responder {
+	api.InstallerSetHostInstallationDiskHandler = installer.SetHostInstallationDiskHandlerFunc(func(params installer.SetHostInstallationDiskParams, principal interface{}) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.SetHostInstallationDisk(ctx, params)
+	})
+	api.InstallerUpdateClusterHandler = installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
 		return c.InstallerAPI.UpdateCluster(ctx, params)
 	})
 	api.InstallerUpdateHostInstallProgressHandler = installer.UpdateHostInstallProgressHandlerFunc(func(params installer.UpdateHostInstallProgressParams) middleware.Responder {
 		ctx := params.HTTPRequest.Context()
 		return c.InstallerAPI.UpdateHostInstallProgress(ctx, params)
 	})
+	api.InstallerWatchClusterHandler = installer.WatchClusterHandlerFunc(func(params installer.WatchClusterParams, principal interface{}) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		ctx = storeAuth(ctx, principal)
+		return c.InstallerAPI.WatchCluster(ctx, params)
+	})
 	api.ServerShutdown = func() {}
 	return api.Serve(c.InnerMiddleware), api, nil
 }
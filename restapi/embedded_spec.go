@@ -50,6 +50,12 @@ func init() {
               "$ref": "#/definitions/cluster-list"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -87,6 +93,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -98,6 +110,7 @@ func init() {
     },
     "/clusters/{clusterId}/hosts/{hostId}/progress": {
       "put": {
+        "security": [],
         "tags": [
           "installer"
         ],
@@ -128,11 +141,29 @@ func init() {
             "schema": {
               "$ref": "#/definitions/host-install-progress-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Update install progress"
+          },
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
@@ -151,6 +182,13 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Return the pull secret and the SSH public key of the cluster, which are redacted otherwise. Requires the write permission.",
+            "name": "with_secrets",
+            "in": "query"
           }
         ],
         "responses": {
@@ -158,6 +196,18 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/cluster"
+            },
+            "headers": {
+              "X-Resource-Version": {
+                "type": "integer",
+                "description": "The resource version the returned state is up to date with, watches resumed from it stream the changes made after it."
+              }
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -193,6 +243,12 @@ func init() {
           "204": {
             "description": "Success."
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -249,6 +305,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -299,6 +361,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -320,8 +388,94 @@ func init() {
         }
       }
     },
+    "/clusters/{cluster_id}/agent-token": {
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Revokes the agent tokens of all the discovery images of the cluster, a new token is embedded in the next discovery image that is generated.",
+        "operationId": "RevokeAgentToken",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/artifacts": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of files generated for the installed/installing cluster that can be downloaded.",
+        "operationId": "ListClusterArtifacts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/artifact-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/clusters/{cluster_id}/downloads/files": {
       "get": {
+        "description": "Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.",
         "produces": [
           "application/octet-stream"
         ],
@@ -360,6 +514,12 @@ func init() {
               "type": "file"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation, or the artifact is sensitive and the user lacks the sensitive permission.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -383,6 +543,7 @@ func init() {
     },
     "/clusters/{cluster_id}/downloads/image": {
       "get": {
+        "description": "Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.",
         "produces": [
           "application/octet-stream"
         ],
@@ -421,12 +582,24 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "The image is not ready.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -460,15 +633,9 @@ func init() {
         ],
         "responses": {
           "201": {
-            "description": "Success.",
+            "description": "Success. The image is generated in the background, a previous image generated with identical parameters is returned instead of generating a new one.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "imageId": {
-                  "type": "string",
-                  "format": "uuid"
-                }
-              }
+              "$ref": "#/definitions/image"
             }
           },
           "400": {
@@ -477,6 +644,153 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the state transitions of the cluster and its hosts, ordered by the time they occurred at.",
+        "operationId": "ListClusterEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Retrieve only the state transitions of this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Retrieve only the state transitions that occurred at this time or after it.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Retrieve only the state transitions that occurred before this time.",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "description": "Retrieve only the events after the event with this ID. The next page of events is retrieved by passing the ID of the last event of the previous page.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of events to retrieve.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "description": "The addresses are found free by a scan of the machine networks made by one of the cluster hosts, the addresses of the hosts and the VIPs already set are excluded.",
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the free addresses of the machine networks of the cluster, which are candidates for the VIPs.",
+        "operationId": "GetFreeAddresses",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$",
+            "type": "string",
+            "description": "Return the free addresses of this machine network only.",
+            "name": "network",
+            "in": "query"
+          },
+          {
+            "maximum": 1024,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8,
+            "description": "The maximal number of free addresses returned for each machine network.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/free-networks-addresses"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -513,6 +827,24 @@ func init() {
             "description": "Success.",
             "schema": {
               "$ref": "#/definitions/host-list"
+            },
+            "headers": {
+              "X-Resource-Version": {
+                "type": "integer",
+                "description": "The resource version the returned state is up to date with, watches resumed from it stream the changes made after it."
+              }
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -524,6 +856,7 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
           "installer"
         ],
@@ -544,6 +877,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
         ],
         "responses": {
@@ -559,6 +898,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -598,6 +943,12 @@ func init() {
               "$ref": "#/definitions/host"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -644,6 +995,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -694,7 +1051,13 @@ func init() {
           "204": {
             "description": "Success."
           },
-          "404": {
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -736,6 +1099,12 @@ func init() {
           "204": {
             "description": "Success."
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -782,6 +1151,148 @@ func init() {
           "204": {
             "description": "Success."
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/installation_disk": {
+      "put": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Sets the disk the host will be installed on, instead of the one chosen by the default selection policy.",
+        "operationId": "SetHostInstallationDisk",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "installation-disk-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installation-disk-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/installation_disk": {
+      "put": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Sets the disk the host will be installed on, instead of the one chosen by the default selection policy.",
+        "operationId": "SetHostInstallationDisk",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "installation-disk-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installation-disk-params"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -805,6 +1316,7 @@ func init() {
     },
     "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
+        "security": [],
         "tags": [
           "installer"
         ],
@@ -824,6 +1336,12 @@ func init() {
             "name": "host_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
         ],
         "responses": {
@@ -833,6 +1351,12 @@ func init() {
               "$ref": "#/definitions/steps"
             }
           },
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -848,6 +1372,7 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
           "installer"
         ],
@@ -874,6 +1399,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/step-reply"
             }
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
         ],
         "responses": {
@@ -886,6 +1417,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -900,112 +1437,1281 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "block-device": {
-      "type": "object",
-      "properties": {
-        "device_type": {
-          "type": "string"
-        },
-        "fstype": {
-          "type": "string"
-        },
-        "major_device_number": {
-          "type": "integer"
-        },
-        "minor_device_number": {
-          "type": "integer"
-        },
-        "mountpoint": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "read_only": {
-          "type": "boolean"
-        },
-        "removable_device": {
-          "type": "integer"
-        },
-        "size": {
-          "type": "integer"
-        }
-      }
-    },
-    "boot": {
-      "type": "object",
-      "properties": {
-        "current_boot_mode": {
-          "type": "string"
-        },
-        "pxe_interface": {
-          "type": "string"
-        }
-      }
     },
-    "cidr": {
-      "type": "object",
-      "properties": {
-        "ip_address": {
-          "type": "string"
-        },
-        "mask": {
-          "type": "integer"
+    "/clusters/{cluster_id}/images": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of OpenShift per-cluster discovery ISOs.",
+        "operationId": "ListClusterImages",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/image-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "cluster": {
-      "type": "object",
-      "required": [
-        "kind",
-        "id",
-        "href",
-        "status",
-        "status_info"
-      ],
-      "properties": {
-        "api_vip": {
-          "description": "Virtual IP used to reach the OpenShift cluster API.",
-          "type": "string",
-          "format": "ipv4"
-        },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+    "/clusters/{cluster_id}/images/{image_id}": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the generation status of an OpenShift per-cluster discovery ISO.",
+        "operationId": "GetClusterImage",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "image_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/image"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deletes an OpenShift per-cluster discovery ISO.",
+        "operationId": "DeleteClusterImage",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "image_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The image is being generated.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/watch": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Streams the state transitions of the cluster and its hosts as they are committed.",
+        "operationId": "WatchCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Stream the changes made after this resource version, such as the X-Resource-Version header of GetCluster and\nListHosts, or the resource version of the last received watch event. Only the changes made after the watch\nstarted are streamed when it is not set.\n",
+            "name": "resource_version",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A stream of watch events, one JSON object per line, that is kept open until the client closes it.\nBookmarks are streamed when the stream starts and periodically, with the resource version the stream is up to date with.\n",
+            "schema": {
+              "$ref": "#/definitions/watch-event"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/hardware_profiles": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of hardware requirement profiles that clusters can be validated against.",
+        "operationId": "ListHardwareProfiles",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/hardware-profile-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/hardware_profiles/{profile_name}": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the details of a hardware requirement profile.",
+        "operationId": "GetHardwareProfile",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "profile_name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/hardware-profile"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of webhook subscriptions.",
+        "operationId": "ListWebhooks",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Retrieve only the subscriptions to the events of this cluster, and the global subscriptions.",
+            "name": "cluster_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Subscribes a webhook to notifications of cluster and host lifecycle events.",
+        "operationId": "RegisterWebhook",
+        "parameters": [
+          {
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation, only admins can subscribe to the events of all the clusters.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhook_id}": {
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deletes a webhook subscription and its delivery history.",
+        "operationId": "DeregisterWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{webhook_id}/deliveries": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the delivery history of a webhook subscription, ordered by the time the deliveries were created at.",
+        "operationId": "ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "webhook_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-delivery-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "artifact": {
+      "type": "object",
+      "required": [
+        "name",
+        "sensitive"
+      ],
+      "properties": {
+        "generated_at": {
+          "description": "The time the artifact was generated at.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "description": "The file name the artifact is downloaded by.",
+          "type": "string"
+        },
+        "sensitive": {
+          "description": "Sensitive artifacts contain cluster credentials, downloading them requires an explicit permission.",
+          "type": "boolean"
+        },
+        "size_bytes": {
+          "description": "Size of the artifact in bytes.",
+          "type": "integer"
+        }
+      }
+    },
+    "artifact-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/artifact"
+      }
+    },
+    "block-device": {
+      "type": "object",
+      "properties": {
+        "device_type": {
+          "type": "string"
+        },
+        "fstype": {
+          "type": "string"
+        },
+        "major_device_number": {
+          "type": "integer"
+        },
+        "minor_device_number": {
+          "type": "integer"
+        },
+        "mountpoint": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "read_only": {
+          "type": "boolean"
+        },
+        "removable_device": {
+          "type": "integer"
+        },
+        "size": {
+          "type": "integer"
+        }
+      }
+    },
+    "boot": {
+      "type": "object",
+      "properties": {
+        "current_boot_mode": {
+          "type": "string"
+        },
+        "pxe_interface": {
+          "type": "string"
+        }
+      }
+    },
+    "cidr": {
+      "type": "object",
+      "properties": {
+        "ip_address": {
+          "type": "string"
+        },
+        "mask": {
+          "type": "integer"
+        }
+      }
+    },
+    "cluster": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "api_vip": {
+          "description": "Virtual IP used to reach the OpenShift cluster API.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "bootstrap_host_id": {
+          "description": "The master host that bootstraps the installation. Either pinned by the user or elected when the installation starts.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "bootstrap_info": {
+          "description": "Explains why the bootstrap host was chosen.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "created_at": {
+          "description": "The time that this cluster was created.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "dns_vip": {
+          "description": "Virtual IP used internally by the cluster for automating internal DNS requirements.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "hardware_profile": {
+          "description": "Name of the hardware requirement profile the cluster hosts are validated against.",
+          "type": "string"
+        },
+        "hosts": {
+          "description": "Hosts that are associated with this cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "$ref": "#/definitions/host"
+          },
+          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "install_completed_at": {
+          "description": "The time that this cluster completed installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
+        },
+        "install_started_at": {
+          "description": "The time that this cluster began installation.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object or 'ClusterLink' if it is just a link.",
+          "type": "string",
+          "enum": [
+            "Cluster"
+          ]
+        },
+        "machine_networks": {
+          "description": "The IPv4 networks that all the cluster hosts are connected to, detected from the NICs of the hosts. The VIPs are chosen from these networks.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "readOnly": true
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "4.4"
+          ]
+        },
+        "organization": {
+          "description": "The organization of the user that registered the cluster, the users of the organization can access the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "owner": {
+          "description": "The user that registered the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site. It is encrypted in the DB and redacted in the responses unless requested.",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/secret"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes. It is encrypted in the DB and redacted in the responses unless requested.",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/secret"
+        },
+        "status": {
+          "description": "Status of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "insufficient",
+            "ready",
+            "error",
+            "installing",
+            "installed"
+          ]
+        },
+        "status_info": {
+          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "type": "string"
+        },
+        "updated_at": {
+          "description": "The last time that this cluster was updated.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "validations_info": {
+          "description": "The results (validation-results) of the last validation of the cluster readiness for installation, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "cluster-create-params": {
+      "type": "object",
+      "required": [
+        "name",
+        "openshift_version"
+      ],
+      "properties": {
+        "api_vip": {
+          "description": "Virtual IP used to reach the OpenShift cluster API.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "dns_vip": {
+          "description": "Virtual IP used internally by the cluster for automating internal DNS requirements.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "hardware_profile": {
+          "description": "Name of the hardware requirement profile the cluster hosts are validated against.",
+          "type": "string"
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "name": {
+          "description": "Name of the OpenShift cluster.",
+          "type": "string"
+        },
+        "openshift_version": {
+          "description": "Version of the OpenShift cluster.",
+          "type": "string",
+          "enum": [
+            "4.4"
+          ]
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        }
+      }
+    },
+    "cluster-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/cluster"
+      }
+    },
+    "cluster-update-params": {
+      "type": "object",
+      "properties": {
+        "api_vip": {
+          "description": "Virtual IP used to reach the OpenShift cluster API.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "base_dns_domain": {
+          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+          "type": "string"
+        },
+        "bootstrap_host_id": {
+          "description": "The master host that will bootstrap the installation, instead of the one chosen by the bootstrap election policy.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "cluster_network_cidr": {
+          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        },
+        "cluster_network_host_prefix": {
+          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "integer",
+          "maximum": 32,
+          "minimum": 1
+        },
+        "dns_vip": {
+          "description": "Virtual IP used internally by the cluster for automating internal DNS requirements.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "hardware_profile": {
+          "description": "Name of the hardware requirement profile the cluster hosts are validated against.",
+          "type": "string"
+        },
+        "hosts_roles": {
+          "description": "The desired role for hosts associated with the cluster.",
+          "type": "array",
+          "items": {
+            "type": "object",
+            "properties": {
+              "id": {
+                "type": "string",
+                "format": "uuid"
+              },
+              "role": {
+                "type": "string",
+                "enum": [
+                  "master",
+                  "worker",
+                  "auto-assign"
+                ]
+              }
+            }
+          },
+          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\""
+        },
+        "ingress_vip": {
+          "description": "Virtual IP used for cluster ingress traffic.",
+          "type": "string",
+          "format": "ipv4"
+        },
+        "name": {
+          "description": "OpenShift cluster name",
+          "type": "string"
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site..",
+          "type": "string"
+        },
+        "service_network_cidr": {
+          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        },
+        "ssh_public_key": {
+          "description": "SSH public key for debugging OpenShift nodes.",
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-check-nic"
+          }
+        }
+      }
+    },
+    "connectivity-check-nic": {
+      "type": "object",
+      "properties": {
+        "ip_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "connectivity-check-params": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/connectivity-check-host"
+      }
+    },
+    "connectivity-remote-host": {
+      "type": "object",
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "l2_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l2-connectivity"
+          }
+        },
+        "l3_connectivity": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/l3-connectivity"
+          }
+        }
+      }
+    },
+    "connectivity-report": {
+      "type": "object",
+      "properties": {
+        "remote_hosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/connectivity-remote-host"
+          }
+        }
+      }
+    },
+    "cpu": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer"
+        },
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "frequency": {
+          "type": "number"
+        },
+        "model_name": {
+          "type": "string"
+        }
+      }
+    },
+    "cpu_details": {
+      "type": "object",
+      "properties": {
+        "architecture": {
+          "type": "string"
+        },
+        "cpu_mhz": {
+          "type": "number"
+        },
+        "cpus": {
+          "type": "integer"
+        },
+        "model_name": {
+          "type": "string"
+        },
+        "sockets": {
+          "type": "integer"
+        },
+        "threads_per_core": {
+          "type": "integer"
+        }
+      }
+    },
+    "debug-step": {
+      "type": "object",
+      "required": [
+        "command"
+      ],
+      "properties": {
+        "command": {
+          "type": "string"
+        }
+      }
+    },
+    "disk": {
+      "type": "object",
+      "properties": {
+        "by_path": {
+          "type": "string"
+        },
+        "drive_type": {
+          "type": "string"
+        },
+        "hctl": {
+          "type": "string"
+        },
+        "model": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "path": {
+          "type": "string"
+        },
+        "serial": {
+          "type": "string"
+        },
+        "size_bytes": {
+          "type": "integer"
+        },
+        "vendor": {
+          "type": "string"
+        },
+        "wwn": {
+          "type": "string"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "code",
+        "reason"
+      ],
+      "properties": {
+        "code": {
+          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, for if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
+          "type": "string"
+        },
+        "field_errors": {
+          "description": "The invalid fields of the request, when the error is caused by them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/field-error"
+          }
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Numeric identifier of the error.",
+          "type": "integer",
+          "format": "int32",
+          "maximum": 504,
+          "minimum": 400
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will always be 'Error'.",
+          "type": "string",
+          "enum": [
+            "Error"
+          ]
+        },
+        "reason": {
+          "description": "Human readable description of the error.",
+          "type": "string"
+        }
+      }
+    },
+    "event": {
+      "type": "object",
+      "required": [
+        "id",
+        "cluster_id",
+        "to_status",
+        "event_time"
+      ],
+      "properties": {
+        "change": {
+          "description": "The field of the cluster or host that changed without a state transition, such as role, hardware_info, inventory, connectivity or configuration for the updates of the cluster. Not set for state transitions, the from_status and to_status of a change are both the current status.",
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
+        "cluster_id": {
+          "description": "The cluster the event occurred in.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
+        "event_time": {
+          "description": "The time the transition occurred at.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "from_status": {
+          "description": "The status before the transition, not set when the cluster or host was registered.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "The host that changed its state, not set for state transitions of the cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "description": "Unique identifier of the event, events that occurred later have greater identifiers.",
           "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "request_id": {
+          "description": "The ID of the request, or of the background task, that made the transition.",
+          "type": "string"
+        },
+        "status_info": {
+          "description": "The status info after the transition.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "to_status": {
+          "description": "The status after the transition.",
+          "type": "string"
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "field-error": {
+      "type": "object",
+      "required": [
+        "field",
+        "reason"
+      ],
+      "properties": {
+        "field": {
+          "description": "Name of the invalid field.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Human readable description of why the field is invalid.",
+          "type": "string"
+        }
+      }
+    },
+    "free-addresses-request": {
+      "description": "The networks the host scans for free addresses.",
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+      }
+    },
+    "free-network-addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        }
+      }
+    },
+    "free-networks-addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free-network-addresses"
+      }
+    },
+    "hardware-profile": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "architectures": {
+          "description": "CPU architectures allowed for the hosts, any architecture is allowed if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "master": {
+          "description": "Requirements of master hosts.",
+          "$ref": "#/definitions/hardware-requirements"
+        },
+        "name": {
+          "description": "Unique name of the profile.",
+          "type": "string"
+        },
+        "unassigned": {
+          "description": "Requirements of hosts without an assigned role.",
+          "$ref": "#/definitions/hardware-requirements"
+        },
+        "worker": {
+          "description": "Requirements of worker hosts.",
+          "$ref": "#/definitions/hardware-requirements"
+        }
+      }
+    },
+    "hardware-profile-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/hardware-profile"
+      }
+    },
+    "hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Minimum number of CPU cores.",
+          "type": "integer"
+        },
+        "disk_size_gib": {
+          "description": "Minimum size in GiB of the installation disk.",
+          "type": "integer"
+        },
+        "ram_gib": {
+          "description": "Minimum amount of RAM in GiB.",
+          "type": "integer"
+        }
+      }
+    },
+    "host": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "status",
+        "status_info"
+      ],
+      "properties": {
+        "bootstrap": {
+          "type": "boolean"
+        },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "cluster_id": {
+          "description": "The cluster that this host is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
+        },
+        "connectivity": {
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/connectivity-report"
         },
         "created_at": {
-          "description": "The time that this cluster was created.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:datetime\""
         },
-        "dns_vip": {
-          "description": "Virtual IP used internally by the cluster for automating internal DNS requirements.",
+        "free_addresses": {
+          "description": "The free addresses of the machine networks (free-networks-addresses) found by the last scan of the host, in JSON format.",
           "type": "string",
-          "format": "ipv4"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "hosts": {
-          "description": "Hosts that are associated with this cluster.",
-          "type": "array",
-          "items": {
-            "type": "object",
-            "$ref": "#/definitions/host"
-          },
-          "x-go-custom-tag": "gorm:\"foreignkey:ClusterID;association_foreignkey:ID\""
+        "free_addresses_updated_at": {
+          "description": "The last time the host reported the free addresses of the machine networks.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "hardware_info": {
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
         "href": {
           "description": "Self link.",
@@ -1017,853 +2723,1607 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
+        "installation_disk": {
+          "description": "The disk the host will be installed on, as set by the user. Identified by its name, by-path, WWN or serial number. Empty if the disk is chosen by the default selection policy.",
+          "type": "string"
+        },
+        "inventory": {
+          "description": "The last inventory (inventory) received from the host, in JSON format.",
           "type": "string",
-          "format": "ipv4"
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "install_completed_at": {
-          "description": "The time that this cluster completed installation.",
+        "installation_disk": {
+          "description": "The disk the host will be installed on, as set by the user. Identified by its name, by-path, WWN or serial number. Empty if the disk is chosen by the default selection policy.",
+          "type": "string"
+        },
+        "inventory": {
+          "description": "The last inventory (inventory) received from the host, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
+          "type": "string",
+          "enum": [
+            "Host"
+          ]
+        },
+        "role": {
+          "description": "The host role. Hosts with the auto-assign role are given the master or worker role when the cluster installation starts.",
+          "type": "string",
+          "enum": [
+            "undefined",
+            "master",
+            "worker",
+            "auto-assign"
+          ]
+        },
+        "role_info": {
+          "description": "Explains why the role was chosen, for hosts whose role was automatically assigned.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "discovering",
+            "known",
+            "disconnected",
+            "insufficient",
+            "disabled",
+            "installing",
+            "installed",
+            "error"
+          ]
+        },
+        "status_info": {
+          "type": "string"
+        },
+        "updated_at": {
           "type": "string",
           "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "validations_info": {
+          "description": "The results (validation-results) of the last validation of the host hardware, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        }
+      }
+    },
+    "host-create-params": {
+      "type": "object",
+      "required": [
+        "host_id"
+      ],
+      "properties": {
+        "host_id": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "host-install-progress-params": {
+      "type": "string"
+    },
+    "host-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/host"
+      }
+    },
+    "image": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "cluster_id",
+        "status"
+      ],
+      "properties": {
+        "checksum": {
+          "description": "SHA256 checksum of the image, available once the image is ready if its generation job stored it.",
+          "type": "string"
+        },
+        "cluster_id": {
+          "description": "The cluster that this image is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "expires_at": {
+          "description": "The time the image will be deleted at, images of clusters that are being installed are kept until the installation ends. Not set for images that never expire.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\"",
+          "x-nullable": true
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
         },
-        "install_started_at": {
-          "description": "The time that this cluster began installation.",
+        "id": {
+          "description": "Unique identifier of the object.",
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
         },
         "kind": {
-          "description": "Indicates the type of this object. Will be 'Cluster' if this is a complete object or 'ClusterLink' if it is just a link.",
+          "description": "Indicates the type of this object.",
           "type": "string",
           "enum": [
-            "Cluster"
+            "Image"
           ]
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "params_digest": {
+          "description": "Digest of the image generation parameters, images generated with identical parameters are reused.",
           "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "4.4"
-          ]
-        },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+        "proxy_url": {
+          "description": "The proxy the image was generated with, credentials are redacted.",
+          "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "size_bytes": {
+          "description": "Size of the image in bytes, available once the image is ready.",
+          "type": "integer"
         },
         "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+          "description": "The SSH public key the image was generated with, it is encrypted in the DB and redacted in the responses.",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/secret"
         },
         "status": {
-          "description": "Status of the OpenShift cluster.",
+          "description": "The image generation status. Images are queued until their generation job starts.",
           "type": "string",
           "enum": [
-            "insufficient",
+            "queued",
+            "building",
             "ready",
-            "error",
-            "installing",
-            "installed"
+            "failed"
           ]
         },
         "status_info": {
-          "description": "Additional information pertaining to the status of the OpenShift cluster.",
+          "description": "The reason of the failure, for images that failed to be generated.",
           "type": "string"
         },
         "updated_at": {
-          "description": "The last time that this cluster was updated.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:datetime\""
         }
       }
     },
-    "cluster-create-params": {
+    "image-create-params": {
       "type": "object",
-      "required": [
-        "name",
-        "openshift_version"
-      ],
       "properties": {
-        "api_vip": {
-          "description": "Virtual IP used to reach the OpenShift cluster API.",
-          "type": "string",
-          "format": "ipv4"
+        "proxy_url": {
+          "description": "The URL of the HTTP/S proxy that agents should use to access the discovery service\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
+          "type": "string"
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "ssh_public_key": {
+          "description": "SSH public key for debugging the installation.",
+          "type": "string"
+        }
+      }
+    },
+    "image-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/image"
+      }
+    },
+    "installation-disk-params": {
+      "type": "object",
+      "properties": {
+        "disk": {
+          "description": "The name, by-path, WWN or serial number of the disk as reported in the host inventory. An empty value restores the default selection policy.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-disk-params": {
+      "type": "object",
+      "properties": {
+        "disk": {
+          "description": "The name, by-path, WWN or serial number of the disk as reported in the host inventory. An empty value restores the default selection policy.",
+          "type": "string"
+        }
+      }
+    },
+    "interface": {
+      "type": "object",
+      "properties": {
+        "biosdevname": {
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "client_id": {
+          "type": "string"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "flags": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "dns_vip": {
-          "description": "Virtual IP used internally by the cluster for automating internal DNS requirements.",
-          "type": "string",
-          "format": "ipv4"
+        "has_carrier": {
+          "type": "boolean"
         },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "format": "ipv4"
+        "ipv4_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
-          "description": "Name of the OpenShift cluster.",
+        "ipv6_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "mac_address": {
           "type": "string"
         },
-        "openshift_version": {
-          "description": "Version of the OpenShift cluster.",
-          "type": "string",
-          "enum": [
-            "4.4"
-          ]
+        "mtu": {
+          "type": "integer"
         },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+        "name": {
           "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "product": {
+          "type": "string"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
+        "speed_mbps": {
+          "type": "integer"
+        },
+        "vendor": {
           "type": "string"
         }
       }
     },
-    "cluster-list": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/cluster"
+    "introspection": {
+      "type": "object",
+      "properties": {
+        "block_devices": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/block-device"
+          }
+        },
+        "cpu": {
+          "$ref": "#/definitions/cpu_details"
+        },
+        "memory": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/memory_details"
+          }
+        },
+        "nics": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/nic"
+          }
+        }
       }
     },
-    "cluster-update-params": {
+    "inventory": {
       "type": "object",
       "properties": {
-        "api_vip": {
-          "description": "Virtual IP used to reach the OpenShift cluster API.",
-          "type": "string",
-          "format": "ipv4"
+        "bmc_address": {
+          "type": "string"
         },
-        "base_dns_domain": {
-          "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
+        "bmc_v6address": {
           "type": "string"
         },
-        "cluster_network_cidr": {
-          "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+        "boot": {
+          "$ref": "#/definitions/boot"
         },
-        "cluster_network_host_prefix": {
-          "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "integer",
-          "maximum": 32,
-          "minimum": 1
+        "cpu": {
+          "$ref": "#/definitions/cpu"
         },
-        "dns_vip": {
-          "description": "Virtual IP used internally by the cluster for automating internal DNS requirements.",
-          "type": "string",
-          "format": "ipv4"
+        "disks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/disk"
+          }
         },
-        "hosts_roles": {
-          "description": "The desired role for hosts associated with the cluster.",
+        "hostname": {
+          "type": "string"
+        },
+        "interfaces": {
           "type": "array",
           "items": {
-            "type": "object",
-            "properties": {
-              "id": {
-                "type": "string",
-                "format": "uuid"
-              },
-              "role": {
-                "type": "string",
-                "enum": [
-                  "master",
-                  "worker"
-                ]
-              }
-            }
-          },
-          "x-go-custom-tag": "gorm:\"type:varchar(64)[]\""
+            "$ref": "#/definitions/interface"
+          }
         },
-        "ingress_vip": {
-          "description": "Virtual IP used for cluster ingress traffic.",
-          "type": "string",
-          "format": "ipv4"
+        "memory": {
+          "$ref": "#/definitions/memory"
         },
-        "name": {
-          "description": "OpenShift cluster name",
+        "system_vendor": {
+          "$ref": "#/definitions/system_vendor"
+        }
+      }
+    },
+    "l2-connectivity": {
+      "type": "object",
+      "properties": {
+        "outgoing_ip_address": {
+          "type": "string"
+        },
+        "outgoing_nic": {
+          "type": "string"
+        },
+        "remote_ip_address": {
+          "type": "string"
+        },
+        "remote_mac": {
+          "type": "string"
+        },
+        "successful": {
+          "type": "boolean"
+        }
+      }
+    },
+    "l3-connectivity": {
+      "type": "object",
+      "properties": {
+        "outgoing_nic": {
           "type": "string"
         },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+        "remote_ip_address": {
           "type": "string"
         },
-        "service_network_cidr": {
-          "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
-          "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
-        },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string"
+        "successful": {
+          "type": "boolean"
         }
       }
     },
-    "connectivity-check-host": {
+    "memory": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
+        "physical_bytes": {
+          "type": "integer"
         },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-check-nic"
-          }
+        "usable_bytes": {
+          "type": "integer"
         }
       }
     },
-    "connectivity-check-nic": {
+    "memory_details": {
       "type": "object",
       "properties": {
-        "ip_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "available": {
+          "type": "integer"
         },
-        "mac": {
-          "type": "string"
+        "buff_cached": {
+          "type": "integer"
+        },
+        "free": {
+          "type": "integer"
         },
         "name": {
           "type": "string"
+        },
+        "shared": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
+        },
+        "used": {
+          "type": "integer"
         }
       }
     },
-    "connectivity-check-params": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/connectivity-check-host"
-      }
-    },
-    "connectivity-remote-host": {
+    "nic": {
       "type": "object",
       "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "l2_connectivity": {
+        "cidrs": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/l2-connectivity"
+            "$ref": "#/definitions/cidr"
           }
         },
-        "l3_connectivity": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/l3-connectivity"
-          }
+        "mac": {
+          "type": "string"
+        },
+        "mtu": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
         }
       }
     },
-    "connectivity-report": {
-      "type": "object",
-      "properties": {
-        "remote_hosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/connectivity-remote-host"
-          }
-        }
+    "secret": {
+      "description": "A secret value, it is encrypted when stored in the DB.",
+      "type": "string",
+      "x-go-type": {
+        "hints": {
+          "kind": "primitive",
+          "noValidation": true
+        },
+        "import": {
+          "package": "github.com/filanov/bm-inventory/pkg/secret"
+        },
+        "type": "String"
       }
     },
-    "cpu": {
+    "step": {
       "type": "object",
       "properties": {
-        "architecture": {
-          "type": "string"
-        },
-        "count": {
-          "type": "integer"
-        },
-        "flags": {
+        "args": {
           "type": "array",
           "items": {
             "type": "string"
           }
         },
-        "frequency": {
-          "type": "number"
+        "command": {
+          "type": "string"
         },
-        "model_name": {
+        "step_id": {
           "type": "string"
+        },
+        "step_type": {
+          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "cpu_details": {
+    "step-reply": {
       "type": "object",
       "properties": {
-        "architecture": {
+        "error": {
           "type": "string"
         },
-        "cpu_mhz": {
-          "type": "number"
-        },
-        "cpus": {
+        "exit_code": {
           "type": "integer"
         },
-        "model_name": {
+        "output": {
           "type": "string"
         },
-        "sockets": {
-          "type": "integer"
-        },
-        "threads_per_core": {
-          "type": "integer"
+        "step_id": {
+          "type": "string"
         }
       }
     },
-    "debug-step": {
-      "type": "object",
-      "required": [
-        "command"
-      ],
-      "properties": {
-        "command": {
-          "type": "string"
-        }
+    "step-type": {
+      "type": "string",
+      "enum": [
+        "hardware-info",
+        "connectivity-check",
+        "execute",
+        "inventory",
+        "free-network-addresses"
+      ]
+    },
+    "steps": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step"
       }
     },
-    "disk": {
+    "steps-reply": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/step-reply"
+      }
+    },
+    "system_vendor": {
       "type": "object",
       "properties": {
-        "by_path": {
+        "manufacturer": {
           "type": "string"
         },
-        "drive_type": {
+        "product_name": {
           "type": "string"
         },
-        "hctl": {
+        "serial_number": {
           "type": "string"
-        },
-        "model": {
+        }
+      }
+    },
+    "validation-result": {
+      "type": "object",
+      "required": [
+        "id",
+        "status",
+        "category"
+      ],
+      "properties": {
+        "actual": {
+          "description": "The value found during the validation.",
           "type": "string"
         },
-        "name": {
-          "type": "string"
+        "category": {
+          "type": "string",
+          "enum": [
+            "cpu",
+            "memory",
+            "disk",
+            "network",
+            "role"
+          ]
         },
-        "path": {
+        "expected": {
+          "description": "The value required to pass the validation.",
           "type": "string"
         },
-        "serial": {
+        "id": {
+          "description": "Identifier of the validation.",
           "type": "string"
         },
-        "size_bytes": {
-          "type": "integer"
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending"
+          ]
+        }
+      }
+    },
+    "validation-results": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/validation-result"
+      }
+    },
+    "watch-event": {
+      "type": "object",
+      "required": [
+        "type",
+        "resource_version"
+      ],
+      "properties": {
+        "event": {
+          "$ref": "#/definitions/event"
         },
-        "vendor": {
-          "type": "string"
+        "resource_version": {
+          "description": "The resource version to resume the watch from after this watch event.",
+          "type": "integer"
         },
-        "wwn": {
-          "type": "string"
+        "type": {
+          "description": "Change events hold a state transition or a change of the cluster or its hosts, bookmarks only advance the resource version.",
+          "type": "string",
+          "enum": [
+            "change",
+            "bookmark"
+          ]
         }
       }
     },
-    "error": {
+    "webhook": {
       "type": "object",
       "required": [
-        "kind",
         "id",
-        "href",
-        "code",
-        "reason"
+        "url",
+        "event_types"
       ],
       "properties": {
-        "code": {
-          "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, for if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
-          "type": "string"
+        "cluster_id": {
+          "description": "The cluster whose events are notified, not set for subscriptions to the events of all the clusters.",
+          "type": "string",
+          "format": "uuid"
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+        "created_at": {
+          "description": "The time the webhook was subscribed.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "event_types": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook-event-type"
+          }
         },
         "id": {
-          "description": "Numeric identifier of the error.",
-          "type": "integer",
-          "format": "int32",
-          "maximum": 504,
-          "minimum": 400
+          "description": "Unique identifier of the webhook subscription.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "url": {
+          "description": "The URL the notifications are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "secret",
+        "event_types"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Notify only on the events of this cluster, the events of all the clusters are notified when not set.",
+          "type": "string",
+          "format": "uuid"
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will always be 'Error'.",
+        "event_types": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/webhook-event-type"
+          }
+        },
+        "secret": {
+          "description": "The key of the HMAC-SHA256 signature of the notifications, sent in the X-Webhook-Signature header.",
           "type": "string",
-          "enum": [
-            "Error"
-          ]
+          "minLength": 16
         },
-        "reason": {
-          "description": "Human readable description of the error.",
+        "url": {
+          "description": "The http or https URL the notifications are posted to.",
           "type": "string"
         }
       }
     },
-    "host": {
+    "webhook-delivery": {
       "type": "object",
       "required": [
-        "kind",
         "id",
-        "href",
-        "status",
-        "status_info"
+        "webhook_id",
+        "event_id",
+        "status"
       ],
       "properties": {
-        "bootstrap": {
-          "type": "boolean"
-        },
-        "cluster_id": {
-          "description": "The cluster that this host is associated with.",
-          "type": "string",
-          "format": "uuid",
-          "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
-        },
-        "connectivity": {
-          "$ref": "#/definitions/connectivity-report"
+        "attempts": {
+          "description": "The number of delivery attempts.",
+          "type": "integer"
         },
         "created_at": {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:datetime\""
         },
-        "hardware_info": {
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:text\""
+        "event_id": {
+          "description": "The event that is notified.",
+          "type": "integer"
         },
-        "href": {
-          "description": "Self link.",
-          "type": "string"
+        "event_type": {
+          "$ref": "#/definitions/webhook-event-type"
         },
         "id": {
-          "description": "Unique identifier of the object.",
-          "type": "string",
-          "format": "uuid",
+          "description": "Unique identifier of the delivery, sent in the X-Webhook-Delivery header.",
+          "type": "integer",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
-        "kind": {
-          "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
+        "last_attempt_at": {
           "type": "string",
-          "enum": [
-            "Host"
-          ]
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
         },
-        "role": {
+        "last_error": {
+          "description": "The reason the last attempt failed.",
           "type": "string",
-          "enum": [
-            "undefined",
-            "master",
-            "worker"
-          ]
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "next_attempt_at": {
+          "description": "The time the pending delivery is attempted at.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
+        },
+        "payload": {
+          "description": "The webhook-notification that is posted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "response_code": {
+          "description": "The HTTP status code of the last attempt, not set when no response was received.",
+          "type": "integer"
         },
         "status": {
+          "description": "Pending deliveries are retried with backoff until they are delivered, or fail after the maximal number of attempts.",
           "type": "string",
           "enum": [
-            "discovering",
-            "known",
-            "disconnected",
-            "insufficient",
-            "disabled",
-            "installing",
-            "installed",
-            "error"
+            "pending",
+            "delivered",
+            "failed"
           ]
         },
-        "status_info": {
-          "type": "string"
-        },
-        "updated_at": {
+        "webhook_id": {
           "type": "string",
-          "format": "date-time",
-          "x-go-custom-tag": "gorm:\"type:datetime\""
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
         }
       }
     },
-    "host-create-params": {
-      "type": "object",
-      "required": [
-        "host_id"
-      ],
-      "properties": {
-        "host_id": {
-          "type": "string",
-          "format": "uuid"
-        }
+    "webhook-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-delivery"
       }
     },
-    "host-install-progress-params": {
-      "type": "string"
+    "webhook-event-type": {
+      "type": "string",
+      "enum": [
+        "host-discovered",
+        "host-insufficient",
+        "cluster-ready",
+        "installation-completed",
+        "installation-failed"
+      ]
     },
-    "host-list": {
+    "webhook-list": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/host"
+        "$ref": "#/definitions/webhook"
       }
     },
-    "image-create-params": {
+    "webhook-notification": {
       "type": "object",
+      "required": [
+        "event_type",
+        "event"
+      ],
       "properties": {
-        "proxy_url": {
-          "description": "The URL of the HTTP/S proxy that agents should use to access the discovery service\nhttp://\\\u003cuser\\\u003e:\\\u003cpassword\\\u003e@\\\u003cserver\\\u003e:\\\u003cport\\\u003e/\n",
-          "type": "string"
+        "event": {
+          "$ref": "#/definitions/event"
         },
-        "ssh_public_key": {
-          "description": "SSH public key for debugging the installation.",
-          "type": "string"
+        "event_type": {
+          "$ref": "#/definitions/webhook-event-type"
         }
       }
-    },
-    "interface": {
-      "type": "object",
-      "properties": {
-        "biosdevname": {
-          "type": "string"
-        },
-        "client_id": {
-          "type": "string"
-        },
-        "flags": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    }
+  },
+  "securityDefinitions": {
+    "userAuth": {
+      "description": "A bearer token of the user, a JWT issued by the configured issuer or a static token.",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "userAuth": []
+    }
+  ],
+  "tags": [
+    {
+      "description": "Assisted bare metal installation",
+      "name": "Assisted installation"
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Assisted installation",
+    "title": "AssistedInstall",
+    "version": "1.0.0"
+  },
+  "host": "api.openshift.com",
+  "basePath": "/api/assisted-install/v1",
+  "paths": {
+    "/clusters": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of OpenShift bare metal clusters.",
+        "operationId": "ListClusters",
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "has_carrier": {
-          "type": "boolean"
-        },
-        "ipv4_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        }
+      },
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Creates a new OpenShift bare metal cluster definition.",
+        "operationId": "RegisterCluster",
+        "parameters": [
+          {
+            "name": "new-cluster-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{clusterId}/hosts/{hostId}/progress": {
+      "put": {
+        "security": [],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Update installation progress",
+        "operationId": "UpdateHostInstallProgress",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the cluster to retrieve",
+            "name": "clusterId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "The ID of the host to retrieve",
+            "name": "hostId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "New progress value",
+            "name": "host-install-progress-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/host-install-progress-params"
+            }
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
-        },
-        "ipv6_addresses": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "Update install progress"
+          },
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "mac_address": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "product": {
-          "type": "string"
-        },
-        "speed_mbps": {
-          "type": "integer"
-        },
-        "vendor": {
-          "type": "string"
         }
       }
     },
-    "introspection": {
-      "type": "object",
-      "properties": {
-        "block_devices": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/block-device"
+    "/clusters/{cluster_id}": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the details of the OpenShift bare metal cluster.",
+        "operationId": "GetCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Return the pull secret and the SSH public key of the cluster, which are redacted otherwise. Requires the write permission.",
+            "name": "with_secrets",
+            "in": "query"
           }
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu_details"
-        },
-        "memory": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/memory_details"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            },
+            "headers": {
+              "X-Resource-Version": {
+                "type": "integer",
+                "description": "The resource version the returned state is up to date with, watches resumed from it stream the changes made after it."
+              }
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "nics": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/nic"
+        }
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deletes an OpenShift bare metal cluster definition.",
+        "operationId": "DeregisterCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Updates an OpenShift bare metal cluster definition.",
+        "operationId": "UpdateCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "cluster-update-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/cluster-update-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "inventory": {
-      "type": "object",
-      "properties": {
-        "bmc_address": {
-          "type": "string"
-        },
-        "bmc_v6address": {
-          "type": "string"
-        },
-        "boot": {
-          "$ref": "#/definitions/boot"
-        },
-        "cpu": {
-          "$ref": "#/definitions/cpu"
-        },
-        "disks": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/disk"
+    "/clusters/{cluster_id}/actions/install": {
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Installs the OpenShift bare metal cluster.",
+        "operationId": "InstallCluster",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
           }
-        },
-        "hostname": {
-          "type": "string"
-        },
-        "interfaces": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/interface"
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/cluster"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "memory": {
-          "$ref": "#/definitions/memory"
-        },
-        "system_vendor": {
-          "$ref": "#/definitions/system_vendor"
-        }
-      }
-    },
-    "l2-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_ip_address": {
-          "type": "string"
-        },
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "remote_mac": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
         }
       }
     },
-    "l3-connectivity": {
-      "type": "object",
-      "properties": {
-        "outgoing_nic": {
-          "type": "string"
-        },
-        "remote_ip_address": {
-          "type": "string"
-        },
-        "successful": {
-          "type": "boolean"
+    "/clusters/{cluster_id}/agent-token": {
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Revokes the agent tokens of all the discovery images of the cluster, a new token is embedded in the next discovery image that is generated.",
+        "operationId": "RevokeAgentToken",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success."
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "memory": {
-      "type": "object",
-      "properties": {
-        "physical_bytes": {
-          "type": "integer"
-        },
-        "usable_bytes": {
-          "type": "integer"
+    "/clusters/{cluster_id}/artifacts": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of files generated for the installed/installing cluster that can be downloaded.",
+        "operationId": "ListClusterArtifacts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/artifact-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "memory_details": {
-      "type": "object",
-      "properties": {
-        "available": {
-          "type": "integer"
-        },
-        "buff_cached": {
-          "type": "integer"
-        },
-        "free": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "shared": {
-          "type": "integer"
-        },
-        "total": {
-          "type": "integer"
-        },
-        "used": {
-          "type": "integer"
+    "/clusters/{cluster_id}/downloads/files": {
+      "get": {
+        "description": "Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Downloads files relating to the installed/installing cluster.",
+        "operationId": "DownloadClusterFiles",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "bootstrap.ign",
+              "master.ign",
+              "metadata.json",
+              "worker.ign",
+              "kubeadmin-password",
+              "kubeconfig"
+            ],
+            "type": "string",
+            "name": "file_name",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation, or the artifact is sensitive and the user lacks the sensitive permission.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "nic": {
-      "type": "object",
-      "properties": {
-        "cidrs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/cidr"
+    "/clusters/{cluster_id}/downloads/image": {
+      "get": {
+        "description": "Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "installer"
+        ],
+        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
+        "operationId": "DownloadClusterISO",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "image_id",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "type": "string",
+              "format": "binary"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "409": {
+            "description": "The image is not ready.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "mac": {
-          "type": "string"
-        },
-        "mtu": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "state": {
-          "type": "string"
         }
-      }
-    },
-    "step": {
-      "type": "object",
-      "properties": {
-        "args": {
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
+        "operationId": "GenerateClusterISO",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "image-create-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/image-create-params"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success. The image is generated in the background, a previous image generated with identical parameters is returned instead of generating a new one.",
+            "schema": {
+              "$ref": "#/definitions/image"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "command": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
-        },
-        "step_type": {
-          "$ref": "#/definitions/step-type"
         }
       }
     },
-    "step-reply": {
-      "type": "object",
-      "properties": {
-        "error": {
-          "type": "string"
-        },
-        "exit_code": {
-          "type": "integer"
-        },
-        "output": {
-          "type": "string"
-        },
-        "step_id": {
-          "type": "string"
+    "/clusters/{cluster_id}/events": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the state transitions of the cluster and its hosts, ordered by the time they occurred at.",
+        "operationId": "ListClusterEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Retrieve only the state transitions of this host.",
+            "name": "host_id",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Retrieve only the state transitions that occurred at this time or after it.",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Retrieve only the state transitions that occurred before this time.",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "description": "Retrieve only the events after the event with this ID. The next page of events is retrieved by passing the ID of the last event of the previous page.",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "default": 100,
+            "description": "The maximal number of events to retrieve.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/event-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "step-type": {
-      "type": "string",
-      "enum": [
-        "hardware-info",
-        "connectivity-check",
-        "execute",
-        "inventory"
-      ]
-    },
-    "steps": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step"
-      }
-    },
-    "steps-reply": {
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/step-reply"
-      }
-    },
-    "system_vendor": {
-      "type": "object",
-      "properties": {
-        "manufacturer": {
-          "type": "string"
-        },
-        "product_name": {
-          "type": "string"
-        },
-        "serial_number": {
-          "type": "string"
-        }
-      }
-    }
-  },
-  "tags": [
-    {
-      "description": "Assisted bare metal installation",
-      "name": "Assisted installation"
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Assisted installation",
-    "title": "AssistedInstall",
-    "version": "1.0.0"
-  },
-  "host": "api.openshift.com",
-  "basePath": "/api/assisted-install/v1",
-  "paths": {
-    "/clusters": {
+    "/clusters/{cluster_id}/free_addresses": {
+      "get": {
+        "description": "The addresses are found free by a scan of the machine networks made by one of the cluster hosts, the addresses of the hosts and the VIPs already set are excluded.",
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the free addresses of the machine networks of the cluster, which are candidates for the VIPs.",
+        "operationId": "GetFreeAddresses",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$",
+            "type": "string",
+            "description": "Return the free addresses of this machine network only.",
+            "name": "network",
+            "in": "query"
+          },
+          {
+            "maximum": 1024,
+            "minimum": 1,
+            "type": "integer",
+            "default": 8,
+            "description": "The maximal number of free addresses returned for each machine network.",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/free-networks-addresses"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/clusters/{cluster_id}/hosts": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the list of OpenShift bare metal clusters.",
-        "operationId": "ListClusters",
+        "summary": "Retrieves the list of OpenShift bare metal hosts.",
+        "operationId": "ListHosts",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster-list"
+              "$ref": "#/definitions/host-list"
+            },
+            "headers": {
+              "X-Resource-Version": {
+                "type": "integer",
+                "description": "The resource version the returned state is up to date with, watches resumed from it stream the changes made after it."
+              }
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "500": {
@@ -1875,26 +4335,40 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
           "installer"
         ],
-        "summary": "Creates a new OpenShift bare metal cluster definition.",
-        "operationId": "RegisterCluster",
+        "summary": "Registers a new OpenShift bare metal host.",
+        "operationId": "RegisterHost",
         "parameters": [
           {
-            "name": "new-cluster-params",
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "new-host-params",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/cluster-create-params"
+              "$ref": "#/definitions/host-create-params"
             }
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
         ],
         "responses": {
           "201": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -1903,6 +4377,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -1912,54 +4392,116 @@ func init() {
         }
       }
     },
-    "/clusters/{clusterId}/hosts/{hostId}/progress": {
-      "put": {
+    "/clusters/{cluster_id}/hosts/{host_id}": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Update installation progress",
-        "operationId": "UpdateHostInstallProgress",
+        "summary": "Retrieves the details of the OpenShift bare metal host.",
+        "operationId": "GetHost",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the cluster to retrieve",
-            "name": "clusterId",
+            "name": "cluster_id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
             "format": "uuid",
-            "description": "The ID of the host to retrieve",
-            "name": "hostId",
+            "name": "host_id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/host"
+            }
           },
-          {
-            "description": "New progress value",
-            "name": "host-install-progress-params",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
-              "$ref": "#/definitions/host-install-progress-params"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Deregisters an OpenShift bare metal host.",
+        "operationId": "DeregisterHost",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "cluster_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Update install progress"
+          "204": {
+            "description": "Success."
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "/clusters/{cluster_id}": {
-      "get": {
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/debug": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the details of the OpenShift bare metal cluster.",
-        "operationId": "GetCluster",
+        "summary": "Sets a single shot debug step that will be sent next time the host agent will ask for a command.",
+        "operationId": "SetDebugStep",
         "parameters": [
           {
             "type": "string",
@@ -1967,13 +4509,31 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "step",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/debug-step"
+            }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -1989,13 +4549,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Deletes an OpenShift bare metal cluster definition.",
-        "operationId": "DeregisterCluster",
+        "summary": "Enables a host for inclusion in the cluster.",
+        "operationId": "EnableHost",
         "parameters": [
           {
             "type": "string",
@@ -2003,12 +4565,25 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "204": {
             "description": "Success."
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -2029,12 +4604,12 @@ func init() {
           }
         }
       },
-      "patch": {
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Updates an OpenShift bare metal cluster definition.",
-        "operationId": "UpdateCluster",
+        "summary": "Disables a host for inclusion in the cluster.",
+        "operationId": "DisableHost",
         "parameters": [
           {
             "type": "string",
@@ -2044,23 +4619,19 @@ func init() {
             "required": true
           },
           {
-            "name": "cluster-update-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/cluster-update-params"
-            }
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/cluster"
-            }
+          "204": {
+            "description": "Success."
           },
-          "400": {
-            "description": "Error.",
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2086,13 +4657,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/actions/install": {
-      "post": {
+    "/clusters/{cluster_id}/hosts/{host_id}/installation_disk": {
+      "put": {
         "tags": [
           "installer"
         ],
-        "summary": "Installs the OpenShift bare metal cluster.",
-        "operationId": "InstallCluster",
+        "summary": "Sets the disk the host will be installed on, instead of the one chosen by the default selection policy.",
+        "operationId": "SetHostInstallationDisk",
         "parameters": [
           {
             "type": "string",
@@ -2100,13 +4671,28 @@ func init() {
             "name": "cluster_id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "installation-disk-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installation-disk-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/cluster"
+              "$ref": "#/definitions/host"
             }
           },
           "400": {
@@ -2115,6 +4701,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -2136,16 +4728,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/files": {
-      "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+    "/clusters/{cluster_id}/hosts/{host_id}/installation_disk": {
+      "put": {
         "tags": [
           "installer"
         ],
-        "summary": "Downloads files relating to the installed/installing cluster.",
-        "operationId": "DownloadClusterFiles",
+        "summary": "Sets the disk the host will be installed on, instead of the one chosen by the default selection policy.",
+        "operationId": "SetHostInstallationDisk",
         "parameters": [
           {
             "type": "string",
@@ -2155,25 +4744,32 @@ func init() {
             "required": true
           },
           {
-            "enum": [
-              "bootstrap.ign",
-              "master.ign",
-              "metadata.json",
-              "worker.ign",
-              "kubeadmin-password",
-              "kubeconfig"
-            ],
             "type": "string",
-            "name": "file_name",
-            "in": "query",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
             "required": true
+          },
+          {
+            "name": "installation-disk-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/installation-disk-params"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "file"
+              "$ref": "#/definitions/host"
+            }
+          },
+          "400": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -2197,16 +4793,14 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/downloads/image": {
+    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
       "get": {
-        "produces": [
-          "application/octet-stream"
-        ],
+        "security": [],
         "tags": [
           "installer"
         ],
-        "summary": "Downloads the OpenShift per-cluster discovery ISO.",
-        "operationId": "DownloadClusterISO",
+        "summary": "Retrieves the next operations that the host agent needs to perform.",
+        "operationId": "GetNextSteps",
         "parameters": [
           {
             "type": "string",
@@ -2218,21 +4812,26 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "name": "image_id",
-            "in": "query",
+            "name": "host_id",
+            "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
         ],
         "responses": {
           "200": {
             "description": "Success.",
             "schema": {
-              "type": "string",
-              "format": "binary"
+              "$ref": "#/definitions/steps"
             }
           },
-          "400": {
-            "description": "Error.",
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2252,11 +4851,12 @@ func init() {
         }
       },
       "post": {
+        "security": [],
         "tags": [
           "installer"
         ],
-        "summary": "Creates a new OpenShift per-cluster discovery ISO.",
-        "operationId": "GenerateClusterISO",
+        "summary": "Posts the result of the operations from the host agent.",
+        "operationId": "PostStepReply",
         "parameters": [
           {
             "type": "string",
@@ -2266,26 +4866,29 @@ func init() {
             "required": true
           },
           {
-            "name": "image-create-params",
+            "type": "string",
+            "format": "uuid",
+            "name": "host_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "reply",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/image-create-params"
+              "$ref": "#/definitions/step-reply"
             }
+          },
+          {
+            "type": "string",
+            "description": "The agent token of the cluster, it is embedded in the discovery images of the cluster.",
+            "name": "X-Agent-Token",
+            "in": "header"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "type": "object",
-              "properties": {
-                "imageId": {
-                  "type": "string",
-                  "format": "uuid"
-                }
-              }
-            }
+          "204": {
+            "description": "Success."
           },
           "400": {
             "description": "Error.",
@@ -2293,6 +4896,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "401": {
+            "description": "The agent token is missing, revoked or doesn't belong to the cluster.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "404": {
             "description": "Error.",
             "schema": {
@@ -2308,13 +4917,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts": {
+    "/clusters/{cluster_id}/images": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the list of OpenShift bare metal hosts.",
-        "operationId": "ListHosts",
+        "summary": "Retrieves the list of OpenShift per-cluster discovery ISOs.",
+        "operationId": "ListClusterImages",
         "parameters": [
           {
             "type": "string",
@@ -2328,48 +4937,16 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host-list"
+              "$ref": "#/definitions/image-list"
             }
           },
-          "500": {
-            "description": "Error.",
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
               "$ref": "#/definitions/error"
             }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "installer"
-        ],
-        "summary": "Registers a new OpenShift bare metal host.",
-        "operationId": "RegisterHost",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "new-host-params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/host-create-params"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Success.",
-            "schema": {
-              "$ref": "#/definitions/host"
-            }
           },
-          "400": {
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -2384,13 +4961,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}": {
+    "/clusters/{cluster_id}/images/{image_id}": {
       "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the details of the OpenShift bare metal host.",
-        "operationId": "GetHost",
+        "summary": "Retrieves the generation status of an OpenShift per-cluster discovery ISO.",
+        "operationId": "GetClusterImage",
         "parameters": [
           {
             "type": "string",
@@ -2402,7 +4979,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "name": "host_id",
+            "name": "image_id",
             "in": "path",
             "required": true
           }
@@ -2411,7 +4988,13 @@ func init() {
           "200": {
             "description": "Success.",
             "schema": {
-              "$ref": "#/definitions/host"
+              "$ref": "#/definitions/image"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -2432,8 +5015,8 @@ func init() {
         "tags": [
           "installer"
         ],
-        "summary": "Deregisters an OpenShift bare metal host.",
-        "operationId": "DeregisterHost",
+        "summary": "Deletes an OpenShift per-cluster discovery ISO.",
+        "operationId": "DeleteClusterImage",
         "parameters": [
           {
             "type": "string",
@@ -2445,7 +5028,7 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "name": "host_id",
+            "name": "image_id",
             "in": "path",
             "required": true
           }
@@ -2454,8 +5037,8 @@ func init() {
           "204": {
             "description": "Success."
           },
-          "400": {
-            "description": "Error.",
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2466,6 +5049,12 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
+          "409": {
+            "description": "The image is being generated.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
           "500": {
             "description": "Error.",
             "schema": {
@@ -2475,13 +5064,13 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/debug": {
-      "post": {
+    "/clusters/{cluster_id}/watch": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Sets a single shot debug step that will be sent next time the host agent will ask for a command.",
-        "operationId": "SetDebugStep",
+        "summary": "Streams the state transitions of the cluster and its hosts as they are committed.",
+        "operationId": "WatchCluster",
         "parameters": [
           {
             "type": "string",
@@ -2491,27 +5080,56 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "type": "integer",
+            "description": "Stream the changes made after this resource version, such as the X-Resource-Version header of GetCluster and\nListHosts, or the resource version of the last received watch event. Only the changes made after the watch\nstarted are streamed when it is not set.\n",
+            "name": "resource_version",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A stream of watch events, one JSON object per line, that is kept open until the client closes it.\nBookmarks are streamed when the stream starts and periodically, with the resource version the stream is up to date with.\n",
+            "schema": {
+              "$ref": "#/definitions/watch-event"
+            }
           },
-          {
-            "name": "step",
-            "in": "body",
-            "required": true,
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
-              "$ref": "#/definitions/debug-step"
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "500": {
+            "description": "Error.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
+        }
+      }
+    },
+    "/hardware_profiles": {
+      "get": {
+        "tags": [
+          "installer"
         ],
+        "summary": "Retrieves the list of hardware requirement profiles that clusters can be validated against.",
+        "operationId": "ListHardwareProfiles",
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/hardware-profile-list"
+            }
           },
-          "404": {
-            "description": "Error.",
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2525,32 +5143,33 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/actions/enable": {
-      "post": {
+    "/hardware_profiles/{profile_name}": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Enables a host for inclusion in the cluster.",
-        "operationId": "EnableHost",
+        "summary": "Retrieves the details of a hardware requirement profile.",
+        "operationId": "GetHardwareProfile",
         "parameters": [
           {
             "type": "string",
-            "format": "uuid",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
+            "name": "profile_name",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/hardware-profile"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "404": {
             "description": "Error.",
@@ -2558,11 +5177,43 @@ func init() {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "500": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "tags": [
+          "installer"
+        ],
+        "summary": "Retrieves the list of webhook subscriptions.",
+        "operationId": "ListWebhooks",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Retrieve only the subscriptions to the events of this cluster, and the global subscriptions.",
+            "name": "cluster_id",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-list"
+            }
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           },
           "500": {
             "description": "Error.",
@@ -2572,39 +5223,42 @@ func init() {
           }
         }
       },
-      "delete": {
+      "post": {
         "tags": [
           "installer"
         ],
-        "summary": "Disables a host for inclusion in the cluster.",
-        "operationId": "DisableHost",
+        "summary": "Subscribes a webhook to notifications of cluster and host lifecycle events.",
+        "operationId": "RegisterWebhook",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
-            "in": "path",
-            "required": true
+            "name": "new-webhook-params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook-create-params"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "201": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
           },
-          "404": {
+          "400": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
             }
           },
-          "409": {
+          "403": {
+            "description": "The user is not permitted to perform the operation, only admins can subscribe to the events of all the clusters.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          },
+          "404": {
             "description": "Error.",
             "schema": {
               "$ref": "#/definitions/error"
@@ -2619,34 +5273,30 @@ func init() {
         }
       }
     },
-    "/clusters/{cluster_id}/hosts/{host_id}/instructions": {
-      "get": {
+    "/webhooks/{webhook_id}": {
+      "delete": {
         "tags": [
           "installer"
         ],
-        "summary": "Retrieves the next operations that the host agent needs to perform.",
-        "operationId": "GetNextSteps",
+        "summary": "Deletes a webhook subscription and its delivery history.",
+        "operationId": "DeregisterWebhook",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
+            "name": "webhook_id",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success.",
+          "204": {
+            "description": "Success."
+          },
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
-              "$ref": "#/definitions/steps"
+              "$ref": "#/definitions/error"
             }
           },
           "404": {
@@ -2662,42 +5312,33 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/webhooks/{webhook_id}/deliveries": {
+      "get": {
         "tags": [
           "installer"
         ],
-        "summary": "Posts the result of the operations from the host agent.",
-        "operationId": "PostStepReply",
+        "summary": "Retrieves the delivery history of a webhook subscription, ordered by the time the deliveries were created at.",
+        "operationId": "ListWebhookDeliveries",
         "parameters": [
           {
             "type": "string",
             "format": "uuid",
-            "name": "cluster_id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "host_id",
+            "name": "webhook_id",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "reply",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/step-reply"
-            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success."
+          "200": {
+            "description": "Success.",
+            "schema": {
+              "$ref": "#/definitions/webhook-delivery-list"
+            }
           },
-          "400": {
-            "description": "Error.",
+          "403": {
+            "description": "The user is not permitted to perform the operation.",
             "schema": {
               "$ref": "#/definitions/error"
             }
@@ -2730,11 +5371,44 @@ func init() {
           "type": "string",
           "enum": [
             "master",
-            "worker"
+            "worker",
+            "auto-assign"
           ]
         }
       }
     },
+    "artifact": {
+      "type": "object",
+      "required": [
+        "name",
+        "sensitive"
+      ],
+      "properties": {
+        "generated_at": {
+          "description": "The time the artifact was generated at.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "description": "The file name the artifact is downloaded by.",
+          "type": "string"
+        },
+        "sensitive": {
+          "description": "Sensitive artifacts contain cluster credentials, downloading them requires an explicit permission.",
+          "type": "boolean"
+        },
+        "size_bytes": {
+          "description": "Size of the artifact in bytes.",
+          "type": "integer"
+        }
+      }
+    },
+    "artifact-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/artifact"
+      }
+    },
     "block-device": {
       "type": "object",
       "properties": {
@@ -2808,10 +5482,19 @@ func init() {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
         },
+        "bootstrap_host_id": {
+          "description": "The master host that bootstraps the installation. Either pinned by the user or elected when the installation starts.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "bootstrap_info": {
+          "description": "Explains why the bootstrap host was chosen.",
+          "type": "string"
+        },
         "cluster_network_cidr": {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
@@ -2830,6 +5513,10 @@ func init() {
           "type": "string",
           "format": "ipv4"
         },
+        "hardware_profile": {
+          "description": "Name of the hardware requirement profile the cluster hosts are validated against.",
+          "type": "string"
+        },
         "hosts": {
           "description": "Hosts that are associated with this cluster.",
           "type": "array",
@@ -2873,6 +5560,15 @@ func init() {
             "Cluster"
           ]
         },
+        "machine_networks": {
+          "description": "The IPv4 networks that all the cluster hosts are connected to, detected from the NICs of the hosts. The VIPs are chosen from these networks.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-go-custom-tag": "gorm:\"-\"",
+          "readOnly": true
+        },
         "name": {
           "description": "Name of the OpenShift cluster.",
           "type": "string"
@@ -2884,20 +5580,30 @@ func init() {
             "4.4"
           ]
         },
-        "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+        "organization": {
+          "description": "The organization of the user that registered the cluster, the users of the organization can access the cluster.",
           "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(4096)\""
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "owner": {
+          "description": "The user that registered the cluster.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "pull_secret": {
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site. It is encrypted in the DB and redacted in the responses unless requested.",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/secret"
         },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
         },
         "ssh_public_key": {
-          "description": "SSH public key for debugging OpenShift nodes.",
-          "type": "string",
-          "x-go-custom-tag": "gorm:\"type:varchar(1024)\""
+          "description": "SSH public key for debugging OpenShift nodes. It is encrypted in the DB and redacted in the responses unless requested.",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/secret"
         },
         "status": {
           "description": "Status of the OpenShift cluster.",
@@ -2919,6 +5625,11 @@ func init() {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "validations_info": {
+          "description": "The results (validation-results) of the last validation of the cluster readiness for installation, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
@@ -2941,7 +5652,7 @@ func init() {
         "cluster_network_cidr": {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
@@ -2954,6 +5665,10 @@ func init() {
           "type": "string",
           "format": "ipv4"
         },
+        "hardware_profile": {
+          "description": "Name of the hardware requirement profile the cluster hosts are validated against.",
+          "type": "string"
+        },
         "ingress_vip": {
           "description": "Virtual IP used for cluster ingress traffic.",
           "type": "string",
@@ -2977,7 +5692,7 @@ func init() {
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
@@ -3003,10 +5718,15 @@ func init() {
           "description": "Base domain of the cluster. All DNS records must be sub-domains of this base and include the cluster name.",
           "type": "string"
         },
+        "bootstrap_host_id": {
+          "description": "The master host that will bootstrap the installation, instead of the one chosen by the bootstrap election policy.",
+          "type": "string",
+          "format": "uuid"
+        },
         "cluster_network_cidr": {
           "description": "IP address block from which Pod IPs are allocated This block must not overlap with existing physical networks. These IP addresses are used for the Pod network, and if you need to access the Pods from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
         },
         "cluster_network_host_prefix": {
           "description": "The subnet prefix length to assign to each individual node. For example, if clusterNetworkHostPrefix is set to 23, then each node is assigned a /23 subnet out of the given cidr (clusterNetworkCIDR), which allows for 510 (2^(32 - 23) - 2) pod IPs addresses. If you are required to provide access to nodes from an external network, configure load balancers and routers to manage the traffic.",
@@ -3019,6 +5739,10 @@ func init() {
           "type": "string",
           "format": "ipv4"
         },
+        "hardware_profile": {
+          "description": "Name of the hardware requirement profile the cluster hosts are validated against.",
+          "type": "string"
+        },
         "hosts_roles": {
           "description": "The desired role for hosts associated with the cluster.",
           "type": "array",
@@ -3037,13 +5761,13 @@ func init() {
           "type": "string"
         },
         "pull_secret": {
-          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site.",
+          "description": "The pull secret that obtained from the Pull Secret page on the Red Hat OpenShift Cluster Manager site..",
           "type": "string"
         },
         "service_network_cidr": {
           "description": "The IP address pool to use for service IP addresses. You can enter only one IP address pool. If you need to access the services from an external network, configure load balancers and routers to manage the traffic.",
           "type": "string",
-          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/[0-9]|[1-2][0-9]|3[0-2]?$"
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
         },
         "ssh_public_key": {
           "description": "SSH public key for debugging OpenShift nodes.",
@@ -3227,6 +5951,13 @@ func init() {
           "description": "Globally unique code of the error, composed of the unique identifier of the API and the numeric identifier of the error. For example, for if the numeric identifier of the error is 93 and the identifier of the API is assisted_install then the code will be ASSISTED-INSTALL-93.",
           "type": "string"
         },
+        "field_errors": {
+          "description": "The invalid fields of the request, when the error is caused by them.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/field-error"
+          }
+        },
         "href": {
           "description": "Self link.",
           "type": "string"
@@ -3251,6 +5982,170 @@ func init() {
         }
       }
     },
+    "event": {
+      "type": "object",
+      "required": [
+        "id",
+        "cluster_id",
+        "to_status",
+        "event_time"
+      ],
+      "properties": {
+        "change": {
+          "description": "The field of the cluster or host that changed without a state transition, such as role, hardware_info, inventory, connectivity or configuration for the updates of the cluster. Not set for state transitions, the from_status and to_status of a change are both the current status.",
+          "type": "string"
+        },
+        "cluster_id": {
+          "description": "The cluster the event occurred in.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "event_time": {
+          "description": "The time the transition occurred at.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "from_status": {
+          "description": "The status before the transition, not set when the cluster or host was registered.",
+          "type": "string"
+        },
+        "host_id": {
+          "description": "The host that changed its state, not set for state transitions of the cluster.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "id": {
+          "description": "Unique identifier of the event, events that occurred later have greater identifiers.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "request_id": {
+          "description": "The ID of the request, or of the background task, that made the transition.",
+          "type": "string"
+        },
+        "status_info": {
+          "description": "The status info after the transition.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "to_status": {
+          "description": "The status after the transition.",
+          "type": "string"
+        }
+      }
+    },
+    "event-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/event"
+      }
+    },
+    "field-error": {
+      "type": "object",
+      "required": [
+        "field",
+        "reason"
+      ],
+      "properties": {
+        "field": {
+          "description": "Name of the invalid field.",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Human readable description of why the field is invalid.",
+          "type": "string"
+        }
+      }
+    },
+    "free-addresses-request": {
+      "description": "The networks the host scans for free addresses.",
+      "type": "array",
+      "items": {
+        "type": "string",
+        "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+      }
+    },
+    "free-network-addresses": {
+      "type": "object",
+      "properties": {
+        "free_addresses": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "ipv4"
+          }
+        },
+        "network": {
+          "type": "string",
+          "pattern": "^([0-9]{1,3}\\.){3}[0-9]{1,3}\\/([0-9]|[1-2][0-9]|3[0-2])$"
+        }
+      }
+    },
+    "free-networks-addresses": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/free-network-addresses"
+      }
+    },
+    "hardware-profile": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "architectures": {
+          "description": "CPU architectures allowed for the hosts, any architecture is allowed if empty.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "master": {
+          "description": "Requirements of master hosts.",
+          "$ref": "#/definitions/hardware-requirements"
+        },
+        "name": {
+          "description": "Unique name of the profile.",
+          "type": "string"
+        },
+        "unassigned": {
+          "description": "Requirements of hosts without an assigned role.",
+          "$ref": "#/definitions/hardware-requirements"
+        },
+        "worker": {
+          "description": "Requirements of worker hosts.",
+          "$ref": "#/definitions/hardware-requirements"
+        }
+      }
+    },
+    "hardware-profile-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/hardware-profile"
+      }
+    },
+    "hardware-requirements": {
+      "type": "object",
+      "properties": {
+        "cpu_cores": {
+          "description": "Minimum number of CPU cores.",
+          "type": "integer"
+        },
+        "disk_size_gib": {
+          "description": "Minimum size in GiB of the installation disk.",
+          "type": "integer"
+        },
+        "ram_gib": {
+          "description": "Minimum amount of RAM in GiB.",
+          "type": "integer"
+        }
+      }
+    },
     "host": {
       "type": "object",
       "required": [
@@ -3264,16 +6159,34 @@ func init() {
         "bootstrap": {
           "type": "boolean"
         },
+        "checked_in_at": {
+          "description": "The last time the host's agent communicated with the service.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
         "cluster_id": {
           "description": "The cluster that this host is associated with.",
           "type": "string",
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key;foreignkey:Cluster\""
         },
-        "connectivity": {
-          "$ref": "#/definitions/connectivity-report"
+        "connectivity": {
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/connectivity-report"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "free_addresses": {
+          "description": "The free addresses of the machine networks (free-networks-addresses) found by the last scan of the host, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         },
-        "created_at": {
+        "free_addresses_updated_at": {
+          "description": "The last time the host reported the free addresses of the machine networks.",
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:datetime\""
@@ -3292,6 +6205,24 @@ func init() {
           "format": "uuid",
           "x-go-custom-tag": "gorm:\"primary_key\""
         },
+        "installation_disk": {
+          "description": "The disk the host will be installed on, as set by the user. Identified by its name, by-path, WWN or serial number. Empty if the disk is chosen by the default selection policy.",
+          "type": "string"
+        },
+        "inventory": {
+          "description": "The last inventory (inventory) received from the host, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "installation_disk": {
+          "description": "The disk the host will be installed on, as set by the user. Identified by its name, by-path, WWN or serial number. Empty if the disk is chosen by the default selection policy.",
+          "type": "string"
+        },
+        "inventory": {
+          "description": "The last inventory (inventory) received from the host, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
         "kind": {
           "description": "Indicates the type of this object. Will be 'Host' if this is a complete object or 'HostLink' if it is just a link.",
           "type": "string",
@@ -3300,13 +6231,19 @@ func init() {
           ]
         },
         "role": {
+          "description": "The host role. Hosts with the auto-assign role are given the master or worker role when the cluster installation starts.",
           "type": "string",
           "enum": [
             "undefined",
             "master",
-            "worker"
+            "worker",
+            "auto-assign"
           ]
         },
+        "role_info": {
+          "description": "Explains why the role was chosen, for hosts whose role was automatically assigned.",
+          "type": "string"
+        },
         "status": {
           "type": "string",
           "enum": [
@@ -3327,6 +6264,11 @@ func init() {
           "type": "string",
           "format": "date-time",
           "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "validations_info": {
+          "description": "The results (validation-results) of the last validation of the host hardware, in JSON format.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
         }
       }
     },
@@ -3351,6 +6293,93 @@ func init() {
         "$ref": "#/definitions/host"
       }
     },
+    "image": {
+      "type": "object",
+      "required": [
+        "kind",
+        "id",
+        "href",
+        "cluster_id",
+        "status"
+      ],
+      "properties": {
+        "checksum": {
+          "description": "SHA256 checksum of the image, available once the image is ready if its generation job stored it.",
+          "type": "string"
+        },
+        "cluster_id": {
+          "description": "The cluster that this image is associated with.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "expires_at": {
+          "description": "The time the image will be deleted at, images of clusters that are being installed are kept until the installation ends. Not set for images that never expire.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\"",
+          "x-nullable": true
+        },
+        "href": {
+          "description": "Self link.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Unique identifier of the object.",
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "kind": {
+          "description": "Indicates the type of this object.",
+          "type": "string",
+          "enum": [
+            "Image"
+          ]
+        },
+        "params_digest": {
+          "description": "Digest of the image generation parameters, images generated with identical parameters are reused.",
+          "type": "string"
+        },
+        "proxy_url": {
+          "description": "The proxy the image was generated with, credentials are redacted.",
+          "type": "string"
+        },
+        "size_bytes": {
+          "description": "Size of the image in bytes, available once the image is ready.",
+          "type": "integer"
+        },
+        "ssh_public_key": {
+          "description": "The SSH public key the image was generated with, it is encrypted in the DB and redacted in the responses.",
+          "x-go-custom-tag": "gorm:\"type:text\"",
+          "$ref": "#/definitions/secret"
+        },
+        "status": {
+          "description": "The image generation status. Images are queued until their generation job starts.",
+          "type": "string",
+          "enum": [
+            "queued",
+            "building",
+            "ready",
+            "failed"
+          ]
+        },
+        "status_info": {
+          "description": "The reason of the failure, for images that failed to be generated.",
+          "type": "string"
+        },
+        "updated_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        }
+      }
+    },
     "image-create-params": {
       "type": "object",
       "properties": {
@@ -3364,6 +6393,30 @@ func init() {
         }
       }
     },
+    "image-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/image"
+      }
+    },
+    "installation-disk-params": {
+      "type": "object",
+      "properties": {
+        "disk": {
+          "description": "The name, by-path, WWN or serial number of the disk as reported in the host inventory. An empty value restores the default selection policy.",
+          "type": "string"
+        }
+      }
+    },
+    "installation-disk-params": {
+      "type": "object",
+      "properties": {
+        "disk": {
+          "description": "The name, by-path, WWN or serial number of the disk as reported in the host inventory. An empty value restores the default selection policy.",
+          "type": "string"
+        }
+      }
+    },
     "interface": {
       "type": "object",
       "properties": {
@@ -3572,6 +6625,20 @@ func init() {
         }
       }
     },
+    "secret": {
+      "description": "A secret value, it is encrypted when stored in the DB.",
+      "type": "string",
+      "x-go-type": {
+        "hints": {
+          "kind": "primitive",
+          "noValidation": true
+        },
+        "import": {
+          "package": "github.com/filanov/bm-inventory/pkg/secret"
+        },
+        "type": "String"
+      }
+    },
     "step": {
       "type": "object",
       "properties": {
@@ -3615,7 +6682,8 @@ func init() {
         "hardware-info",
         "connectivity-check",
         "execute",
-        "inventory"
+        "inventory",
+        "free-network-addresses"
       ]
     },
     "steps": {
@@ -3643,8 +6711,265 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "validation-result": {
+      "type": "object",
+      "required": [
+        "id",
+        "status",
+        "category"
+      ],
+      "properties": {
+        "actual": {
+          "description": "The value found during the validation.",
+          "type": "string"
+        },
+        "category": {
+          "type": "string",
+          "enum": [
+            "cpu",
+            "memory",
+            "disk",
+            "network",
+            "role"
+          ]
+        },
+        "expected": {
+          "description": "The value required to pass the validation.",
+          "type": "string"
+        },
+        "id": {
+          "description": "Identifier of the validation.",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "success",
+            "failure",
+            "pending"
+          ]
+        }
+      }
+    },
+    "validation-results": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/validation-result"
+      }
+    },
+    "watch-event": {
+      "type": "object",
+      "required": [
+        "type",
+        "resource_version"
+      ],
+      "properties": {
+        "event": {
+          "$ref": "#/definitions/event"
+        },
+        "resource_version": {
+          "description": "The resource version to resume the watch from after this watch event.",
+          "type": "integer"
+        },
+        "type": {
+          "description": "Change events hold a state transition or a change of the cluster or its hosts, bookmarks only advance the resource version.",
+          "type": "string",
+          "enum": [
+            "change",
+            "bookmark"
+          ]
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "event_types"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "The cluster whose events are notified, not set for subscriptions to the events of all the clusters.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "created_at": {
+          "description": "The time the webhook was subscribed.",
+          "type": "string",
+          "format": "date-time"
+        },
+        "event_types": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook-event-type"
+          }
+        },
+        "id": {
+          "description": "Unique identifier of the webhook subscription.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "url": {
+          "description": "The URL the notifications are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-create-params": {
+      "type": "object",
+      "required": [
+        "url",
+        "secret",
+        "event_types"
+      ],
+      "properties": {
+        "cluster_id": {
+          "description": "Notify only on the events of this cluster, the events of all the clusters are notified when not set.",
+          "type": "string",
+          "format": "uuid"
+        },
+        "event_types": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/webhook-event-type"
+          }
+        },
+        "secret": {
+          "description": "The key of the HMAC-SHA256 signature of the notifications, sent in the X-Webhook-Signature header.",
+          "type": "string",
+          "minLength": 16
+        },
+        "url": {
+          "description": "The http or https URL the notifications are posted to.",
+          "type": "string"
+        }
+      }
+    },
+    "webhook-delivery": {
+      "type": "object",
+      "required": [
+        "id",
+        "webhook_id",
+        "event_id",
+        "status"
+      ],
+      "properties": {
+        "attempts": {
+          "description": "The number of delivery attempts.",
+          "type": "integer"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime\""
+        },
+        "event_id": {
+          "description": "The event that is notified.",
+          "type": "integer"
+        },
+        "event_type": {
+          "$ref": "#/definitions/webhook-event-type"
+        },
+        "id": {
+          "description": "Unique identifier of the delivery, sent in the X-Webhook-Delivery header.",
+          "type": "integer",
+          "x-go-custom-tag": "gorm:\"primary_key\""
+        },
+        "last_attempt_at": {
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
+        },
+        "last_error": {
+          "description": "The reason the last attempt failed.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "next_attempt_at": {
+          "description": "The time the pending delivery is attempted at.",
+          "type": "string",
+          "format": "date-time",
+          "x-go-custom-tag": "gorm:\"type:datetime;default:0\""
+        },
+        "payload": {
+          "description": "The webhook-notification that is posted.",
+          "type": "string",
+          "x-go-custom-tag": "gorm:\"type:text\""
+        },
+        "response_code": {
+          "description": "The HTTP status code of the last attempt, not set when no response was received.",
+          "type": "integer"
+        },
+        "status": {
+          "description": "Pending deliveries are retried with backoff until they are delivered, or fail after the maximal number of attempts.",
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ]
+        },
+        "webhook_id": {
+          "type": "string",
+          "format": "uuid",
+          "x-go-custom-tag": "gorm:\"index\""
+        }
+      }
+    },
+    "webhook-delivery-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook-delivery"
+      }
+    },
+    "webhook-event-type": {
+      "type": "string",
+      "enum": [
+        "host-discovered",
+        "host-insufficient",
+        "cluster-ready",
+        "installation-completed",
+        "installation-failed"
+      ]
+    },
+    "webhook-list": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/webhook"
+      }
+    },
+    "webhook-notification": {
+      "type": "object",
+      "required": [
+        "event_type",
+        "event"
+      ],
+      "properties": {
+        "event": {
+          "$ref": "#/definitions/event"
+        },
+        "event_type": {
+          "$ref": "#/definitions/webhook-event-type"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "userAuth": {
+      "description": "A bearer token of the user, a JWT issued by the configured issuer or a static token.",
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
     }
   },
+  "security": [
+    {
+      "userAuth": []
+    }
+  ],
   "tags": [
     {
       "description": "Assisted bare metal installation",
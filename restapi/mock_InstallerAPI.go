@@ -16,6 +16,22 @@ type MockInstallerAPI struct {
 	mock.Mock
 }
 
+// DeleteClusterImage provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) DeleteClusterImage(ctx context.Context, params installer.DeleteClusterImageParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.DeleteClusterImageParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // DeregisterCluster provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) DeregisterCluster(ctx context.Context, params installer.DeregisterClusterParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -48,6 +64,22 @@ func (_m *MockInstallerAPI) DeregisterHost(ctx context.Context, params installer
 	return r0
 }
 
+// DeregisterWebhook provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) DeregisterWebhook(ctx context.Context, params installer.DeregisterWebhookParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.DeregisterWebhookParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // DisableHost provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) DisableHost(ctx context.Context, params installer.DisableHostParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -144,6 +176,54 @@ func (_m *MockInstallerAPI) GetCluster(ctx context.Context, params installer.Get
 	return r0
 }
 
+// GetClusterImage provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) GetClusterImage(ctx context.Context, params installer.GetClusterImageParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.GetClusterImageParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
+// GetFreeAddresses provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) GetFreeAddresses(ctx context.Context, params installer.GetFreeAddressesParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.GetFreeAddressesParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
+// GetHardwareProfile provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) GetHardwareProfile(ctx context.Context, params installer.GetHardwareProfileParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.GetHardwareProfileParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // GetHost provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) GetHost(ctx context.Context, params installer.GetHostParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -192,6 +272,54 @@ func (_m *MockInstallerAPI) InstallCluster(ctx context.Context, params installer
 	return r0
 }
 
+// ListClusterArtifacts provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) ListClusterArtifacts(ctx context.Context, params installer.ListClusterArtifactsParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.ListClusterArtifactsParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
+// ListClusterEvents provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) ListClusterEvents(ctx context.Context, params installer.ListClusterEventsParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.ListClusterEventsParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
+// ListClusterImages provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) ListClusterImages(ctx context.Context, params installer.ListClusterImagesParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.ListClusterImagesParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // ListClusters provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) ListClusters(ctx context.Context, params installer.ListClustersParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -208,6 +336,22 @@ func (_m *MockInstallerAPI) ListClusters(ctx context.Context, params installer.L
 	return r0
 }
 
+// ListHardwareProfiles provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) ListHardwareProfiles(ctx context.Context, params installer.ListHardwareProfilesParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.ListHardwareProfilesParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // ListHosts provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) ListHosts(ctx context.Context, params installer.ListHostsParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -224,6 +368,38 @@ func (_m *MockInstallerAPI) ListHosts(ctx context.Context, params installer.List
 	return r0
 }
 
+// ListWebhookDeliveries provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) ListWebhookDeliveries(ctx context.Context, params installer.ListWebhookDeliveriesParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.ListWebhookDeliveriesParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
+// ListWebhooks provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) ListWebhooks(ctx context.Context, params installer.ListWebhooksParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.ListWebhooksParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // PostStepReply provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) PostStepReply(ctx context.Context, params installer.PostStepReplyParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -272,6 +448,38 @@ func (_m *MockInstallerAPI) RegisterHost(ctx context.Context, params installer.R
 	return r0
 }
 
+// RegisterWebhook provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) RegisterWebhook(ctx context.Context, params installer.RegisterWebhookParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.RegisterWebhookParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
+// RevokeAgentToken provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) RevokeAgentToken(ctx context.Context, params installer.RevokeAgentTokenParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.RevokeAgentTokenParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // SetDebugStep provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) SetDebugStep(ctx context.Context, params installer.SetDebugStepParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -288,6 +496,22 @@ func (_m *MockInstallerAPI) SetDebugStep(ctx context.Context, params installer.S
 	return r0
 }
 
+// SetHostInstallationDisk provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) SetHostInstallationDisk(ctx context.Context, params installer.SetHostInstallationDiskParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.SetHostInstallationDiskParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
+
 // UpdateCluster provides a mock function with given fields: ctx, params
 func (_m *MockInstallerAPI) UpdateCluster(ctx context.Context, params installer.UpdateClusterParams) middleware.Responder {
 	ret := _m.Called(ctx, params)
@@ -319,3 +543,19 @@ func (_m *MockInstallerAPI) UpdateHostInstallProgress(ctx context.Context, param
 
 	return r0
 }
+
+// WatchCluster provides a mock function with given fields: ctx, params
+func (_m *MockInstallerAPI) WatchCluster(ctx context.Context, params installer.WatchClusterParams) middleware.Responder {
+	ret := _m.Called(ctx, params)
+
+	var r0 middleware.Responder
+	if rf, ok := ret.Get(0).(func(context.Context, installer.WatchClusterParams) middleware.Responder); ok {
+		r0 = rf(ctx, params)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(middleware.Responder)
+		}
+	}
+
+	return r0
+}
@@ -44,63 +44,115 @@ func NewAssistedInstallAPI(spec *loads.Document) *AssistedInstallAPI {
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
-		InstallerDeregisterClusterHandler: installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams) middleware.Responder {
+		InstallerDeleteClusterImageHandler: installer.DeleteClusterImageHandlerFunc(func(params installer.DeleteClusterImageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DeleteClusterImage has not yet been implemented")
+		}),
+		InstallerDeregisterClusterHandler: installer.DeregisterClusterHandlerFunc(func(params installer.DeregisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterCluster has not yet been implemented")
 		}),
-		InstallerDeregisterHostHandler: installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams) middleware.Responder {
+		InstallerDeregisterHostHandler: installer.DeregisterHostHandlerFunc(func(params installer.DeregisterHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DeregisterHost has not yet been implemented")
 		}),
-		InstallerDisableHostHandler: installer.DisableHostHandlerFunc(func(params installer.DisableHostParams) middleware.Responder {
+		InstallerDeregisterWebhookHandler: installer.DeregisterWebhookHandlerFunc(func(params installer.DeregisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.DeregisterWebhook has not yet been implemented")
+		}),
+		InstallerDisableHostHandler: installer.DisableHostHandlerFunc(func(params installer.DisableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DisableHost has not yet been implemented")
 		}),
-		InstallerDownloadClusterFilesHandler: installer.DownloadClusterFilesHandlerFunc(func(params installer.DownloadClusterFilesParams) middleware.Responder {
+		InstallerDownloadClusterFilesHandler: installer.DownloadClusterFilesHandlerFunc(func(params installer.DownloadClusterFilesParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DownloadClusterFiles has not yet been implemented")
 		}),
-		InstallerDownloadClusterISOHandler: installer.DownloadClusterISOHandlerFunc(func(params installer.DownloadClusterISOParams) middleware.Responder {
+		InstallerDownloadClusterISOHandler: installer.DownloadClusterISOHandlerFunc(func(params installer.DownloadClusterISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.DownloadClusterISO has not yet been implemented")
 		}),
-		InstallerEnableHostHandler: installer.EnableHostHandlerFunc(func(params installer.EnableHostParams) middleware.Responder {
+		InstallerEnableHostHandler: installer.EnableHostHandlerFunc(func(params installer.EnableHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.EnableHost has not yet been implemented")
 		}),
-		InstallerGenerateClusterISOHandler: installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams) middleware.Responder {
+		InstallerGenerateClusterISOHandler: installer.GenerateClusterISOHandlerFunc(func(params installer.GenerateClusterISOParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GenerateClusterISO has not yet been implemented")
 		}),
-		InstallerGetClusterHandler: installer.GetClusterHandlerFunc(func(params installer.GetClusterParams) middleware.Responder {
+		InstallerGetClusterHandler: installer.GetClusterHandlerFunc(func(params installer.GetClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetCluster has not yet been implemented")
 		}),
-		InstallerGetHostHandler: installer.GetHostHandlerFunc(func(params installer.GetHostParams) middleware.Responder {
+		InstallerGetClusterImageHandler: installer.GetClusterImageHandlerFunc(func(params installer.GetClusterImageParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetClusterImage has not yet been implemented")
+		}),
+		InstallerGetFreeAddressesHandler: installer.GetFreeAddressesHandlerFunc(func(params installer.GetFreeAddressesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetFreeAddresses has not yet been implemented")
+		}),
+		InstallerGetHardwareProfileHandler: installer.GetHardwareProfileHandlerFunc(func(params installer.GetHardwareProfileParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.GetHardwareProfile has not yet been implemented")
+		}),
+		InstallerGetHostHandler: installer.GetHostHandlerFunc(func(params installer.GetHostParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetHost has not yet been implemented")
 		}),
 		InstallerGetNextStepsHandler: installer.GetNextStepsHandlerFunc(func(params installer.GetNextStepsParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.GetNextSteps has not yet been implemented")
 		}),
-		InstallerInstallClusterHandler: installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams) middleware.Responder {
+		InstallerInstallClusterHandler: installer.InstallClusterHandlerFunc(func(params installer.InstallClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.InstallCluster has not yet been implemented")
 		}),
-		InstallerListClustersHandler: installer.ListClustersHandlerFunc(func(params installer.ListClustersParams) middleware.Responder {
+		InstallerListClusterArtifactsHandler: installer.ListClusterArtifactsHandlerFunc(func(params installer.ListClusterArtifactsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterArtifacts has not yet been implemented")
+		}),
+		InstallerListClusterEventsHandler: installer.ListClusterEventsHandlerFunc(func(params installer.ListClusterEventsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterEvents has not yet been implemented")
+		}),
+		InstallerListClusterImagesHandler: installer.ListClusterImagesHandlerFunc(func(params installer.ListClusterImagesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListClusterImages has not yet been implemented")
+		}),
+		InstallerListClustersHandler: installer.ListClustersHandlerFunc(func(params installer.ListClustersParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListClusters has not yet been implemented")
 		}),
-		InstallerListHostsHandler: installer.ListHostsHandlerFunc(func(params installer.ListHostsParams) middleware.Responder {
+		InstallerListHardwareProfilesHandler: installer.ListHardwareProfilesHandlerFunc(func(params installer.ListHardwareProfilesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListHardwareProfiles has not yet been implemented")
+		}),
+		InstallerListHostsHandler: installer.ListHostsHandlerFunc(func(params installer.ListHostsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.ListHosts has not yet been implemented")
 		}),
+		InstallerListWebhookDeliveriesHandler: installer.ListWebhookDeliveriesHandlerFunc(func(params installer.ListWebhookDeliveriesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListWebhookDeliveries has not yet been implemented")
+		}),
+		InstallerListWebhooksHandler: installer.ListWebhooksHandlerFunc(func(params installer.ListWebhooksParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.ListWebhooks has not yet been implemented")
+		}),
 		InstallerPostStepReplyHandler: installer.PostStepReplyHandlerFunc(func(params installer.PostStepReplyParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.PostStepReply has not yet been implemented")
 		}),
-		InstallerRegisterClusterHandler: installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams) middleware.Responder {
+		InstallerRegisterClusterHandler: installer.RegisterClusterHandlerFunc(func(params installer.RegisterClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterCluster has not yet been implemented")
 		}),
 		InstallerRegisterHostHandler: installer.RegisterHostHandlerFunc(func(params installer.RegisterHostParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.RegisterHost has not yet been implemented")
 		}),
-		InstallerSetDebugStepHandler: installer.SetDebugStepHandlerFunc(func(params installer.SetDebugStepParams) middleware.Responder {
+		InstallerRegisterWebhookHandler: installer.RegisterWebhookHandlerFunc(func(params installer.RegisterWebhookParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.RegisterWebhook has not yet been implemented")
+		}),
+		InstallerRevokeAgentTokenHandler: installer.RevokeAgentTokenHandlerFunc(func(params installer.RevokeAgentTokenParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.RevokeAgentToken has not yet been implemented")
+		}),
+		InstallerSetDebugStepHandler: installer.SetDebugStepHandlerFunc(func(params installer.SetDebugStepParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.SetDebugStep has not yet been implemented")
 		}),
-		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams) middleware.Responder {
+		InstallerSetHostInstallationDiskHandler: installer.SetHostInstallationDiskHandlerFunc(func(params installer.SetHostInstallationDiskParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.SetHostInstallationDisk has not yet been implemented")
+		}),
+		InstallerUpdateClusterHandler: installer.UpdateClusterHandlerFunc(func(params installer.UpdateClusterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateCluster has not yet been implemented")
 		}),
 		InstallerUpdateHostInstallProgressHandler: installer.UpdateHostInstallProgressHandlerFunc(func(params installer.UpdateHostInstallProgressParams) middleware.Responder {
 			return middleware.NotImplemented("operation installer.UpdateHostInstallProgress has not yet been implemented")
 		}),
+		InstallerWatchClusterHandler: installer.WatchClusterHandlerFunc(func(params installer.WatchClusterParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation installer.WatchCluster has not yet been implemented")
+		}),
+
+		// Applies when the "Authorization" header is set
+		UserAuthAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (userAuth) Authorization from header param [Authorization] has not yet been implemented")
+		},
+		// default authorizer is authorized meaning no requests are blocked
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
@@ -137,10 +189,21 @@ type AssistedInstallAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// UserAuthAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Authorization provided in the header
+	UserAuthAuth func(string) (interface{}, error)
+
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// InstallerDeleteClusterImageHandler sets the operation handler for the delete cluster image operation
+	InstallerDeleteClusterImageHandler installer.DeleteClusterImageHandler
 	// InstallerDeregisterClusterHandler sets the operation handler for the deregister cluster operation
 	InstallerDeregisterClusterHandler installer.DeregisterClusterHandler
 	// InstallerDeregisterHostHandler sets the operation handler for the deregister host operation
 	InstallerDeregisterHostHandler installer.DeregisterHostHandler
+	// InstallerDeregisterWebhookHandler sets the operation handler for the deregister webhook operation
+	InstallerDeregisterWebhookHandler installer.DeregisterWebhookHandler
 	// InstallerDisableHostHandler sets the operation handler for the disable host operation
 	InstallerDisableHostHandler installer.DisableHostHandler
 	// InstallerDownloadClusterFilesHandler sets the operation handler for the download cluster files operation
@@ -153,28 +216,54 @@ type AssistedInstallAPI struct {
 	InstallerGenerateClusterISOHandler installer.GenerateClusterISOHandler
 	// InstallerGetClusterHandler sets the operation handler for the get cluster operation
 	InstallerGetClusterHandler installer.GetClusterHandler
+	// InstallerGetClusterImageHandler sets the operation handler for the get cluster image operation
+	InstallerGetClusterImageHandler installer.GetClusterImageHandler
+	// InstallerGetFreeAddressesHandler sets the operation handler for the get free addresses operation
+	InstallerGetFreeAddressesHandler installer.GetFreeAddressesHandler
+	// InstallerGetHardwareProfileHandler sets the operation handler for the get hardware profile operation
+	InstallerGetHardwareProfileHandler installer.GetHardwareProfileHandler
 	// InstallerGetHostHandler sets the operation handler for the get host operation
 	InstallerGetHostHandler installer.GetHostHandler
 	// InstallerGetNextStepsHandler sets the operation handler for the get next steps operation
 	InstallerGetNextStepsHandler installer.GetNextStepsHandler
 	// InstallerInstallClusterHandler sets the operation handler for the install cluster operation
 	InstallerInstallClusterHandler installer.InstallClusterHandler
+	// InstallerListClusterArtifactsHandler sets the operation handler for the list cluster artifacts operation
+	InstallerListClusterArtifactsHandler installer.ListClusterArtifactsHandler
+	// InstallerListClusterEventsHandler sets the operation handler for the list cluster events operation
+	InstallerListClusterEventsHandler installer.ListClusterEventsHandler
+	// InstallerListClusterImagesHandler sets the operation handler for the list cluster images operation
+	InstallerListClusterImagesHandler installer.ListClusterImagesHandler
 	// InstallerListClustersHandler sets the operation handler for the list clusters operation
 	InstallerListClustersHandler installer.ListClustersHandler
+	// InstallerListHardwareProfilesHandler sets the operation handler for the list hardware profiles operation
+	InstallerListHardwareProfilesHandler installer.ListHardwareProfilesHandler
 	// InstallerListHostsHandler sets the operation handler for the list hosts operation
 	InstallerListHostsHandler installer.ListHostsHandler
+	// InstallerListWebhookDeliveriesHandler sets the operation handler for the list webhook deliveries operation
+	InstallerListWebhookDeliveriesHandler installer.ListWebhookDeliveriesHandler
+	// InstallerListWebhooksHandler sets the operation handler for the list webhooks operation
+	InstallerListWebhooksHandler installer.ListWebhooksHandler
 	// InstallerPostStepReplyHandler sets the operation handler for the post step reply operation
 	InstallerPostStepReplyHandler installer.PostStepReplyHandler
 	// InstallerRegisterClusterHandler sets the operation handler for the register cluster operation
 	InstallerRegisterClusterHandler installer.RegisterClusterHandler
 	// InstallerRegisterHostHandler sets the operation handler for the register host operation
 	InstallerRegisterHostHandler installer.RegisterHostHandler
+	// InstallerRegisterWebhookHandler sets the operation handler for the register webhook operation
+	InstallerRegisterWebhookHandler installer.RegisterWebhookHandler
+	// InstallerRevokeAgentTokenHandler sets the operation handler for the revoke agent token operation
+	InstallerRevokeAgentTokenHandler installer.RevokeAgentTokenHandler
 	// InstallerSetDebugStepHandler sets the operation handler for the set debug step operation
 	InstallerSetDebugStepHandler installer.SetDebugStepHandler
+	// InstallerSetHostInstallationDiskHandler sets the operation handler for the set host installation disk operation
+	InstallerSetHostInstallationDiskHandler installer.SetHostInstallationDiskHandler
 	// InstallerUpdateClusterHandler sets the operation handler for the update cluster operation
 	InstallerUpdateClusterHandler installer.UpdateClusterHandler
 	// InstallerUpdateHostInstallProgressHandler sets the operation handler for the update host install progress operation
 	InstallerUpdateHostInstallProgressHandler installer.UpdateHostInstallProgressHandler
+	// InstallerWatchClusterHandler sets the operation handler for the watch cluster operation
+	InstallerWatchClusterHandler installer.WatchClusterHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -244,12 +333,22 @@ func (o *AssistedInstallAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.UserAuthAuth == nil {
+		unregistered = append(unregistered, "AuthorizationAuth")
+	}
+
+	if o.InstallerDeleteClusterImageHandler == nil {
+		unregistered = append(unregistered, "installer.DeleteClusterImageHandler")
+	}
 	if o.InstallerDeregisterClusterHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterClusterHandler")
 	}
 	if o.InstallerDeregisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.DeregisterHostHandler")
 	}
+	if o.InstallerDeregisterWebhookHandler == nil {
+		unregistered = append(unregistered, "installer.DeregisterWebhookHandler")
+	}
 	if o.InstallerDisableHostHandler == nil {
 		unregistered = append(unregistered, "installer.DisableHostHandler")
 	}
@@ -268,6 +367,15 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerGetClusterHandler == nil {
 		unregistered = append(unregistered, "installer.GetClusterHandler")
 	}
+	if o.InstallerGetClusterImageHandler == nil {
+		unregistered = append(unregistered, "installer.GetClusterImageHandler")
+	}
+	if o.InstallerGetFreeAddressesHandler == nil {
+		unregistered = append(unregistered, "installer.GetFreeAddressesHandler")
+	}
+	if o.InstallerGetHardwareProfileHandler == nil {
+		unregistered = append(unregistered, "installer.GetHardwareProfileHandler")
+	}
 	if o.InstallerGetHostHandler == nil {
 		unregistered = append(unregistered, "installer.GetHostHandler")
 	}
@@ -277,12 +385,30 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerInstallClusterHandler == nil {
 		unregistered = append(unregistered, "installer.InstallClusterHandler")
 	}
+	if o.InstallerListClusterArtifactsHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterArtifactsHandler")
+	}
+	if o.InstallerListClusterEventsHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterEventsHandler")
+	}
+	if o.InstallerListClusterImagesHandler == nil {
+		unregistered = append(unregistered, "installer.ListClusterImagesHandler")
+	}
 	if o.InstallerListClustersHandler == nil {
 		unregistered = append(unregistered, "installer.ListClustersHandler")
 	}
+	if o.InstallerListHardwareProfilesHandler == nil {
+		unregistered = append(unregistered, "installer.ListHardwareProfilesHandler")
+	}
 	if o.InstallerListHostsHandler == nil {
 		unregistered = append(unregistered, "installer.ListHostsHandler")
 	}
+	if o.InstallerListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "installer.ListWebhookDeliveriesHandler")
+	}
+	if o.InstallerListWebhooksHandler == nil {
+		unregistered = append(unregistered, "installer.ListWebhooksHandler")
+	}
 	if o.InstallerPostStepReplyHandler == nil {
 		unregistered = append(unregistered, "installer.PostStepReplyHandler")
 	}
@@ -292,15 +418,27 @@ func (o *AssistedInstallAPI) Validate() error {
 	if o.InstallerRegisterHostHandler == nil {
 		unregistered = append(unregistered, "installer.RegisterHostHandler")
 	}
+	if o.InstallerRegisterWebhookHandler == nil {
+		unregistered = append(unregistered, "installer.RegisterWebhookHandler")
+	}
+	if o.InstallerRevokeAgentTokenHandler == nil {
+		unregistered = append(unregistered, "installer.RevokeAgentTokenHandler")
+	}
 	if o.InstallerSetDebugStepHandler == nil {
 		unregistered = append(unregistered, "installer.SetDebugStepHandler")
 	}
+	if o.InstallerSetHostInstallationDiskHandler == nil {
+		unregistered = append(unregistered, "installer.SetHostInstallationDiskHandler")
+	}
 	if o.InstallerUpdateClusterHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateClusterHandler")
 	}
 	if o.InstallerUpdateHostInstallProgressHandler == nil {
 		unregistered = append(unregistered, "installer.UpdateHostInstallProgressHandler")
 	}
+	if o.InstallerWatchClusterHandler == nil {
+		unregistered = append(unregistered, "installer.WatchClusterHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -316,12 +454,21 @@ func (o *AssistedInstallAPI) ServeErrorFor(operationID string) func(http.Respons
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *AssistedInstallAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "userAuth":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.UserAuthAuth)
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *AssistedInstallAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -391,6 +538,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/images/{image_id}"] = installer.NewDeleteClusterImage(o.context, o.InstallerDeleteClusterImageHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -402,6 +553,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/webhooks/{webhook_id}"] = installer.NewDeregisterWebhook(o.context, o.InstallerDeregisterWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/clusters/{cluster_id}/hosts/{host_id}/actions/enable"] = installer.NewDisableHost(o.context, o.InstallerDisableHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -426,6 +581,18 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/images/{image_id}"] = installer.NewGetClusterImage(o.context, o.InstallerGetClusterImageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/free_addresses"] = installer.NewGetFreeAddresses(o.context, o.InstallerGetFreeAddressesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/hardware_profiles/{profile_name}"] = installer.NewGetHardwareProfile(o.context, o.InstallerGetHardwareProfileHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts/{host_id}"] = installer.NewGetHost(o.context, o.InstallerGetHostHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -438,11 +605,35 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/clusters/{cluster_id}/artifacts"] = installer.NewListClusterArtifacts(o.context, o.InstallerListClusterArtifactsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/events"] = installer.NewListClusterEvents(o.context, o.InstallerListClusterEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/images"] = installer.NewListClusterImages(o.context, o.InstallerListClusterImagesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters"] = installer.NewListClusters(o.context, o.InstallerListClustersHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/hardware_profiles"] = installer.NewListHardwareProfiles(o.context, o.InstallerListHardwareProfilesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/clusters/{cluster_id}/hosts"] = installer.NewListHosts(o.context, o.InstallerListHostsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{webhook_id}/deliveries"] = installer.NewListWebhookDeliveries(o.context, o.InstallerListWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = installer.NewListWebhooks(o.context, o.InstallerListWebhooksHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -458,7 +649,19 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/webhooks"] = installer.NewRegisterWebhook(o.context, o.InstallerRegisterWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/clusters/{cluster_id}/agent-token"] = installer.NewRevokeAgentToken(o.context, o.InstallerRevokeAgentTokenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/clusters/{cluster_id}/hosts/{host_id}/actions/debug"] = installer.NewSetDebugStep(o.context, o.InstallerSetDebugStepHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/clusters/{cluster_id}/hosts/{host_id}/installation_disk"] = installer.NewSetHostInstallationDisk(o.context, o.InstallerSetHostInstallationDiskHandler)
 	if o.handlers["PATCH"] == nil {
 		o.handlers["PATCH"] = make(map[string]http.Handler)
 	}
@@ -467,6 +670,10 @@ func (o *AssistedInstallAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/clusters/{clusterId}/hosts/{hostId}/progress"] = installer.NewUpdateHostInstallProgress(o.context, o.InstallerUpdateHostInstallProgressHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/clusters/{cluster_id}/watch"] = installer.NewWatchCluster(o.context, o.InstallerWatchClusterHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -37,6 +37,50 @@ func (o *DeregisterClusterNoContent) WriteResponse(rw http.ResponseWriter, produ
 	rw.WriteHeader(204)
 }
 
+// DeregisterClusterForbiddenCode is the HTTP code returned for type DeregisterClusterForbidden
+const DeregisterClusterForbiddenCode int = 403
+
+/*DeregisterClusterForbidden The user is not permitted to perform the operation.
+
+swagger:response deregisterClusterForbidden
+*/
+type DeregisterClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeregisterClusterForbidden creates DeregisterClusterForbidden with default headers values
+func NewDeregisterClusterForbidden() *DeregisterClusterForbidden {
+
+	return &DeregisterClusterForbidden{}
+}
+
+// WithPayload adds the payload to the deregister cluster forbidden response
+func (o *DeregisterClusterForbidden) WithPayload(payload *models.Error) *DeregisterClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deregister cluster forbidden response
+func (o *DeregisterClusterForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeregisterClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DeregisterClusterNotFoundCode is the HTTP code returned for type DeregisterClusterNotFound
 const DeregisterClusterNotFoundCode int = 404
 
@@ -81,6 +81,50 @@ func (o *DeregisterHostBadRequest) WriteResponse(rw http.ResponseWriter, produce
 	}
 }
 
+// DeregisterHostForbiddenCode is the HTTP code returned for type DeregisterHostForbidden
+const DeregisterHostForbiddenCode int = 403
+
+/*DeregisterHostForbidden The user is not permitted to perform the operation.
+
+swagger:response deregisterHostForbidden
+*/
+type DeregisterHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeregisterHostForbidden creates DeregisterHostForbidden with default headers values
+func NewDeregisterHostForbidden() *DeregisterHostForbidden {
+
+	return &DeregisterHostForbidden{}
+}
+
+// WithPayload adds the payload to the deregister host forbidden response
+func (o *DeregisterHostForbidden) WithPayload(payload *models.Error) *DeregisterHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deregister host forbidden response
+func (o *DeregisterHostForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeregisterHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DeregisterHostNotFoundCode is the HTTP code returned for type DeregisterHostNotFound
 const DeregisterHostNotFoundCode int = 404
 
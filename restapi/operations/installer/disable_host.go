@@ -12,16 +12,16 @@ import (
 )
 
 // DisableHostHandlerFunc turns a function with the right signature into a disable host handler
-type DisableHostHandlerFunc func(DisableHostParams) middleware.Responder
+type DisableHostHandlerFunc func(DisableHostParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn DisableHostHandlerFunc) Handle(params DisableHostParams) middleware.Responder {
-	return fn(params)
+func (fn DisableHostHandlerFunc) Handle(params DisableHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // DisableHostHandler interface for that can handle valid disable host params
 type DisableHostHandler interface {
-	Handle(DisableHostParams) middleware.Responder
+	Handle(DisableHostParams, interface{}) middleware.Responder
 }
 
 // NewDisableHost creates a new http.Handler for the disable host operation
@@ -46,12 +46,25 @@ func (o *DisableHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewDisableHostParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -37,6 +37,50 @@ func (o *DisableHostNoContent) WriteResponse(rw http.ResponseWriter, producer ru
 	rw.WriteHeader(204)
 }
 
+// DisableHostForbiddenCode is the HTTP code returned for type DisableHostForbidden
+const DisableHostForbiddenCode int = 403
+
+/*DisableHostForbidden The user is not permitted to perform the operation.
+
+swagger:response disableHostForbidden
+*/
+type DisableHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDisableHostForbidden creates DisableHostForbidden with default headers values
+func NewDisableHostForbidden() *DisableHostForbidden {
+
+	return &DisableHostForbidden{}
+}
+
+// WithPayload adds the payload to the disable host forbidden response
+func (o *DisableHostForbidden) WithPayload(payload *models.Error) *DisableHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the disable host forbidden response
+func (o *DisableHostForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DisableHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DisableHostNotFoundCode is the HTTP code returned for type DisableHostNotFound
 const DisableHostNotFoundCode int = 404
 
@@ -12,16 +12,16 @@ import (
 )
 
 // DownloadClusterFilesHandlerFunc turns a function with the right signature into a download cluster files handler
-type DownloadClusterFilesHandlerFunc func(DownloadClusterFilesParams) middleware.Responder
+type DownloadClusterFilesHandlerFunc func(DownloadClusterFilesParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn DownloadClusterFilesHandlerFunc) Handle(params DownloadClusterFilesParams) middleware.Responder {
-	return fn(params)
+func (fn DownloadClusterFilesHandlerFunc) Handle(params DownloadClusterFilesParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // DownloadClusterFilesHandler interface for that can handle valid download cluster files params
 type DownloadClusterFilesHandler interface {
-	Handle(DownloadClusterFilesParams) middleware.Responder
+	Handle(DownloadClusterFilesParams, interface{}) middleware.Responder
 }
 
 // NewDownloadClusterFiles creates a new http.Handler for the download cluster files operation
@@ -33,6 +33,7 @@ func NewDownloadClusterFiles(ctx *middleware.Context, handler DownloadClusterFil
 
 Downloads files relating to the installed/installing cluster.
 
+Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.
 */
 type DownloadClusterFiles struct {
 	Context *middleware.Context
@@ -46,12 +47,25 @@ func (o *DownloadClusterFiles) ServeHTTP(rw http.ResponseWriter, r *http.Request
 	}
 	var Params = NewDownloadClusterFilesParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -56,6 +56,50 @@ func (o *DownloadClusterFilesOK) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// DownloadClusterFilesForbiddenCode is the HTTP code returned for type DownloadClusterFilesForbidden
+const DownloadClusterFilesForbiddenCode int = 403
+
+/*DownloadClusterFilesForbidden The user is not permitted to perform the operation, or the artifact is sensitive and the user lacks the sensitive permission.
+
+swagger:response downloadClusterFilesForbidden
+*/
+type DownloadClusterFilesForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadClusterFilesForbidden creates DownloadClusterFilesForbidden with default headers values
+func NewDownloadClusterFilesForbidden() *DownloadClusterFilesForbidden {
+
+	return &DownloadClusterFilesForbidden{}
+}
+
+// WithPayload adds the payload to the download cluster files forbidden response
+func (o *DownloadClusterFilesForbidden) WithPayload(payload *models.Error) *DownloadClusterFilesForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster files forbidden response
+func (o *DownloadClusterFilesForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterFilesForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadClusterFilesNotFoundCode is the HTTP code returned for type DownloadClusterFilesNotFound
 const DownloadClusterFilesNotFoundCode int = 404
 
@@ -12,16 +12,16 @@ import (
 )
 
 // DownloadClusterISOHandlerFunc turns a function with the right signature into a download cluster i s o handler
-type DownloadClusterISOHandlerFunc func(DownloadClusterISOParams) middleware.Responder
+type DownloadClusterISOHandlerFunc func(DownloadClusterISOParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn DownloadClusterISOHandlerFunc) Handle(params DownloadClusterISOParams) middleware.Responder {
-	return fn(params)
+func (fn DownloadClusterISOHandlerFunc) Handle(params DownloadClusterISOParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // DownloadClusterISOHandler interface for that can handle valid download cluster i s o params
 type DownloadClusterISOHandler interface {
-	Handle(DownloadClusterISOParams) middleware.Responder
+	Handle(DownloadClusterISOParams, interface{}) middleware.Responder
 }
 
 // NewDownloadClusterISO creates a new http.Handler for the download cluster i s o operation
@@ -33,6 +33,7 @@ func NewDownloadClusterISO(ctx *middleware.Context, handler DownloadClusterISOHa
 
 Downloads the OpenShift per-cluster discovery ISO.
 
+Supports HEAD requests, byte range requests and conditional requests by ETag. Redirects to a presigned URL of the object store when presigned downloads are enabled.
 */
 type DownloadClusterISO struct {
 	Context *middleware.Context
@@ -46,12 +47,25 @@ func (o *DownloadClusterISO) ServeHTTP(rw http.ResponseWriter, r *http.Request)
 	}
 	var Params = NewDownloadClusterISOParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
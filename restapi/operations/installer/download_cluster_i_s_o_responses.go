@@ -100,6 +100,50 @@ func (o *DownloadClusterISOBadRequest) WriteResponse(rw http.ResponseWriter, pro
 	}
 }
 
+// DownloadClusterISOForbiddenCode is the HTTP code returned for type DownloadClusterISOForbidden
+const DownloadClusterISOForbiddenCode int = 403
+
+/*DownloadClusterISOForbidden The user is not permitted to perform the operation.
+
+swagger:response downloadClusterISOForbidden
+*/
+type DownloadClusterISOForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadClusterISOForbidden creates DownloadClusterISOForbidden with default headers values
+func NewDownloadClusterISOForbidden() *DownloadClusterISOForbidden {
+
+	return &DownloadClusterISOForbidden{}
+}
+
+// WithPayload adds the payload to the download cluster i s o forbidden response
+func (o *DownloadClusterISOForbidden) WithPayload(payload *models.Error) *DownloadClusterISOForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster i s o forbidden response
+func (o *DownloadClusterISOForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterISOForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadClusterISONotFoundCode is the HTTP code returned for type DownloadClusterISONotFound
 const DownloadClusterISONotFoundCode int = 404
 
@@ -144,6 +188,50 @@ func (o *DownloadClusterISONotFound) WriteResponse(rw http.ResponseWriter, produ
 	}
 }
 
+// DownloadClusterISOConflictCode is the HTTP code returned for type DownloadClusterISOConflict
+const DownloadClusterISOConflictCode int = 409
+
+/*DownloadClusterISOConflict The image is not ready.
+
+swagger:response downloadClusterISOConflict
+*/
+type DownloadClusterISOConflict struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDownloadClusterISOConflict creates DownloadClusterISOConflict with default headers values
+func NewDownloadClusterISOConflict() *DownloadClusterISOConflict {
+
+	return &DownloadClusterISOConflict{}
+}
+
+// WithPayload adds the payload to the download cluster i s o conflict response
+func (o *DownloadClusterISOConflict) WithPayload(payload *models.Error) *DownloadClusterISOConflict {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the download cluster i s o conflict response
+func (o *DownloadClusterISOConflict) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DownloadClusterISOConflict) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(409)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // DownloadClusterISOInternalServerErrorCode is the HTTP code returned for type DownloadClusterISOInternalServerError
 const DownloadClusterISOInternalServerErrorCode int = 500
 
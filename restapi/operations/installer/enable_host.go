@@ -12,16 +12,16 @@ import (
 )
 
 // EnableHostHandlerFunc turns a function with the right signature into a enable host handler
-type EnableHostHandlerFunc func(EnableHostParams) middleware.Responder
+type EnableHostHandlerFunc func(EnableHostParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn EnableHostHandlerFunc) Handle(params EnableHostParams) middleware.Responder {
-	return fn(params)
+func (fn EnableHostHandlerFunc) Handle(params EnableHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // EnableHostHandler interface for that can handle valid enable host params
 type EnableHostHandler interface {
-	Handle(EnableHostParams) middleware.Responder
+	Handle(EnableHostParams, interface{}) middleware.Responder
 }
 
 // NewEnableHost creates a new http.Handler for the enable host operation
@@ -46,12 +46,25 @@ func (o *EnableHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewEnableHostParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -37,6 +37,50 @@ func (o *EnableHostNoContent) WriteResponse(rw http.ResponseWriter, producer run
 	rw.WriteHeader(204)
 }
 
+// EnableHostForbiddenCode is the HTTP code returned for type EnableHostForbidden
+const EnableHostForbiddenCode int = 403
+
+/*EnableHostForbidden The user is not permitted to perform the operation.
+
+swagger:response enableHostForbidden
+*/
+type EnableHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewEnableHostForbidden creates EnableHostForbidden with default headers values
+func NewEnableHostForbidden() *EnableHostForbidden {
+
+	return &EnableHostForbidden{}
+}
+
+// WithPayload adds the payload to the enable host forbidden response
+func (o *EnableHostForbidden) WithPayload(payload *models.Error) *EnableHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the enable host forbidden response
+func (o *EnableHostForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *EnableHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // EnableHostNotFoundCode is the HTTP code returned for type EnableHostNotFound
 const EnableHostNotFoundCode int = 404
 
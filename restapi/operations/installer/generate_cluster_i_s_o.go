@@ -8,24 +8,20 @@ package installer
 import (
 	"net/http"
 
-	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/go-openapi/strfmt"
-	"github.com/go-openapi/swag"
-	"github.com/go-openapi/validate"
 )
 
 // GenerateClusterISOHandlerFunc turns a function with the right signature into a generate cluster i s o handler
-type GenerateClusterISOHandlerFunc func(GenerateClusterISOParams) middleware.Responder
+type GenerateClusterISOHandlerFunc func(GenerateClusterISOParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn GenerateClusterISOHandlerFunc) Handle(params GenerateClusterISOParams) middleware.Responder {
-	return fn(params)
+func (fn GenerateClusterISOHandlerFunc) Handle(params GenerateClusterISOParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // GenerateClusterISOHandler interface for that can handle valid generate cluster i s o params
 type GenerateClusterISOHandler interface {
-	Handle(GenerateClusterISOParams) middleware.Responder
+	Handle(GenerateClusterISOParams, interface{}) middleware.Responder
 }
 
 // NewGenerateClusterISO creates a new http.Handler for the generate cluster i s o operation
@@ -50,68 +46,26 @@ func (o *GenerateClusterISO) ServeHTTP(rw http.ResponseWriter, r *http.Request)
 	}
 	var Params = NewGenerateClusterISOParams()
 
-	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
-
-	res := o.Handler.Handle(Params) // actually handle the request
-
-	o.Context.Respond(rw, r, route.Produces, route, res)
-
-}
-
-// GenerateClusterISOCreatedBody generate cluster i s o created body
-//
-// swagger:model GenerateClusterISOCreatedBody
-type GenerateClusterISOCreatedBody struct {
-
-	// image Id
-	// Format: uuid
-	ImageID strfmt.UUID `json:"imageId,omitempty"`
-}
-
-// Validate validates this generate cluster i s o created body
-func (o *GenerateClusterISOCreatedBody) Validate(formats strfmt.Registry) error {
-	var res []error
-
-	if err := o.validateImageID(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
+	if aCtx != nil {
+		r = aCtx
 	}
-	return nil
-}
-
-func (o *GenerateClusterISOCreatedBody) validateImageID(formats strfmt.Registry) error {
-
-	if swag.IsZero(o.ImageID) { // not required
-		return nil
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
 	}
 
-	if err := validate.FormatOf("generateClusterISOCreated"+"."+"imageId", "body", "uuid", o.ImageID.String(), formats); err != nil {
-		return err
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
 	}
 
-	return nil
-}
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
-// MarshalBinary interface implementation
-func (o *GenerateClusterISOCreatedBody) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(o)
-}
+	o.Context.Respond(rw, r, route.Produces, route, res)
 
-// UnmarshalBinary interface implementation
-func (o *GenerateClusterISOCreatedBody) UnmarshalBinary(b []byte) error {
-	var res GenerateClusterISOCreatedBody
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*o = res
-	return nil
 }
@@ -16,7 +16,7 @@ import (
 // GenerateClusterISOCreatedCode is the HTTP code returned for type GenerateClusterISOCreated
 const GenerateClusterISOCreatedCode int = 201
 
-/*GenerateClusterISOCreated Success.
+/*GenerateClusterISOCreated Success. The image is generated in the background, a previous image generated with identical parameters is returned instead of generating a new one.
 
 swagger:response generateClusterISOCreated
 */
@@ -25,7 +25,7 @@ type GenerateClusterISOCreated struct {
 	/*
 	  In: Body
 	*/
-	Payload *GenerateClusterISOCreatedBody `json:"body,omitempty"`
+	Payload *models.Image `json:"body,omitempty"`
 }
 
 // NewGenerateClusterISOCreated creates GenerateClusterISOCreated with default headers values
@@ -35,13 +35,13 @@ func NewGenerateClusterISOCreated() *GenerateClusterISOCreated {
 }
 
 // WithPayload adds the payload to the generate cluster i s o created response
-func (o *GenerateClusterISOCreated) WithPayload(payload *GenerateClusterISOCreatedBody) *GenerateClusterISOCreated {
+func (o *GenerateClusterISOCreated) WithPayload(payload *models.Image) *GenerateClusterISOCreated {
 	o.Payload = payload
 	return o
 }
 
 // SetPayload sets the payload to the generate cluster i s o created response
-func (o *GenerateClusterISOCreated) SetPayload(payload *GenerateClusterISOCreatedBody) {
+func (o *GenerateClusterISOCreated) SetPayload(payload *models.Image) {
 	o.Payload = payload
 }
 
@@ -101,6 +101,50 @@ func (o *GenerateClusterISOBadRequest) WriteResponse(rw http.ResponseWriter, pro
 	}
 }
 
+// GenerateClusterISOForbiddenCode is the HTTP code returned for type GenerateClusterISOForbidden
+const GenerateClusterISOForbiddenCode int = 403
+
+/*GenerateClusterISOForbidden The user is not permitted to perform the operation.
+
+swagger:response generateClusterISOForbidden
+*/
+type GenerateClusterISOForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGenerateClusterISOForbidden creates GenerateClusterISOForbidden with default headers values
+func NewGenerateClusterISOForbidden() *GenerateClusterISOForbidden {
+
+	return &GenerateClusterISOForbidden{}
+}
+
+// WithPayload adds the payload to the generate cluster i s o forbidden response
+func (o *GenerateClusterISOForbidden) WithPayload(payload *models.Error) *GenerateClusterISOForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the generate cluster i s o forbidden response
+func (o *GenerateClusterISOForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GenerateClusterISOForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GenerateClusterISONotFoundCode is the HTTP code returned for type GenerateClusterISONotFound
 const GenerateClusterISONotFoundCode int = 404
 
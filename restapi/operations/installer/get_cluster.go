@@ -12,16 +12,16 @@ import (
 )
 
 // GetClusterHandlerFunc turns a function with the right signature into a get cluster handler
-type GetClusterHandlerFunc func(GetClusterParams) middleware.Responder
+type GetClusterHandlerFunc func(GetClusterParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn GetClusterHandlerFunc) Handle(params GetClusterParams) middleware.Responder {
-	return fn(params)
+func (fn GetClusterHandlerFunc) Handle(params GetClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // GetClusterHandler interface for that can handle valid get cluster params
 type GetClusterHandler interface {
-	Handle(GetClusterParams) middleware.Responder
+	Handle(GetClusterParams, interface{}) middleware.Responder
 }
 
 // NewGetCluster creates a new http.Handler for the get cluster operation
@@ -46,12 +46,25 @@ func (o *GetCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewGetClusterParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -9,16 +9,26 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/go-openapi/validate"
 )
 
 // NewGetClusterParams creates a new GetClusterParams object
-// no default values defined in spec.
+// with the default values initialized.
 func NewGetClusterParams() GetClusterParams {
 
-	return GetClusterParams{}
+	var (
+		// initialize parameters with default values
+
+		withSecretsDefault = bool(false)
+	)
+
+	return GetClusterParams{
+		WithSecrets: &withSecretsDefault,
+	}
 }
 
 // GetClusterParams contains all the bound params for the get cluster operation
@@ -35,6 +45,11 @@ type GetClusterParams struct {
 	  In: path
 	*/
 	ClusterID strfmt.UUID
+	/*Return the pull secret and the SSH public key of the cluster, which are redacted otherwise. Requires the write permission.
+	  In: query
+	  Default: false
+	*/
+	WithSecrets *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -46,11 +61,18 @@ func (o *GetClusterParams) BindRequest(r *http.Request, route *middleware.Matche
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qWithSecrets, qhkWithSecrets, _ := qs.GetOK("with_secrets")
+	if err := o.bindWithSecrets(qWithSecrets, qhkWithSecrets, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -89,3 +111,26 @@ func (o *GetClusterParams) validateClusterID(formats strfmt.Registry) error {
 	}
 	return nil
 }
+
+// bindWithSecrets binds and validates parameter WithSecrets from query.
+func (o *GetClusterParams) bindWithSecrets(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetClusterParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("with_secrets", "query", "bool", raw)
+	}
+	o.WithSecrets = &value
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/filanov/bm-inventory/models"
 )
@@ -21,6 +22,10 @@ const GetClusterOKCode int = 200
 swagger:response getClusterOK
 */
 type GetClusterOK struct {
+	/*The resource version the returned state is up to date with, watches resumed from it stream the changes made after it.
+
+	 */
+	XResourceVersion int64 `json:"X-Resource-Version"`
 
 	/*
 	  In: Body
@@ -34,6 +39,17 @@ func NewGetClusterOK() *GetClusterOK {
 	return &GetClusterOK{}
 }
 
+// WithXResourceVersion adds the xResourceVersion to the get cluster o k response
+func (o *GetClusterOK) WithXResourceVersion(xResourceVersion int64) *GetClusterOK {
+	o.XResourceVersion = xResourceVersion
+	return o
+}
+
+// SetXResourceVersion sets the xResourceVersion to the get cluster o k response
+func (o *GetClusterOK) SetXResourceVersion(xResourceVersion int64) {
+	o.XResourceVersion = xResourceVersion
+}
+
 // WithPayload adds the payload to the get cluster o k response
 func (o *GetClusterOK) WithPayload(payload *models.Cluster) *GetClusterOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *GetClusterOK) SetPayload(payload *models.Cluster) {
 // WriteResponse to the client
 func (o *GetClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Resource-Version
+
+	xResourceVersion := swag.FormatInt64(o.XResourceVersion)
+	if xResourceVersion != "" {
+		rw.Header().Set("X-Resource-Version", xResourceVersion)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -57,6 +80,50 @@ func (o *GetClusterOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pr
 	}
 }
 
+// GetClusterForbiddenCode is the HTTP code returned for type GetClusterForbidden
+const GetClusterForbiddenCode int = 403
+
+/*GetClusterForbidden The user is not permitted to perform the operation.
+
+swagger:response getClusterForbidden
+*/
+type GetClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetClusterForbidden creates GetClusterForbidden with default headers values
+func NewGetClusterForbidden() *GetClusterForbidden {
+
+	return &GetClusterForbidden{}
+}
+
+// WithPayload adds the payload to the get cluster forbidden response
+func (o *GetClusterForbidden) WithPayload(payload *models.Error) *GetClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get cluster forbidden response
+func (o *GetClusterForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetClusterNotFoundCode is the HTTP code returned for type GetClusterNotFound
 const GetClusterNotFoundCode int = 404
 
@@ -12,12 +12,15 @@ import (
 	"strings"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // GetClusterURL generates an URL for the get cluster operation
 type GetClusterURL struct {
 	ClusterID strfmt.UUID
 
+	WithSecrets *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -57,6 +60,18 @@ func (o *GetClusterURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var withSecretsQ string
+	if o.WithSecrets != nil {
+		withSecretsQ = swag.FormatBool(*o.WithSecrets)
+	}
+	if withSecretsQ != "" {
+		qs.Set("with_secrets", withSecretsQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -12,16 +12,16 @@ import (
 )
 
 // GetHostHandlerFunc turns a function with the right signature into a get host handler
-type GetHostHandlerFunc func(GetHostParams) middleware.Responder
+type GetHostHandlerFunc func(GetHostParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn GetHostHandlerFunc) Handle(params GetHostParams) middleware.Responder {
-	return fn(params)
+func (fn GetHostHandlerFunc) Handle(params GetHostParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // GetHostHandler interface for that can handle valid get host params
 type GetHostHandler interface {
-	Handle(GetHostParams) middleware.Responder
+	Handle(GetHostParams, interface{}) middleware.Responder
 }
 
 // NewGetHost creates a new http.Handler for the get host operation
@@ -46,12 +46,25 @@ func (o *GetHost) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewGetHostParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -57,6 +57,50 @@ func (o *GetHostOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produ
 	}
 }
 
+// GetHostForbiddenCode is the HTTP code returned for type GetHostForbidden
+const GetHostForbiddenCode int = 403
+
+/*GetHostForbidden The user is not permitted to perform the operation.
+
+swagger:response getHostForbidden
+*/
+type GetHostForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetHostForbidden creates GetHostForbidden with default headers values
+func NewGetHostForbidden() *GetHostForbidden {
+
+	return &GetHostForbidden{}
+}
+
+// WithPayload adds the payload to the get host forbidden response
+func (o *GetHostForbidden) WithPayload(payload *models.Error) *GetHostForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get host forbidden response
+func (o *GetHostForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetHostNotFoundCode is the HTTP code returned for type GetHostNotFound
 const GetHostNotFoundCode int = 404
 
@@ -60,6 +60,50 @@ func (o *GetNextStepsOK) WriteResponse(rw http.ResponseWriter, producer runtime.
 	}
 }
 
+// GetNextStepsUnauthorizedCode is the HTTP code returned for type GetNextStepsUnauthorized
+const GetNextStepsUnauthorizedCode int = 401
+
+/*GetNextStepsUnauthorized The agent token is missing, revoked or doesn't belong to the cluster.
+
+swagger:response getNextStepsUnauthorized
+*/
+type GetNextStepsUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetNextStepsUnauthorized creates GetNextStepsUnauthorized with default headers values
+func NewGetNextStepsUnauthorized() *GetNextStepsUnauthorized {
+
+	return &GetNextStepsUnauthorized{}
+}
+
+// WithPayload adds the payload to the get next steps unauthorized response
+func (o *GetNextStepsUnauthorized) WithPayload(payload *models.Error) *GetNextStepsUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get next steps unauthorized response
+func (o *GetNextStepsUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetNextStepsUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetNextStepsNotFoundCode is the HTTP code returned for type GetNextStepsNotFound
 const GetNextStepsNotFoundCode int = 404
 
@@ -12,16 +12,16 @@ import (
 )
 
 // InstallClusterHandlerFunc turns a function with the right signature into a install cluster handler
-type InstallClusterHandlerFunc func(InstallClusterParams) middleware.Responder
+type InstallClusterHandlerFunc func(InstallClusterParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn InstallClusterHandlerFunc) Handle(params InstallClusterParams) middleware.Responder {
-	return fn(params)
+func (fn InstallClusterHandlerFunc) Handle(params InstallClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // InstallClusterHandler interface for that can handle valid install cluster params
 type InstallClusterHandler interface {
-	Handle(InstallClusterParams) middleware.Responder
+	Handle(InstallClusterParams, interface{}) middleware.Responder
 }
 
 // NewInstallCluster creates a new http.Handler for the install cluster operation
@@ -46,12 +46,25 @@ func (o *InstallCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewInstallClusterParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -101,6 +101,50 @@ func (o *InstallClusterBadRequest) WriteResponse(rw http.ResponseWriter, produce
 	}
 }
 
+// InstallClusterForbiddenCode is the HTTP code returned for type InstallClusterForbidden
+const InstallClusterForbiddenCode int = 403
+
+/*InstallClusterForbidden The user is not permitted to perform the operation.
+
+swagger:response installClusterForbidden
+*/
+type InstallClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewInstallClusterForbidden creates InstallClusterForbidden with default headers values
+func NewInstallClusterForbidden() *InstallClusterForbidden {
+
+	return &InstallClusterForbidden{}
+}
+
+// WithPayload adds the payload to the install cluster forbidden response
+func (o *InstallClusterForbidden) WithPayload(payload *models.Error) *InstallClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the install cluster forbidden response
+func (o *InstallClusterForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *InstallClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // InstallClusterNotFoundCode is the HTTP code returned for type InstallClusterNotFound
 const InstallClusterNotFoundCode int = 404
 
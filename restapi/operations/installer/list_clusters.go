@@ -12,16 +12,16 @@ import (
 )
 
 // ListClustersHandlerFunc turns a function with the right signature into a list clusters handler
-type ListClustersHandlerFunc func(ListClustersParams) middleware.Responder
+type ListClustersHandlerFunc func(ListClustersParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn ListClustersHandlerFunc) Handle(params ListClustersParams) middleware.Responder {
-	return fn(params)
+func (fn ListClustersHandlerFunc) Handle(params ListClustersParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // ListClustersHandler interface for that can handle valid list clusters params
 type ListClustersHandler interface {
-	Handle(ListClustersParams) middleware.Responder
+	Handle(ListClustersParams, interface{}) middleware.Responder
 }
 
 // NewListClusters creates a new http.Handler for the list clusters operation
@@ -46,12 +46,25 @@ func (o *ListClusters) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewListClustersParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
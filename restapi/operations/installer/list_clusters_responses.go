@@ -60,6 +60,50 @@ func (o *ListClustersOK) WriteResponse(rw http.ResponseWriter, producer runtime.
 	}
 }
 
+// ListClustersForbiddenCode is the HTTP code returned for type ListClustersForbidden
+const ListClustersForbiddenCode int = 403
+
+/*ListClustersForbidden The user is not permitted to perform the operation.
+
+swagger:response listClustersForbidden
+*/
+type ListClustersForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListClustersForbidden creates ListClustersForbidden with default headers values
+func NewListClustersForbidden() *ListClustersForbidden {
+
+	return &ListClustersForbidden{}
+}
+
+// WithPayload adds the payload to the list clusters forbidden response
+func (o *ListClustersForbidden) WithPayload(payload *models.Error) *ListClustersForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list clusters forbidden response
+func (o *ListClustersForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListClustersForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ListClustersInternalServerErrorCode is the HTTP code returned for type ListClustersInternalServerError
 const ListClustersInternalServerErrorCode int = 500
 
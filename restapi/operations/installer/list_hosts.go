@@ -12,16 +12,16 @@ import (
 )
 
 // ListHostsHandlerFunc turns a function with the right signature into a list hosts handler
-type ListHostsHandlerFunc func(ListHostsParams) middleware.Responder
+type ListHostsHandlerFunc func(ListHostsParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn ListHostsHandlerFunc) Handle(params ListHostsParams) middleware.Responder {
-	return fn(params)
+func (fn ListHostsHandlerFunc) Handle(params ListHostsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // ListHostsHandler interface for that can handle valid list hosts params
 type ListHostsHandler interface {
-	Handle(ListHostsParams) middleware.Responder
+	Handle(ListHostsParams, interface{}) middleware.Responder
 }
 
 // NewListHosts creates a new http.Handler for the list hosts operation
@@ -46,12 +46,25 @@ func (o *ListHosts) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewListHostsParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/filanov/bm-inventory/models"
 )
@@ -21,6 +22,10 @@ const ListHostsOKCode int = 200
 swagger:response listHostsOK
 */
 type ListHostsOK struct {
+	/*The resource version the returned state is up to date with, watches resumed from it stream the changes made after it.
+
+	 */
+	XResourceVersion int64 `json:"X-Resource-Version"`
 
 	/*
 	  In: Body
@@ -34,6 +39,17 @@ func NewListHostsOK() *ListHostsOK {
 	return &ListHostsOK{}
 }
 
+// WithXResourceVersion adds the xResourceVersion to the list hosts o k response
+func (o *ListHostsOK) WithXResourceVersion(xResourceVersion int64) *ListHostsOK {
+	o.XResourceVersion = xResourceVersion
+	return o
+}
+
+// SetXResourceVersion sets the xResourceVersion to the list hosts o k response
+func (o *ListHostsOK) SetXResourceVersion(xResourceVersion int64) {
+	o.XResourceVersion = xResourceVersion
+}
+
 // WithPayload adds the payload to the list hosts o k response
 func (o *ListHostsOK) WithPayload(payload models.HostList) *ListHostsOK {
 	o.Payload = payload
@@ -48,6 +64,13 @@ func (o *ListHostsOK) SetPayload(payload models.HostList) {
 // WriteResponse to the client
 func (o *ListHostsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Resource-Version
+
+	xResourceVersion := swag.FormatInt64(o.XResourceVersion)
+	if xResourceVersion != "" {
+		rw.Header().Set("X-Resource-Version", xResourceVersion)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -60,6 +83,94 @@ func (o *ListHostsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Pro
 	}
 }
 
+// ListHostsForbiddenCode is the HTTP code returned for type ListHostsForbidden
+const ListHostsForbiddenCode int = 403
+
+/*ListHostsForbidden The user is not permitted to perform the operation.
+
+swagger:response listHostsForbidden
+*/
+type ListHostsForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListHostsForbidden creates ListHostsForbidden with default headers values
+func NewListHostsForbidden() *ListHostsForbidden {
+
+	return &ListHostsForbidden{}
+}
+
+// WithPayload adds the payload to the list hosts forbidden response
+func (o *ListHostsForbidden) WithPayload(payload *models.Error) *ListHostsForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list hosts forbidden response
+func (o *ListHostsForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostsForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// ListHostsNotFoundCode is the HTTP code returned for type ListHostsNotFound
+const ListHostsNotFoundCode int = 404
+
+/*ListHostsNotFound Error.
+
+swagger:response listHostsNotFound
+*/
+type ListHostsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListHostsNotFound creates ListHostsNotFound with default headers values
+func NewListHostsNotFound() *ListHostsNotFound {
+
+	return &ListHostsNotFound{}
+}
+
+// WithPayload adds the payload to the list hosts not found response
+func (o *ListHostsNotFound) WithPayload(payload *models.Error) *ListHostsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list hosts not found response
+func (o *ListHostsNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListHostsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // ListHostsInternalServerErrorCode is the HTTP code returned for type ListHostsInternalServerError
 const ListHostsInternalServerErrorCode int = 500
 
@@ -33,6 +33,10 @@ type PostStepReplyParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The agent token of the cluster, it is embedded in the discovery images of the cluster.
+	  In: header
+	*/
+	XAgentToken *string
 	/*
 	  Required: true
 	  In: path
@@ -58,6 +62,10 @@ func (o *PostStepReplyParams) BindRequest(r *http.Request, route *middleware.Mat
 
 	o.HTTPRequest = r
 
+	if err := o.bindXAgentToken(r.Header[http.CanonicalHeaderKey("X-Agent-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -90,6 +98,24 @@ func (o *PostStepReplyParams) BindRequest(r *http.Request, route *middleware.Mat
 	return nil
 }
 
+// bindXAgentToken binds and validates parameter XAgentToken from header.
+func (o *PostStepReplyParams) bindXAgentToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.XAgentToken = &raw
+
+	return nil
+}
+
 // bindClusterID binds and validates parameter ClusterID from path.
 func (o *PostStepReplyParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
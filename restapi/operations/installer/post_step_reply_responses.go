@@ -81,6 +81,50 @@ func (o *PostStepReplyBadRequest) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// PostStepReplyUnauthorizedCode is the HTTP code returned for type PostStepReplyUnauthorized
+const PostStepReplyUnauthorizedCode int = 401
+
+/*PostStepReplyUnauthorized The agent token is missing, revoked or doesn't belong to the cluster.
+
+swagger:response postStepReplyUnauthorized
+*/
+type PostStepReplyUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostStepReplyUnauthorized creates PostStepReplyUnauthorized with default headers values
+func NewPostStepReplyUnauthorized() *PostStepReplyUnauthorized {
+
+	return &PostStepReplyUnauthorized{}
+}
+
+// WithPayload adds the payload to the post step reply unauthorized response
+func (o *PostStepReplyUnauthorized) WithPayload(payload *models.Error) *PostStepReplyUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post step reply unauthorized response
+func (o *PostStepReplyUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostStepReplyUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // PostStepReplyNotFoundCode is the HTTP code returned for type PostStepReplyNotFound
 const PostStepReplyNotFoundCode int = 404
 
@@ -12,16 +12,16 @@ import (
 )
 
 // RegisterClusterHandlerFunc turns a function with the right signature into a register cluster handler
-type RegisterClusterHandlerFunc func(RegisterClusterParams) middleware.Responder
+type RegisterClusterHandlerFunc func(RegisterClusterParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn RegisterClusterHandlerFunc) Handle(params RegisterClusterParams) middleware.Responder {
-	return fn(params)
+func (fn RegisterClusterHandlerFunc) Handle(params RegisterClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // RegisterClusterHandler interface for that can handle valid register cluster params
 type RegisterClusterHandler interface {
-	Handle(RegisterClusterParams) middleware.Responder
+	Handle(RegisterClusterParams, interface{}) middleware.Responder
 }
 
 // NewRegisterCluster creates a new http.Handler for the register cluster operation
@@ -46,12 +46,25 @@ func (o *RegisterCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewRegisterClusterParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
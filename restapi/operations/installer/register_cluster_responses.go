@@ -101,6 +101,50 @@ func (o *RegisterClusterBadRequest) WriteResponse(rw http.ResponseWriter, produc
 	}
 }
 
+// RegisterClusterForbiddenCode is the HTTP code returned for type RegisterClusterForbidden
+const RegisterClusterForbiddenCode int = 403
+
+/*RegisterClusterForbidden The user is not permitted to perform the operation.
+
+swagger:response registerClusterForbidden
+*/
+type RegisterClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRegisterClusterForbidden creates RegisterClusterForbidden with default headers values
+func NewRegisterClusterForbidden() *RegisterClusterForbidden {
+
+	return &RegisterClusterForbidden{}
+}
+
+// WithPayload adds the payload to the register cluster forbidden response
+func (o *RegisterClusterForbidden) WithPayload(payload *models.Error) *RegisterClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register cluster forbidden response
+func (o *RegisterClusterForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // RegisterClusterInternalServerErrorCode is the HTTP code returned for type RegisterClusterInternalServerError
 const RegisterClusterInternalServerErrorCode int = 500
 
@@ -34,6 +34,10 @@ type RegisterHostParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The agent token of the cluster, it is embedded in the discovery images of the cluster.
+	  In: header
+	*/
+	XAgentToken *string
 	/*
 	  Required: true
 	  In: path
@@ -55,6 +59,10 @@ func (o *RegisterHostParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	o.HTTPRequest = r
 
+	if err := o.bindXAgentToken(r.Header[http.CanonicalHeaderKey("X-Agent-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("cluster_id")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -88,6 +96,24 @@ func (o *RegisterHostParams) BindRequest(r *http.Request, route *middleware.Matc
 	return nil
 }
 
+// bindXAgentToken binds and validates parameter XAgentToken from header.
+func (o *RegisterHostParams) bindXAgentToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.XAgentToken = &raw
+
+	return nil
+}
+
 // bindClusterID binds and validates parameter ClusterID from path.
 func (o *RegisterHostParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -101,6 +101,50 @@ func (o *RegisterHostBadRequest) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// RegisterHostUnauthorizedCode is the HTTP code returned for type RegisterHostUnauthorized
+const RegisterHostUnauthorizedCode int = 401
+
+/*RegisterHostUnauthorized The agent token is missing, revoked or doesn't belong to the cluster.
+
+swagger:response registerHostUnauthorized
+*/
+type RegisterHostUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRegisterHostUnauthorized creates RegisterHostUnauthorized with default headers values
+func NewRegisterHostUnauthorized() *RegisterHostUnauthorized {
+
+	return &RegisterHostUnauthorized{}
+}
+
+// WithPayload adds the payload to the register host unauthorized response
+func (o *RegisterHostUnauthorized) WithPayload(payload *models.Error) *RegisterHostUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the register host unauthorized response
+func (o *RegisterHostUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RegisterHostUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // RegisterHostInternalServerErrorCode is the HTTP code returned for type RegisterHostInternalServerError
 const RegisterHostInternalServerErrorCode int = 500
 
@@ -12,16 +12,16 @@ import (
 )
 
 // SetDebugStepHandlerFunc turns a function with the right signature into a set debug step handler
-type SetDebugStepHandlerFunc func(SetDebugStepParams) middleware.Responder
+type SetDebugStepHandlerFunc func(SetDebugStepParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn SetDebugStepHandlerFunc) Handle(params SetDebugStepParams) middleware.Responder {
-	return fn(params)
+func (fn SetDebugStepHandlerFunc) Handle(params SetDebugStepParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // SetDebugStepHandler interface for that can handle valid set debug step params
 type SetDebugStepHandler interface {
-	Handle(SetDebugStepParams) middleware.Responder
+	Handle(SetDebugStepParams, interface{}) middleware.Responder
 }
 
 // NewSetDebugStep creates a new http.Handler for the set debug step operation
@@ -46,12 +46,25 @@ func (o *SetDebugStep) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewSetDebugStepParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
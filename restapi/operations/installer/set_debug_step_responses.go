@@ -37,6 +37,50 @@ func (o *SetDebugStepNoContent) WriteResponse(rw http.ResponseWriter, producer r
 	rw.WriteHeader(204)
 }
 
+// SetDebugStepForbiddenCode is the HTTP code returned for type SetDebugStepForbidden
+const SetDebugStepForbiddenCode int = 403
+
+/*SetDebugStepForbidden The user is not permitted to perform the operation.
+
+swagger:response setDebugStepForbidden
+*/
+type SetDebugStepForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetDebugStepForbidden creates SetDebugStepForbidden with default headers values
+func NewSetDebugStepForbidden() *SetDebugStepForbidden {
+
+	return &SetDebugStepForbidden{}
+}
+
+// WithPayload adds the payload to the set debug step forbidden response
+func (o *SetDebugStepForbidden) WithPayload(payload *models.Error) *SetDebugStepForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set debug step forbidden response
+func (o *SetDebugStepForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetDebugStepForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // SetDebugStepNotFoundCode is the HTTP code returned for type SetDebugStepNotFound
 const SetDebugStepNotFoundCode int = 404
 
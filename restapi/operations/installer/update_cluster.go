@@ -12,16 +12,16 @@ import (
 )
 
 // UpdateClusterHandlerFunc turns a function with the right signature into a update cluster handler
-type UpdateClusterHandlerFunc func(UpdateClusterParams) middleware.Responder
+type UpdateClusterHandlerFunc func(UpdateClusterParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn UpdateClusterHandlerFunc) Handle(params UpdateClusterParams) middleware.Responder {
-	return fn(params)
+func (fn UpdateClusterHandlerFunc) Handle(params UpdateClusterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // UpdateClusterHandler interface for that can handle valid update cluster params
 type UpdateClusterHandler interface {
-	Handle(UpdateClusterParams) middleware.Responder
+	Handle(UpdateClusterParams, interface{}) middleware.Responder
 }
 
 // NewUpdateCluster creates a new http.Handler for the update cluster operation
@@ -46,12 +46,25 @@ func (o *UpdateCluster) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 	}
 	var Params = NewUpdateClusterParams()
 
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
@@ -101,6 +101,50 @@ func (o *UpdateClusterBadRequest) WriteResponse(rw http.ResponseWriter, producer
 	}
 }
 
+// UpdateClusterForbiddenCode is the HTTP code returned for type UpdateClusterForbidden
+const UpdateClusterForbiddenCode int = 403
+
+/*UpdateClusterForbidden The user is not permitted to perform the operation.
+
+swagger:response updateClusterForbidden
+*/
+type UpdateClusterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateClusterForbidden creates UpdateClusterForbidden with default headers values
+func NewUpdateClusterForbidden() *UpdateClusterForbidden {
+
+	return &UpdateClusterForbidden{}
+}
+
+// WithPayload adds the payload to the update cluster forbidden response
+func (o *UpdateClusterForbidden) WithPayload(payload *models.Error) *UpdateClusterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update cluster forbidden response
+func (o *UpdateClusterForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateClusterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // UpdateClusterNotFoundCode is the HTTP code returned for type UpdateClusterNotFound
 const UpdateClusterNotFoundCode int = 404
 
@@ -34,6 +34,10 @@ type UpdateHostInstallProgressParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*The agent token of the cluster, it is embedded in the discovery images of the cluster.
+	  In: header
+	*/
+	XAgentToken *string
 	/*The ID of the cluster to retrieve
 	  Required: true
 	  In: path
@@ -60,6 +64,10 @@ func (o *UpdateHostInstallProgressParams) BindRequest(r *http.Request, route *mi
 
 	o.HTTPRequest = r
 
+	if err := o.bindXAgentToken(r.Header[http.CanonicalHeaderKey("X-Agent-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rClusterID, rhkClusterID, _ := route.Params.GetOK("clusterId")
 	if err := o.bindClusterID(rClusterID, rhkClusterID, route.Formats); err != nil {
 		res = append(res, err)
@@ -98,6 +106,24 @@ func (o *UpdateHostInstallProgressParams) BindRequest(r *http.Request, route *mi
 	return nil
 }
 
+// bindXAgentToken binds and validates parameter XAgentToken from header.
+func (o *UpdateHostInstallProgressParams) bindXAgentToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	o.XAgentToken = &raw
+
+	return nil
+}
+
 // bindClusterID binds and validates parameter ClusterID from path.
 func (o *UpdateHostInstallProgressParams) bindClusterID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
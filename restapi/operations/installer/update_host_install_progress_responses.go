@@ -9,6 +9,8 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+
+	"github.com/filanov/bm-inventory/models"
 )
 
 // UpdateHostInstallProgressOKCode is the HTTP code returned for type UpdateHostInstallProgressOK
@@ -34,3 +36,91 @@ func (o *UpdateHostInstallProgressOK) WriteResponse(rw http.ResponseWriter, prod
 
 	rw.WriteHeader(200)
 }
+
+// UpdateHostInstallProgressUnauthorizedCode is the HTTP code returned for type UpdateHostInstallProgressUnauthorized
+const UpdateHostInstallProgressUnauthorizedCode int = 401
+
+/*UpdateHostInstallProgressUnauthorized The agent token is missing, revoked or doesn't belong to the cluster.
+
+swagger:response updateHostInstallProgressUnauthorized
+*/
+type UpdateHostInstallProgressUnauthorized struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateHostInstallProgressUnauthorized creates UpdateHostInstallProgressUnauthorized with default headers values
+func NewUpdateHostInstallProgressUnauthorized() *UpdateHostInstallProgressUnauthorized {
+
+	return &UpdateHostInstallProgressUnauthorized{}
+}
+
+// WithPayload adds the payload to the update host install progress unauthorized response
+func (o *UpdateHostInstallProgressUnauthorized) WithPayload(payload *models.Error) *UpdateHostInstallProgressUnauthorized {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update host install progress unauthorized response
+func (o *UpdateHostInstallProgressUnauthorized) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateHostInstallProgressUnauthorized) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(401)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// UpdateHostInstallProgressInternalServerErrorCode is the HTTP code returned for type UpdateHostInstallProgressInternalServerError
+const UpdateHostInstallProgressInternalServerErrorCode int = 500
+
+/*UpdateHostInstallProgressInternalServerError Error.
+
+swagger:response updateHostInstallProgressInternalServerError
+*/
+type UpdateHostInstallProgressInternalServerError struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateHostInstallProgressInternalServerError creates UpdateHostInstallProgressInternalServerError with default headers values
+func NewUpdateHostInstallProgressInternalServerError() *UpdateHostInstallProgressInternalServerError {
+
+	return &UpdateHostInstallProgressInternalServerError{}
+}
+
+// WithPayload adds the payload to the update host install progress internal server error response
+func (o *UpdateHostInstallProgressInternalServerError) WithPayload(payload *models.Error) *UpdateHostInstallProgressInternalServerError {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update host install progress internal server error response
+func (o *UpdateHostInstallProgressInternalServerError) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateHostInstallProgressInternalServerError) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(500)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -1,13 +1,20 @@
 package subsystem
 
 import (
+	"bufio"
 	"context"
 	"encoding/json"
 	"io/ioutil"
+	"net/http"
+	"net/url"
 	"os"
+	"path"
 	"reflect"
+	"strconv"
+	"time"
 
 	"github.com/alecthomas/units"
+	"github.com/filanov/bm-inventory/client"
 	"github.com/filanov/bm-inventory/client/installer"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
@@ -41,6 +48,78 @@ var _ = Describe("Cluster tests", func() {
 		clusterID = *cluster.GetPayload().ID
 	})
 
+	It("cluster hardware profile", func() {
+		Expect(cluster.GetPayload().HardwareProfile).Should(Equal("default"))
+
+		list, err := bmclient.Installer.ListHardwareProfiles(ctx, &installer.ListHardwareProfilesParams{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(swag.StringValue(list.GetPayload()[0].Name)).Should(Equal("default"))
+
+		profile, err := bmclient.Installer.GetHardwareProfile(ctx, &installer.GetHardwareProfileParams{ProfileName: "default"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(profile.GetPayload().Master).ShouldNot(BeNil())
+
+		_, err = bmclient.Installer.GetHardwareProfile(ctx, &installer.GetHardwareProfileParams{ProfileName: "no-such-profile"})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewGetHardwareProfileNotFound())))
+
+		_, err = bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterID:           clusterID,
+			ClusterUpdateParams: &models.ClusterUpdateParams{HardwareProfile: "no-such-profile"},
+		})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewUpdateClusterBadRequest())))
+	})
+
+	It("cluster network validation", func() {
+		_, err := bmclient.Installer.UpdateCluster(ctx, &installer.UpdateClusterParams{
+			ClusterID: clusterID,
+			ClusterUpdateParams: &models.ClusterUpdateParams{
+				ClusterNetworkCidr: "10.128.0.0/14",
+				ServiceNetworkCidr: "10.128.0.0/16",
+				APIVip:             "192.168.126.100",
+				DNSVip:             "192.168.126.100",
+			},
+		})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewUpdateClusterBadRequest())))
+		var fields []string
+		for _, fieldError := range err.(*installer.UpdateClusterBadRequest).Payload.FieldErrors {
+			fields = append(fields, swag.StringValue(fieldError.Field))
+		}
+		Expect(fields).Should(Equal([]string{"service_network_cidr", "dns_vip"}))
+	})
+
+	It("cluster machine networks", func() {
+		for _, ip := range []string{"192.168.126.10", "192.168.126.11"} {
+			h := registerHost(clusterID)
+			hw, err := json.Marshal(&models.Introspection{Nics: []*models.Nic{
+				{Name: "eth0", Cidrs: []*models.Cidr{{IPAddress: ip, Mask: 24}}}}})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
+				ClusterID:   clusterID,
+				XAgentToken: agentToken(clusterID),
+				HostID:      *h.ID,
+				Reply:       &models.StepReply{Output: string(hw), StepID: string(models.StepTypeHardwareInfo)},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
+				ClusterID:   clusterID,
+				XAgentToken: agentToken(clusterID),
+				HostID:      *h.ID,
+				Reply: &models.StepReply{StepID: string(models.StepTypeFreeNetworkAddresses),
+					Output: `[{"network":"192.168.126.0/24","free_addresses":["192.168.126.100","192.168.126.11"]}]`},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		getReply, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReply.GetPayload().MachineNetworks).Should(Equal([]string{"192.168.126.0/24"}))
+
+		free, err := bmclient.Installer.GetFreeAddresses(ctx, &installer.GetFreeAddressesParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(free.GetPayload()).Should(Equal(models.FreeNetworksAddresses{
+			{Network: "192.168.126.0/24", FreeAddresses: []strfmt.IPv4{"192.168.126.100"}}}))
+	})
+
 	It("cluster CRUD", func() {
 		_ = registerHost(clusterID)
 		Expect(err).NotTo(HaveOccurred())
@@ -65,6 +144,116 @@ var _ = Describe("Cluster tests", func() {
 		Expect(err).Should(HaveOccurred())
 	})
 
+	It("cluster access", func() {
+		list, err := otherclient.Installer.ListClusters(ctx, &installer.ListClustersParams{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list.GetPayload()).Should(BeEmpty())
+
+		_, err = otherclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewGetClusterNotFound())))
+
+		_, err = otherclient.Installer.DeregisterCluster(ctx, &installer.DeregisterClusterParams{ClusterID: clusterID})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewDeregisterClusterNotFound())))
+
+		_, err = newClient("no-such-token").Installer.ListClusters(ctx, &installer.ListClustersParams{})
+		Expect(err).Should(HaveOccurred())
+
+		getReply, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getReply.GetPayload().Owner).Should(Equal("user1"))
+		Expect(getReply.GetPayload().Organization).Should(Equal("org1"))
+	})
+
+	It("cluster permissions", func() {
+		_, err := viewerclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = viewerclient.Installer.InstallCluster(ctx, &installer.InstallClusterParams{ClusterID: clusterID})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewInstallClusterForbidden())))
+		reason := swag.StringValue(err.(*installer.InstallClusterForbidden).Payload.Reason)
+		Expect(reason).Should(ContainSubstring("the install permission is required"))
+
+		_, err = viewerclient.Installer.DeregisterCluster(ctx, &installer.DeregisterClusterParams{ClusterID: clusterID})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewDeregisterClusterForbidden())))
+	})
+
+	It("cluster events", func() {
+		host := registerHost(clusterID)
+
+		reply, err := bmclient.Installer.ListClusterEvents(ctx, &installer.ListClusterEventsParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(reply.GetPayload())).Should(BeNumerically(">=", 2))
+		Expect(swag.StringValue(reply.GetPayload()[0].ToStatus)).Should(Equal("insufficient"))
+
+		reply, err = bmclient.Installer.ListClusterEvents(ctx, &installer.ListClusterEventsParams{
+			ClusterID: clusterID,
+			HostID:    host.ID,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(reply.GetPayload())).Should(Equal(1))
+		Expect(swag.StringValue(reply.GetPayload()[0].ToStatus)).Should(Equal("discovering"))
+	})
+
+	It("cluster watch", func() {
+		getReply, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+
+		watchCtx, cancel := context.WithTimeout(ctx, time.Minute)
+		defer cancel()
+		watchURL := url.URL{
+			Scheme:   client.DefaultSchemes[0],
+			Host:     Options.InventoryHost,
+			Path:     path.Join(client.DefaultBasePath, "clusters", clusterID.String(), "watch"),
+			RawQuery: url.Values{"resource_version": {strconv.FormatInt(getReply.XResourceVersion, 10)}}.Encode(),
+		}
+		req, err := http.NewRequest(http.MethodGet, watchURL.String(), nil)
+		Expect(err).NotTo(HaveOccurred())
+		resp, err := http.DefaultClient.Do(req.WithContext(watchCtx))
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).Should(Equal(http.StatusOK))
+
+		host := registerHost(clusterID)
+		var change *models.WatchEvent
+		scanner := bufio.NewScanner(resp.Body)
+		for change == nil && scanner.Scan() {
+			var watchEvent models.WatchEvent
+			Expect(json.Unmarshal(scanner.Bytes(), &watchEvent)).NotTo(HaveOccurred())
+			if swag.StringValue(watchEvent.Type) == models.WatchEventTypeChange {
+				change = &watchEvent
+			}
+		}
+		Expect(change).ShouldNot(BeNil())
+		Expect(change.Event.HostID).Should(Equal(*host.ID))
+		Expect(swag.StringValue(change.Event.ToStatus)).Should(Equal("discovering"))
+	})
+
+	It("cluster webhooks", func() {
+		reply, err := bmclient.Installer.RegisterWebhook(ctx, &installer.RegisterWebhookParams{
+			NewWebhookParams: &models.WebhookCreateParams{
+				URL:        swag.String("http://example.com/hook"),
+				Secret:     swag.String("0123456789abcdef"),
+				ClusterID:  clusterID,
+				EventTypes: []models.WebhookEventType{models.WebhookEventTypeHostDiscovered},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		webhookID := *reply.GetPayload().ID
+		_ = registerHost(clusterID)
+
+		deliveries, err := bmclient.Installer.ListWebhookDeliveries(ctx,
+			&installer.ListWebhookDeliveriesParams{WebhookID: webhookID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(deliveries.GetPayload())).Should(Equal(1))
+		Expect(deliveries.GetPayload()[0].EventType).Should(Equal(models.WebhookEventTypeHostDiscovered))
+
+		_, err = bmclient.Installer.DeregisterCluster(ctx, &installer.DeregisterClusterParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		list, err := bmclient.Installer.ListWebhooks(ctx, &installer.ListWebhooksParams{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(len(list.GetPayload())).Should(Equal(0))
+	})
+
 	It("cluster update", func() {
 		host1 := registerHost(clusterID)
 		host2 := registerHost(clusterID)
@@ -88,7 +277,19 @@ var _ = Describe("Cluster tests", func() {
 			ClusterID: clusterID,
 		})
 		Expect(err).NotTo(HaveOccurred())
-		Expect(c.GetPayload().SSHPublicKey).Should(Equal(publicKey))
+		// the secrets are redacted unless they are requested
+		Expect(c.GetPayload().SSHPublicKey).Should(BeEmpty())
+		withSecrets, err := bmclient.Installer.GetCluster(ctx, &installer.GetClusterParams{
+			ClusterID:   clusterID,
+			WithSecrets: swag.Bool(true),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(withSecrets.GetPayload().SSHPublicKey)).Should(Equal(publicKey))
+		_, err = viewerclient.Installer.GetCluster(ctx, &installer.GetClusterParams{
+			ClusterID:   clusterID,
+			WithSecrets: swag.Bool(true),
+		})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewGetClusterForbidden())))
 
 		h := getHost(clusterID, *host1.ID)
 		Expect(h.Role).Should(Equal("master"))
@@ -112,7 +313,7 @@ var _ = Describe("system-test cluster install", func() {
 	BeforeEach(func() {
 		registerClusterReply, err := bmclient.Installer.RegisterCluster(ctx, &installer.RegisterClusterParams{
 			NewClusterParams: &models.ClusterCreateParams{
-				APIVip:                   "v1",
+				APIVip:                   "",
 				BaseDNSDomain:            "example.com",
 				ClusterNetworkCidr:       "10.128.0.0/14",
 				ClusterNetworkHostPrefix: 23,
@@ -133,8 +334,9 @@ var _ = Describe("system-test cluster install", func() {
 		hw, err := json.Marshal(&hwInfo)
 		Expect(err).NotTo(HaveOccurred())
 		_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
-			ClusterID: h.ClusterID,
-			HostID:    *h.ID,
+			ClusterID:   h.ClusterID,
+			XAgentToken: agentToken(h.ClusterID),
+			HostID:      *h.ID,
 			Reply: &models.StepReply{
 				ExitCode: 0,
 				Output:   string(hw),
@@ -208,6 +410,7 @@ var _ = Describe("system-test cluster install", func() {
 				installProgress := models.HostInstallProgressParams(progress)
 				updateReply, err := bmclient.Installer.UpdateHostInstallProgress(ctx, &installer.UpdateHostInstallProgressParams{
 					ClusterID:                 clusterID,
+					XAgentToken:               agentToken(clusterID),
 					HostInstallProgressParams: installProgress,
 					HostID:                    hostID,
 				})
@@ -272,6 +475,17 @@ var _ = Describe("system-test cluster install", func() {
 			s, err := file.Stat()
 			Expect(err).NotTo(HaveOccurred())
 			Expect(s.Size()).ShouldNot(Equal(0))
+
+			list, err := bmclient.Installer.ListClusterArtifacts(ctx, &installer.ListClusterArtifactsParams{ClusterID: clusterID})
+			Expect(err).NotTo(HaveOccurred())
+			var names []string
+			for _, artifact := range list.GetPayload() {
+				names = append(names, swag.StringValue(artifact.Name))
+			}
+			Expect(names).Should(ContainElement("bootstrap.ign"))
+
+			_, err = bmclient.Installer.DownloadClusterFiles(ctx, &installer.DownloadClusterFilesParams{ClusterID: clusterID, FileName: "kubeconfig"}, file)
+			Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewDownloadClusterFilesForbidden())))
 		})
 	})
 
@@ -2,6 +2,7 @@ package subsystem
 
 import (
 	"context"
+	"reflect"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -94,8 +95,9 @@ var _ = Describe("Host tests", func() {
 		hwInfo := "{\"block_devices\":null,\"cpu\":{\"architecture\":\"x86_64\",\"cpus\":8,\"sockets\":1},\"memory\":[{\"available\":19743372,\"free\":8357316,\"name\":\"Mem\",\"shared\":1369116,\"total\":32657728,\"used\":11105024},{\"free\":16400380,\"name\":\"Swap\",\"total\":16400380}],\"nics\":[{\"cidrs\":[],\"mac\":\"f8:75:a4:a4:01:6e\",\"mtu\":1500,\"name\":\"enp0s31f6\",\"state\":\"NO-CARRIER,BROADCAST,MULTICAST,UP\"},{\"cidrs\":[{\"mask\":24}],\"mac\":\"80:32:53:4f:16:4f\",\"mtu\":1500,\"name\":\"wlp0s20f3\",\"state\":\"BROADCAST,MULTICAST,UP,LOWER_UP\"},{\"cidrs\":[{\"mask\":24}],\"mac\":\"52:54:00:71:50:da\",\"mtu\":1500,\"name\":\"virbr1\",\"state\":\"BROADCAST,MULTICAST,UP,LOWER_UP\"},{\"cidrs\":[],\"mac\":\"8e:59:a1:a9:14:23\",\"mtu\":1500,\"name\":\"virbr1-nic\",\"state\":\"BROADCAST,MULTICAST\"},{\"cidrs\":[{\"mask\":24}],\"mac\":\"52:54:00:bc:9b:3f\",\"mtu\":1500,\"name\":\"virbr0\",\"state\":\"BROADCAST,MULTICAST,UP,LOWER_UP\"},{\"cidrs\":[],\"mac\":\"52:54:00:bc:9b:3f\",\"mtu\":1500,\"name\":\"virbr0-nic\",\"state\":\"BROADCAST,MULTICAST\"},{\"cidrs\":[{\"mask\":16}],\"mac\":\"02:42:aa:59:3a:d3\",\"mtu\":1500,\"name\":\"docker0\",\"state\":\"NO-CARRIER,BROADCAST,MULTICAST,UP\"},{\"cidrs\":[],\"mac\":\"fe:9b:ea:d0:f5:70\",\"mtu\":1500,\"name\":\"vnet0\",\"state\":\"BROADCAST,MULTICAST,UP,LOWER_UP\"},{\"cidrs\":[],\"mac\":\"fe:16:a0:ea:b3:0b\",\"mtu\":1500,\"name\":\"vnet1\",\"state\":\"BROADCAST,MULTICAST,UP,LOWER_UP\"}]}"
 
 		_, err := bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
-			ClusterID: clusterID,
-			HostID:    *host.ID,
+			ClusterID:   clusterID,
+			XAgentToken: agentToken(clusterID),
+			HostID:      *host.ID,
 			Reply: &models.StepReply{
 				ExitCode: 0,
 				Output:   extraHwInfo,
@@ -107,8 +109,9 @@ var _ = Describe("Host tests", func() {
 		Expect(host.HardwareInfo).Should(Equal(hwInfo))
 
 		_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
-			ClusterID: clusterID,
-			HostID:    *host.ID,
+			ClusterID:   clusterID,
+			XAgentToken: agentToken(clusterID),
+			HostID:      *host.ID,
 			Reply: &models.StepReply{
 				ExitCode: 0,
 				Output:   "not a json",
@@ -144,12 +147,19 @@ var _ = Describe("Host tests", func() {
 	It("debug", func() {
 		host1 := registerHost(clusterID)
 		host2 := registerHost(clusterID)
-		// set debug to host1
+		// only the users with the debug permission can set debug steps
 		_, err := bmclient.Installer.SetDebugStep(ctx, &installer.SetDebugStepParams{
 			ClusterID: clusterID,
 			HostID:    *host1.ID,
 			Step:      &models.DebugStep{Command: swag.String("echo hello")},
 		})
+		Expect(reflect.TypeOf(err)).Should(Equal(reflect.TypeOf(installer.NewSetDebugStepForbidden())))
+		// set debug to host1
+		_, err = adminclient.Installer.SetDebugStep(ctx, &installer.SetDebugStepParams{
+			ClusterID: clusterID,
+			HostID:    *host1.ID,
+			Step:      &models.DebugStep{Command: swag.String("echo hello")},
+		})
 		Expect(err).NotTo(HaveOccurred())
 
 		var step *models.Step
@@ -168,8 +178,9 @@ var _ = Describe("Host tests", func() {
 		Expect(ok).Should(Equal(false))
 
 		_, err = bmclient.Installer.PostStepReply(ctx, &installer.PostStepReplyParams{
-			ClusterID: clusterID,
-			HostID:    *host1.ID,
+			ClusterID:   clusterID,
+			XAgentToken: agentToken(clusterID),
+			HostID:      *host1.ID,
 			Reply: &models.StepReply{
 				ExitCode: 0,
 				Output:   "hello",
@@ -183,7 +194,8 @@ var _ = Describe("Host tests", func() {
 		hostID := strToUUID(uuid.New().String())
 		// register to cluster1
 		_, err := bmclient.Installer.RegisterHost(context.Background(), &installer.RegisterHostParams{
-			ClusterID: clusterID,
+			ClusterID:   clusterID,
+			XAgentToken: agentToken(clusterID),
 			NewHostParams: &models.HostCreateParams{
 				HostID: hostID,
 			},
@@ -200,7 +212,8 @@ var _ = Describe("Host tests", func() {
 
 		// register to cluster2
 		_, err = bmclient.Installer.RegisterHost(ctx, &installer.RegisterHostParams{
-			ClusterID: *cluster2.GetPayload().ID,
+			ClusterID:   *cluster2.GetPayload().ID,
+			XAgentToken: agentToken(*cluster2.GetPayload().ID),
 			NewHostParams: &models.HostCreateParams{
 				HostID: hostID,
 			},
@@ -223,7 +236,8 @@ var _ = Describe("Host tests", func() {
 		h = getHost(*cluster2.GetPayload().ID, *hostID)
 		Expect(swag.StringValue(h.Status)).Should(Equal("known"))
 		_, err = bmclient.Installer.RegisterHost(ctx, &installer.RegisterHostParams{
-			ClusterID: *cluster2.GetPayload().ID,
+			ClusterID:   *cluster2.GetPayload().ID,
+			XAgentToken: agentToken(*cluster2.GetPayload().ID),
 			NewHostParams: &models.HostCreateParams{
 				HostID: hostID,
 			},
@@ -232,6 +246,33 @@ var _ = Describe("Host tests", func() {
 		h = getHost(*cluster2.GetPayload().ID, *hostID)
 		Expect(swag.StringValue(h.Status)).Should(Equal("discovering"))
 	})
+
+	It("agent token", func() {
+		host := registerHost(clusterID)
+		_, err := bmclient.Installer.GetNextSteps(ctx, &installer.GetNextStepsParams{
+			ClusterID: clusterID,
+			HostID:    *host.ID,
+		})
+		Expect(err).Should(BeAssignableToTypeOf(installer.NewGetNextStepsUnauthorized()))
+
+		_, err = bmclient.Installer.RegisterHost(ctx, &installer.RegisterHostParams{
+			ClusterID:   clusterID,
+			XAgentToken: swag.String("not a token"),
+			NewHostParams: &models.HostCreateParams{
+				HostID: strToUUID(uuid.New().String()),
+			},
+		})
+		Expect(err).Should(BeAssignableToTypeOf(installer.NewRegisterHostUnauthorized()))
+
+		_, err = bmclient.Installer.RevokeAgentToken(ctx, &installer.RevokeAgentTokenParams{ClusterID: clusterID})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = bmclient.Installer.GetNextSteps(ctx, &installer.GetNextStepsParams{
+			ClusterID:   clusterID,
+			HostID:      *host.ID,
+			XAgentToken: agentToken(clusterID),
+		})
+		Expect(err).Should(BeAssignableToTypeOf(installer.NewGetNextStepsUnauthorized()))
+	})
 })
 
 func getStepInList(steps models.Steps, sType models.StepType) (*models.Step, bool) {
@@ -245,8 +286,9 @@ func getStepInList(steps models.Steps, sType models.StepType) (*models.Step, boo
 
 func getNextSteps(clusterID, hostID strfmt.UUID) models.Steps {
 	steps, err := bmclient.Installer.GetNextSteps(context.Background(), &installer.GetNextStepsParams{
-		ClusterID: clusterID,
-		HostID:    hostID,
+		ClusterID:   clusterID,
+		XAgentToken: agentToken(clusterID),
+		HostID:      hostID,
 	})
 	Expect(err).NotTo(HaveOccurred())
 	return steps.GetPayload()
@@ -1,14 +1,14 @@
 package subsystem
 
 import (
-	"fmt"
 	"log"
 	"net/url"
 	"testing"
 
 	"github.com/filanov/bm-inventory/client"
+	"github.com/filanov/bm-inventory/pkg/database"
+	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/jinzhu/gorm"
-	_ "github.com/jinzhu/gorm/dialects/mysql"
 	"github.com/kelseyhightower/envconfig"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -18,10 +18,33 @@ import (
 var db *gorm.DB
 var bmclient *client.AssistedInstall
 
+// otherclient is the client of a user of another organization, it must not access the clusters of bmclient
+var otherclient *client.AssistedInstall
+
+// adminclient is the client of an admin of the organization of bmclient, viewerclient of a user of that organization
+// that is only permitted to read
+var adminclient *client.AssistedInstall
+var viewerclient *client.AssistedInstall
+
+// the tokens match the static tokens of deploy/bm-inventory-auth-secret.yaml
 var Options struct {
-	DBHost        string `envconfig:"DB_HOST"`
-	DBPort        string `envconfig:"DB_PORT"`
-	InventoryHost string `envconfig:"INVENTORY"`
+	DBConfig       database.Config
+	InventoryHost  string `envconfig:"INVENTORY"`
+	UserToken      string `envconfig:"USER_TOKEN" default:"subsystem-user-token"`
+	OtherUserToken string `envconfig:"OTHER_USER_TOKEN" default:"subsystem-other-token"`
+	AdminToken     string `envconfig:"ADMIN_TOKEN" default:"subsystem-admin-token"`
+	ViewerToken    string `envconfig:"VIEWER_TOKEN" default:"subsystem-viewer-token"`
+}
+
+func newClient(token string) *client.AssistedInstall {
+	return client.New(client.Config{
+		URL: &url.URL{
+			Scheme: client.DefaultSchemes[0],
+			Host:   Options.InventoryHost,
+			Path:   client.DefaultBasePath,
+		},
+		AuthInfo: httptransport.BearerToken(token),
+	})
 }
 
 func init() {
@@ -31,17 +54,12 @@ func init() {
 		log.Fatal(err.Error())
 	}
 
-	bmclient = client.New(client.Config{
-		URL: &url.URL{
-			Scheme: client.DefaultSchemes[0],
-			Host:   Options.InventoryHost,
-			Path:   client.DefaultBasePath,
-		},
-	})
+	bmclient = newClient(Options.UserToken)
+	otherclient = newClient(Options.OtherUserToken)
+	adminclient = newClient(Options.AdminToken)
+	viewerclient = newClient(Options.ViewerToken)
 
-	db, err = gorm.Open("mysql",
-		fmt.Sprintf("admin:admin@tcp(%s:%s)/installer?charset=utf8&parseTime=True&loc=Local",
-			Options.DBHost, Options.DBPort))
+	db, err = database.Open(logrus.New(), Options.DBConfig)
 	if err != nil {
 		logrus.Fatal("Fail to connect to DB, ", err)
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/filanov/bm-inventory/client/installer"
+	"github.com/filanov/bm-inventory/internal/agenttoken"
 	"github.com/filanov/bm-inventory/models"
 	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
@@ -13,6 +14,22 @@ import (
 func clearDB() {
 	db.Delete(&models.Host{})
 	db.Delete(&models.Cluster{})
+	db.Delete(&models.Image{})
+	db.Delete(&agenttoken.Token{})
+}
+
+var agentTokens = map[strfmt.UUID]*string{}
+
+// agentToken returns the agent token of the cluster. The agents of the tests don't boot from the discovery images
+// of their clusters, so the token is generated directly in the DB instead of reading it from an image.
+func agentToken(clusterID strfmt.UUID) *string {
+	if token, ok := agentTokens[clusterID]; ok {
+		return token
+	}
+	token, err := agenttoken.Generate(db, clusterID, strfmt.UUID(uuid.New().String()), 0)
+	Expect(err).NotTo(HaveOccurred())
+	agentTokens[clusterID] = &token
+	return &token
 }
 
 func strToUUID(s string) *strfmt.UUID {
@@ -22,7 +39,8 @@ func strToUUID(s string) *strfmt.UUID {
 
 func registerHost(clusterID strfmt.UUID) *models.Host {
 	host, err := bmclient.Installer.RegisterHost(context.Background(), &installer.RegisterHostParams{
-		ClusterID: clusterID,
+		ClusterID:   clusterID,
+		XAgentToken: agentToken(clusterID),
 		NewHostParams: &models.HostCreateParams{
 			HostID: strToUUID(uuid.New().String()),
 		},